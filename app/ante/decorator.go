@@ -40,6 +40,9 @@ type AnteDecorators struct {
 	SigGasConsumer         func(meter cosmos.GasMeter, sig signing.SignatureV2, params authmodule.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           anteutils.TxFeeChecker
+	// EVMMempoolEnabled accepts the ethereum txs with future nonces in CheckTx, which are queued
+	// and ordered by nonce in the app-side evm mempool.
+	EVMMempoolEnabled bool
 }
 
 // Validate checks if the keepers are defined
@@ -96,7 +99,7 @@ func newEVMAnteHandler(app *baseapp.BaseApp, options AnteDecorators) cosmos.Ante
 		evmante.NewCanTransferDecorator(options.EvmKeeper),
 		// evmante.NewEthVestingTransactionDecorator(options.AccountKeeper, options.BankKeeper, options.EvmKeeper),
 		evmante.NewEthGasConsumeDecorator(options.BankKeeper, options.DistributionKeeper, options.EvmKeeper, nil, options.MaxTxGasWanted),
-		evmante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper),
+		evmante.NewEthIncrementSenderSequenceDecorator(options.AccountKeeper, options.EVMMempoolEnabled),
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeKeeper),
 		// emit eth tx hash and index at the very last ante handler.
 		evmante.NewEthEmitEventDecorator(options.EvmKeeper),
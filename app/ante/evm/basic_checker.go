@@ -329,12 +329,15 @@ func (ctd CanTransferDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, sim
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
 	ak evmmodule.AccountKeeper
+	// mempoolEnabled is true if the app-side evm mempool orders the txs by nonce
+	mempoolEnabled bool
 }
 
 // NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmmodule.AccountKeeper) EthIncrementSenderSequenceDecorator {
+func NewEthIncrementSenderSequenceDecorator(ak evmmodule.AccountKeeper, mempoolEnabled bool) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		ak:             ak,
+		mempoolEnabled: mempoolEnabled,
 	}
 }
 
@@ -362,6 +365,19 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx cosmos.Context, t
 			)
 		}
 		nonce := acc.GetSequence()
+		// with the app-side evm mempool, the txs with future nonces are queued, and the txs with the nonce of
+		// a pending txs replace it, so in CheckTx the nonce is only checked to not be lower than the account
+		// nonce, which is not increased to keep the committed nonce.
+		if issd.mempoolEnabled && ctx.IsCheckTx() && !simulate {
+			if txData.GetNonce() < nonce {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInvalidSequence,
+					"invalid nonce; got %d, expected at least %d", txData.GetNonce(), nonce,
+				)
+			}
+			continue
+		}
+
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
 		if txData.GetNonce() != nonce {
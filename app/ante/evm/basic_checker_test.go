@@ -0,0 +1,83 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authmodule "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
+)
+
+type mockAccountKeeper struct {
+	evmmodule.AccountKeeper
+	account authmodule.AccountI
+}
+
+func (m *mockAccountKeeper) GetAccount(_ cosmos.Context, _ cosmos.AccAddress) authmodule.AccountI {
+	return m.account
+}
+
+func (m *mockAccountKeeper) SetAccount(_ cosmos.Context, account authmodule.AccountI) {
+	m.account = account
+}
+
+func TestEthIncrementSenderSequenceDecorator(t *testing.T) {
+	sender := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	next := func(ctx cosmos.Context, _ cosmos.Tx, _ bool) (cosmos.Context, error) { return ctx, nil }
+	newTx := func(nonce uint64) cosmos.Tx {
+		msg := &txs.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(ethereum.NewTx(&ethereum.LegacyTx{
+			Nonce:    nonce,
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+			To:       &sender,
+		})))
+		msg.From = sender.Hex()
+		return msg
+	}
+
+	testCases := []struct {
+		name           string
+		mempoolEnabled bool
+		checkTx        bool
+		simulate       bool
+		nonce          uint64
+		expErr         bool
+		expSequence    uint64
+	}{
+		{"check tx", false, true, false, 5, false, 6},
+		{"check tx future nonce", false, true, false, 6, true, 5},
+		{"mempool check tx", true, true, false, 5, false, 5},
+		{"mempool check tx future nonce", true, true, false, 7, false, 5},
+		{"mempool check tx nonce too low", true, true, false, 4, true, 5},
+		{"mempool simulate", true, true, true, 5, false, 6},
+		{"mempool simulate future nonce", true, true, true, 7, true, 5},
+		{"mempool deliver tx", true, false, false, 5, false, 6},
+		{"mempool deliver tx future nonce", true, false, false, 7, true, 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			account := authmodule.NewBaseAccountWithAddress(sender.Bytes())
+			require.NoError(t, account.SetSequence(5))
+			ak := &mockAccountKeeper{account: account}
+
+			decorator := NewEthIncrementSenderSequenceDecorator(ak, tc.mempoolEnabled)
+			ctx := cosmos.Context{}.WithIsCheckTx(tc.checkTx)
+			_, err := decorator.AnteHandle(ctx, newTx(tc.nonce), tc.simulate, next)
+			if tc.expErr {
+				require.ErrorIs(t, err, errortypes.ErrInvalidSequence)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expSequence, ak.account.GetSequence())
+		})
+	}
+}
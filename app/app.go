@@ -10,6 +10,7 @@ import (
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+	errorsmod "cosmossdk.io/errors"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
@@ -27,6 +28,8 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -111,9 +114,13 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
 
+	"github.com/artela-network/artela/app/upgrades/v0410rc10"
 	"github.com/artela-network/artela/app/upgrades/v047rc7"
 	"github.com/artela-network/artela/app/upgrades/v048rc8"
 	"github.com/artela-network/artela/app/upgrades/v049rc9"
+	aspectmodule "github.com/artela-network/artela/x/aspect"
+	aspectmodulekeeper "github.com/artela-network/artela/x/aspect/keeper"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
 	evmmodule "github.com/artela-network/artela/x/evm"
 	evmmodulekeeper "github.com/artela-network/artela/x/evm/keeper"
 	evmmoduletypes "github.com/artela-network/artela/x/evm/types"
@@ -125,15 +132,17 @@ import (
 
 	"github.com/artela-network/artela/app/ante"
 	ethante "github.com/artela-network/artela/app/ante/evm"
+	"github.com/artela-network/artela/app/mempool"
 	appparams "github.com/artela-network/artela/app/params"
 	"github.com/artela-network/artela/app/post"
 	"github.com/artela-network/artela/common"
 	"github.com/artela-network/artela/docs"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	srvflags "github.com/artela-network/artela/ethereum/server/flags"
 	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/aspect/provider"
-	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
-	aspectmodule "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/artela/handle"
+	evmartelatypes "github.com/artela-network/artela/x/evm/artela/types"
 	aspecttypes "github.com/artela-network/aspect-core/types"
 
 	// do not remove this, this will register the native evm tracers
@@ -202,6 +211,7 @@ var (
 		consensus.AppModuleBasic{},
 		evmmodule.AppModuleBasic{},
 		feemodule.AppModuleBasic{},
+		aspectmodule.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -217,6 +227,7 @@ var (
 		transfermodule.ModuleName:       {authmodule.Minter, authmodule.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 		evmmoduletypes.ModuleName: {authmodule.Minter, authmodule.Burner},
+		feemoduletypes.ModuleName: nil,
 	}
 )
 
@@ -283,9 +294,13 @@ type Artela struct {
 
 	EvmKeeper *evmmodulekeeper.Keeper
 
-	FeeKeeper *feemodulekeeper.Keeper
+	FeeKeeper    *feemodulekeeper.Keeper
+	AspectKeeper *aspectmodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
+	// EVMMempool is the app-side evm mempool, nil if not enabled
+	EVMMempool *mempool.EVMMempool
+
 	// mm is the module manager
 	mm *module.Manager
 
@@ -560,11 +575,12 @@ func NewArtela(
 		appCodec, authmodule.NewModuleAddress(govmodule.ModuleName),
 		keys[feemoduletypes.StoreKey],
 		tkeys[feemoduletypes.TransientKey],
+		app.BankKeeper,
 		app.GetSubspace(feemoduletypes.ModuleName),
 	)
 	feeModule := feemodule.NewAppModule(app.FeeKeeper, app.GetSubspace(feemoduletypes.ModuleName))
 
-	aspectmodule.InitStoreKeys(keys[evmmoduletypes.StoreKey], keys[aspectmoduletypes.StoreKey])
+	evmartelatypes.InitStoreKeys(keys[evmmoduletypes.StoreKey], keys[aspectmoduletypes.StoreKey])
 	aspect := provider.NewArtelaProvider(keys[evmmoduletypes.StoreKey], keys[aspectmoduletypes.StoreKey], app.LastBlockHeight)
 	app.EvmKeeper = evmmodulekeeper.NewKeeper(
 		appCodec, keys[evmmoduletypes.StoreKey], tkeys[evmmoduletypes.TransientKey], authmodule.NewModuleAddress(govmodule.ModuleName),
@@ -573,6 +589,9 @@ func NewArtela(
 	)
 	evmModule := evmmodule.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmmoduletypes.ModuleName))
 
+	app.AspectKeeper = aspectmodulekeeper.NewKeeper(keys[aspectmoduletypes.StoreKey], keys[evmmoduletypes.StoreKey])
+	aspectModule := aspectmodule.NewAppModule(app.AspectKeeper)
+
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	/**** IBC Routing ****/
@@ -636,6 +655,7 @@ func NewArtela(
 		icaModule,
 		evmModule,
 		feeModule,
+		aspectModule,
 		// this line is used by starport scaffolding # stargate/app/appModule
 
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisismodule.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
@@ -651,6 +671,7 @@ func NewArtela(
 		capabilitymodule.ModuleName,
 		feemoduletypes.ModuleName,
 		evmmoduletypes.ModuleName,
+		aspectmoduletypes.ModuleName,
 		mintmodule.ModuleName,
 		distrmodule.ModuleName,
 		slashingmodule.ModuleName,
@@ -679,6 +700,7 @@ func NewArtela(
 		stakingmodule.ModuleName,
 		evmmoduletypes.ModuleName,
 		feemoduletypes.ModuleName,
+		aspectmoduletypes.ModuleName,
 		transfermodule.ModuleName,
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
@@ -717,6 +739,7 @@ func NewArtela(
 		crisismodule.ModuleName,
 		evmmoduletypes.ModuleName,
 		feemoduletypes.ModuleName,
+		aspectmoduletypes.ModuleName,
 		genutilmodule.ModuleName,
 		transfermodule.ModuleName,
 		ibcexported.ModuleName,
@@ -762,17 +785,18 @@ func NewArtela(
 
 	// initialize BaseApp
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	evmMempoolEnabled := cast.ToBool(appOpts.Get(srvflags.EVMMempoolEnable))
+	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, evmMempoolEnabled)
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	// init Aspect
 	app.setPostHandler()
 
-	// // aspect add ProposalHandler
-	// aspectProposalHandler := handle.NewArtelaProposalHandler(bApp.GetMemPool(), bApp)
-	// bApp.SetPrepareProposal(aspectProposalHandler.PrepareProposalHandler())
-	// bApp.SetProcessProposal(aspectProposalHandler.ProcessProposalHandler())
+	if evmMempoolEnabled {
+		app.setEVMMempool(appOpts)
+	}
+
 	// setupUpgradeHandlers should be called before `LoadLatestVersion()`
 	// because StoreLoad is sealed after that
 	// app upgrade
@@ -792,7 +816,7 @@ func NewArtela(
 }
 
 // TODO mark
-func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, evmMempoolEnabled bool) {
 	options := ante.AnteDecorators{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -806,6 +830,7 @@ func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		EVMMempoolEnabled:      evmMempoolEnabled,
 
 		// TODO StakingKeeper:          app.StakingKeeper,
 		IBCKeeper: app.IBCKeeper,
@@ -830,9 +855,53 @@ func (app *Artela) setPostHandler() {
 	app.SetPostHandler(post.NewPostHandler(app.BaseApp, options))
 }
 
+// TxPool returns the app-side evm mempool read by the txpool api, nil if it's not enabled.
+func (app *Artela) TxPool() rpctypes.TxPool {
+	if app.EVMMempool == nil {
+		return nil
+	}
+	return app.EVMMempool
+}
+
+// setEVMMempool sets the app-side evm mempool, and the proposal handlers selecting the txs from it.
+func (app *Artela) setEVMMempool(appOpts servertypes.AppOptions) {
+	evmMempool := mempool.NewEVMMempool(mempool.Config{
+		PriceBump:    cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+		AccountSlots: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountSlots)),
+		AccountQueue: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountQueue)),
+		GlobalSlots:  cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalSlots)),
+		GlobalQueue:  cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalQueue)),
+		Lifetime:     cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)),
+	}, app.EvmKeeper, app.FeeKeeper)
+	app.SetMempool(evmMempool)
+	app.EVMMempool = evmMempool
+
+	proposalHandler := handle.NewArtelaProposalHandler(evmMempool, app.BaseApp)
+	if cast.ToBool(appOpts.Get(srvflags.EVMMempoolAspectPrecheck)) {
+		proposalHandler = proposalHandler.WithAspectPrecheck(app.EvmKeeper,
+			cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMaxBlockAspectGas)))
+	}
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
+}
+
 // Name returns the name of the App
 func (app *Artela) Name() string { return app.BaseApp.Name() }
 
+// CheckTx implements the ABCI interface. With the app-side evm mempool, the ethereum txs dropped by
+// the app mempool, e.g. evicted or replaced, are rejected on recheck, so that they are removed from
+// the CometBFT mempool too.
+func (app *Artela) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	if app.EVMMempool != nil && req.Type == abci.CheckTxType_Recheck {
+		tx, err := app.txConfig.TxDecoder()(req.Tx)
+		if err == nil && app.EVMMempool.Dropped(tx) {
+			return errortypes.ResponseCheckTxWithEvents(
+				errorsmod.Wrap(sdkmempool.ErrTxNotFound, "dropped by the evm mempool"), 0, 0, nil, false)
+		}
+	}
+	return app.BaseApp.CheckTx(req)
+}
+
 // BeginBlocker application updates every begin block
 func (app *Artela) BeginBlocker(ctx cosmos.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -1035,6 +1104,14 @@ func (app *Artela) setupUpgradeHandlers() {
 		),
 	)
 
+	// v0.4.10-rc10 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v0410rc10.UpgradeName,
+		v0410rc10.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1060,6 +1137,8 @@ func (app *Artela) setupUpgradeHandlers() {
 				aspectmoduletypes.StoreKey,
 			},
 		}
+	case v0410rc10.UpgradeName:
+		// no store upgrades in v0410rc10
 	default:
 		// no-op
 	}
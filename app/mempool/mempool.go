@@ -0,0 +1,494 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+var _ sdkmempool.Mempool = (*EVMMempool)(nil)
+
+var (
+	// ErrNonceTooLow is returned if the nonce of a txs is lower than the one of the sender account.
+	ErrNonceTooLow = errors.New("nonce too low")
+	// ErrAlreadyKnown is returned if the txs is already in the mempool.
+	ErrAlreadyKnown = errors.New("already known")
+	// ErrReplaceUnderpriced is returned if a txs is attempted to be replaced with a different one
+	// without the required price bump.
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrAccountLimitExceeded is returned if the sender has too many txs in the mempool.
+	ErrAccountLimitExceeded = errors.New("account limit exceeded")
+)
+
+// EVMKeeper defines the expected evm keeper interface used by the mempool.
+type EVMKeeper interface {
+	GetNonce(ctx cosmos.Context, addr common.Address) uint64
+}
+
+// FeeKeeper defines the expected fee keeper interface used by the mempool.
+type FeeKeeper interface {
+	GetBaseFee(ctx cosmos.Context) *big.Int
+}
+
+// Config are the configuration parameters of the evm mempool.
+type Config struct {
+	PriceBump    uint64        // Minimum price bump percentage to replace an already existing txs (nonce)
+	AccountSlots uint64        // Max number of executable txs per account
+	AccountQueue uint64        // Max number of non-executable txs per account
+	GlobalSlots  uint64        // Max number of executable txs of all accounts
+	GlobalQueue  uint64        // Max number of non-executable txs of all accounts
+	Lifetime     time.Duration // Max time the non-executable txs are queued
+}
+
+// EVMMempool is an app-side mempool keeping the ethereum txs of each sender ordered by nonce, split
+// into the pending (executable) txs, and the queued txs waiting for a nonce gap to be filled or for
+// a pending slot of the sender. The pending txs are selected by effective tip across the senders and
+// by nonce within a sender.
+//
+// The cosmos txs are kept in a priority nonce mempool and selected before the ethereum txs.
+//
+// NOTE: the nonce of the ethereum txs is checked against the committed state in CheckTx when the
+// mempool is enabled, so the pending and queued txs are classified by the account nonces at the
+// latest committed block.
+type EVMMempool struct {
+	mu sync.Mutex
+
+	config    Config
+	evmKeeper EVMKeeper
+	feeKeeper FeeKeeper
+
+	// ethereum txs indexed by sender and by hash
+	accounts map[common.Address]*txList
+	all      map[common.Hash]*poolTx
+	// number of the pending and queued ethereum txs of all the senders
+	pending, queued uint64
+
+	cosmosTxs *sdkmempool.PriorityNonceMempool
+}
+
+// NewEVMMempool creates a new evm mempool.
+func NewEVMMempool(config Config, evmKeeper EVMKeeper, feeKeeper FeeKeeper) *EVMMempool {
+	return &EVMMempool{
+		config:    config,
+		evmKeeper: evmKeeper,
+		feeKeeper: feeKeeper,
+		accounts:  make(map[common.Address]*txList),
+		all:       make(map[common.Hash]*poolTx),
+		cosmosTxs: sdkmempool.NewPriorityMempool(),
+	}
+}
+
+// poolTx is an ethereum txs in the mempool
+type poolTx struct {
+	tx     cosmos.Tx
+	ethTx  *ethereum.Transaction
+	sender common.Address
+	time   time.Time
+}
+
+// txList is the ethereum txs of a sender indexed by nonce
+type txList struct {
+	txs map[uint64]*poolTx
+	// nonce is the account nonce the txs were last classified with
+	nonce uint64
+	// number of the pending and queued txs as last classified
+	pending, queued uint64
+}
+
+// consecutive returns the number of the txs with consecutive nonces from the account nonce.
+func (l *txList) consecutive() uint64 {
+	count := uint64(0)
+	for _, ok := l.txs[l.nonce+count]; ok; _, ok = l.txs[l.nonce+count] {
+		count++
+	}
+	return count
+}
+
+// unwrapEthereumTx returns the ethereum txs wrapped in the cosmos txs, false if the cosmos txs is
+// not a single MsgEthereumTx.
+func unwrapEthereumTx(tx cosmos.Tx) (*txs.MsgEthereumTx, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	msg, ok := msgs[0].(*txs.MsgEthereumTx)
+	return msg, ok
+}
+
+// Insert implements the sdk mempool.Mempool interface. The ethereum txs replaces the one with the same
+// sender and nonce if it pays the price bump, it's rejected if the account or global limits are reached.
+func (mp *EVMMempool) Insert(goCtx context.Context, tx cosmos.Tx) error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	msg, ok := unwrapEthereumTx(tx)
+	if !ok {
+		return mp.cosmosTxs.Insert(goCtx, tx)
+	}
+
+	if msg.From == "" {
+		return fmt.Errorf("sender of ethereum txs %s is not set", msg.Hash)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+	sender := common.HexToAddress(msg.From)
+	ethTx := msg.AsTransaction()
+	if _, ok := mp.all[ethTx.Hash()]; ok {
+		return ErrAlreadyKnown
+	}
+
+	nonce := mp.evmKeeper.GetNonce(ctx, sender)
+	if ethTx.Nonce() < nonce {
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", ErrNonceTooLow, sender, ethTx.Nonce(), nonce)
+	}
+
+	list, ok := mp.accounts[sender]
+	if !ok {
+		list = &txList{txs: make(map[uint64]*poolTx)}
+		mp.accounts[sender] = list
+	}
+	mp.reset(list, nonce)
+	mp.evictExpired(list, time.Now())
+
+	newTx := &poolTx{tx: tx, ethTx: ethTx, sender: sender, time: time.Now()}
+	if old, ok := list.txs[ethTx.Nonce()]; ok {
+		if !mp.outbids(ethTx, old.ethTx) {
+			return ErrReplaceUnderpriced
+		}
+		delete(mp.all, old.ethTx.Hash())
+		list.txs[ethTx.Nonce()] = newTx
+		mp.all[ethTx.Hash()] = newTx
+		return nil
+	}
+
+	// the txs filling the nonce gap promotes the queued txs following it, up to the account slots
+	if ethTx.Nonce() == list.nonce+list.consecutive() && list.pending < mp.config.AccountSlots {
+		promoted := mp.promoted(list, ethTx.Nonce())
+		if mp.pending+promoted > mp.config.GlobalSlots {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+	} else {
+		if list.queued >= mp.config.AccountQueue {
+			return fmt.Errorf("%w: address %s has %d non-executable txs", ErrAccountLimitExceeded, sender, list.queued)
+		}
+		if mp.queued >= mp.config.GlobalQueue {
+			return sdkmempool.ErrMempoolTxMaxCapacity
+		}
+	}
+
+	list.txs[ethTx.Nonce()] = newTx
+	mp.all[ethTx.Hash()] = newTx
+	mp.recount(list)
+	return nil
+}
+
+// promoted returns the number of the txs becoming pending if a txs with the given nonce is added to the
+// sender, which fills the nonce gap of the sender.
+func (mp *EVMMempool) promoted(list *txList, nonce uint64) uint64 {
+	pending := nonce - list.nonce + 1
+	for _, ok := list.txs[list.nonce+pending]; ok && pending < mp.config.AccountSlots; _, ok = list.txs[list.nonce+pending] {
+		pending++
+	}
+	if pending > mp.config.AccountSlots {
+		pending = mp.config.AccountSlots
+	}
+	return pending - list.pending
+}
+
+// outbids returns true if the new txs pays the price bump over the old one on both the tip cap and the fee cap.
+func (mp *EVMMempool) outbids(newTx, oldTx *ethereum.Transaction) bool {
+	bump := func(price *big.Int) *big.Int {
+		threshold := new(big.Int).Mul(price, new(big.Int).SetUint64(100+mp.config.PriceBump))
+		return threshold.Div(threshold, big.NewInt(100))
+	}
+
+	return newTx.GasTipCapIntCmp(bump(oldTx.GasTipCap())) >= 0 &&
+		newTx.GasFeeCapIntCmp(bump(oldTx.GasFeeCap())) >= 0
+}
+
+// reset classifies the txs of the sender with the given account nonce, dropping the stale txs.
+func (mp *EVMMempool) reset(list *txList, nonce uint64) {
+	for txNonce, tx := range list.txs {
+		if txNonce < nonce {
+			delete(list.txs, txNonce)
+			delete(mp.all, tx.ethTx.Hash())
+		}
+	}
+	list.nonce = nonce
+	mp.recount(list)
+}
+
+// evictExpired drops the non-executable txs of the sender queued for longer than the lifetime.
+func (mp *EVMMempool) evictExpired(list *txList, now time.Time) {
+	for txNonce, tx := range list.txs {
+		if txNonce >= list.nonce+list.pending && now.Sub(tx.time) > mp.config.Lifetime {
+			delete(list.txs, txNonce)
+			delete(mp.all, tx.ethTx.Hash())
+		}
+	}
+	mp.recount(list)
+}
+
+// recount classifies the txs of the sender after the txs are changed, the txs with consecutive nonces
+// from the account nonce are pending up to the account slots, the others are queued. The counters of
+// all the senders are updated accordingly, so they are maintained without iterating the senders.
+func (mp *EVMMempool) recount(list *txList) {
+	mp.pending -= list.pending
+	mp.queued -= list.queued
+
+	list.pending = list.consecutive()
+	if list.pending > mp.config.AccountSlots {
+		list.pending = mp.config.AccountSlots
+	}
+	list.queued = uint64(len(list.txs)) - list.pending
+
+	mp.pending += list.pending
+	mp.queued += list.queued
+}
+
+// Dropped returns true if the ethereum txs is no longer in the mempool, i.e. it's evicted, replaced or
+// included in a block. The cosmos txs are never reported as dropped. The queued txs expired are evicted
+// by the lookup, so they are dropped on the nodes not proposing blocks as well.
+func (mp *EVMMempool) Dropped(tx cosmos.Tx) bool {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	msg, ok := unwrapEthereumTx(tx)
+	if !ok {
+		return false
+	}
+
+	pooled, ok := mp.all[msg.AsTransaction().Hash()]
+	if !ok {
+		return true
+	}
+	list := mp.accounts[pooled.sender]
+	mp.evictExpired(list, time.Now())
+	mp.removeEmpty(pooled.sender, list)
+
+	_, ok = mp.all[pooled.ethTx.Hash()]
+	return !ok
+}
+
+// removeEmpty removes the sender without any txs.
+func (mp *EVMMempool) removeEmpty(sender common.Address, list *txList) {
+	if len(list.txs) == 0 {
+		delete(mp.accounts, sender)
+	}
+}
+
+// Stats returns the number of the pending and queued ethereum txs.
+func (mp *EVMMempool) Stats() (pending, queued uint64) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	return mp.pending, mp.queued
+}
+
+// Content returns the pending and queued ethereum txs of each sender ordered by nonce, as classified by the
+// mempool with the account nonces last seen.
+func (mp *EVMMempool) Content() (pending, queued map[common.Address][]*txs.MsgEthereumTx) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	pending = make(map[common.Address][]*txs.MsgEthereumTx)
+	queued = make(map[common.Address][]*txs.MsgEthereumTx)
+	for sender, list := range mp.accounts {
+		nonces := make([]uint64, 0, len(list.txs))
+		for nonce := range list.txs {
+			nonces = append(nonces, nonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		for _, nonce := range nonces {
+			msg, _ := unwrapEthereumTx(list.txs[nonce].tx)
+			if nonce < list.nonce+list.pending {
+				pending[sender] = append(pending[sender], msg)
+			} else {
+				queued[sender] = append(queued[sender], msg)
+			}
+		}
+	}
+	return pending, queued
+}
+
+// Select implements the sdk mempool.Mempool interface. The cosmos txs are returned first, followed by the
+// executable ethereum txs, ordered by effective tip across the senders and by nonce within a sender.
+// The txs paying a fee cap lower than the current base fee are not selected.
+func (mp *EVMMempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+	now := time.Now()
+
+	var selected []cosmos.Tx
+	for iterator := mp.cosmosTxs.Select(goCtx, nil); iterator != nil; iterator = iterator.Next() {
+		selected = append(selected, iterator.Tx())
+	}
+
+	baseFee := mp.feeKeeper.GetBaseFee(ctx)
+	heads := newTxsByTip(len(mp.accounts))
+	for sender, list := range mp.accounts {
+		mp.reset(list, mp.evmKeeper.GetNonce(ctx, sender))
+		mp.evictExpired(list, now)
+		if len(list.txs) == 0 {
+			delete(mp.accounts, sender)
+			continue
+		}
+
+		executable := make([]*poolTx, 0, list.pending)
+		for nonce := list.nonce; nonce < list.nonce+list.pending; nonce++ {
+			executable = append(executable, list.txs[nonce])
+		}
+		heads.push(executable, baseFee)
+	}
+
+	selected = append(selected, heads.sort(baseFee)...)
+
+	if len(selected) == 0 {
+		return nil
+	}
+	return &iterator{txs: selected}
+}
+
+// CountTx implements the sdk mempool.Mempool interface.
+func (mp *EVMMempool) CountTx() int {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	return len(mp.all) + mp.cosmosTxs.CountTx()
+}
+
+// Remove implements the sdk mempool.Mempool interface.
+func (mp *EVMMempool) Remove(tx cosmos.Tx) error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	msg, ok := unwrapEthereumTx(tx)
+	if !ok {
+		return mp.cosmosTxs.Remove(tx)
+	}
+
+	hash := msg.AsTransaction().Hash()
+	removed, ok := mp.all[hash]
+	if !ok {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	delete(mp.all, hash)
+	list := mp.accounts[removed.sender]
+	delete(list.txs, removed.ethTx.Nonce())
+	// the first executable txs is removed once included in a block, which increases the account nonce
+	if removed.ethTx.Nonce() == list.nonce {
+		list.nonce++
+	}
+	mp.recount(list)
+	mp.removeEmpty(removed.sender, list)
+	return nil
+}
+
+// txsByTip is the executable txs of the senders, which are merged by the effective tip of their first txs.
+type txsByTip struct {
+	heads [][]*poolTx
+	tips  []*big.Int
+}
+
+func newTxsByTip(capacity int) *txsByTip {
+	return &txsByTip{
+		heads: make([][]*poolTx, 0, capacity),
+		tips:  make([]*big.Int, 0, capacity),
+	}
+}
+
+// Len implements the heap.Interface interface.
+func (t *txsByTip) Len() int { return len(t.heads) }
+
+// Less implements the heap.Interface interface, ties are broken by the time the txs were received.
+func (t *txsByTip) Less(i, j int) bool {
+	cmp := t.tips[i].Cmp(t.tips[j])
+	if cmp == 0 {
+		return t.heads[i][0].time.Before(t.heads[j][0].time)
+	}
+	return cmp > 0
+}
+
+// Swap implements the heap.Interface interface.
+func (t *txsByTip) Swap(i, j int) {
+	t.heads[i], t.heads[j] = t.heads[j], t.heads[i]
+	t.tips[i], t.tips[j] = t.tips[j], t.tips[i]
+}
+
+// Push implements the heap.Interface interface.
+func (t *txsByTip) Push(x interface{}) {
+	t.heads = append(t.heads, x.([]*poolTx))
+}
+
+// Pop implements the heap.Interface interface.
+func (t *txsByTip) Pop() interface{} {
+	n := len(t.heads) - 1
+	head := t.heads[n]
+	t.heads, t.tips = t.heads[:n], t.tips[:n]
+	return head
+}
+
+// push adds the executable txs of a sender, the txs are skipped if the first one pays a fee cap lower
+// than the base fee.
+func (t *txsByTip) push(executable []*poolTx, baseFee *big.Int) {
+	if len(executable) == 0 {
+		return
+	}
+
+	tip := executable[0].ethTx.GasTipCap()
+	if baseFee != nil {
+		if executable[0].ethTx.GasFeeCapIntCmp(baseFee) < 0 {
+			return
+		}
+		tip = executable[0].ethTx.EffectiveGasTipValue(baseFee)
+	}
+
+	t.tips = append(t.tips, tip)
+	heap.Push(t, executable)
+}
+
+// sort returns the txs ordered by effective tip, keeping the nonce order of each sender, in the same way
+// as the go-ethereum miner.
+func (t *txsByTip) sort(baseFee *big.Int) []cosmos.Tx {
+	var sorted []cosmos.Tx
+	for t.Len() > 0 {
+		executable := heap.Pop(t).([]*poolTx)
+		sorted = append(sorted, executable[0].tx)
+		t.push(executable[1:], baseFee)
+	}
+	return sorted
+}
+
+// iterator is a snapshot of the selected txs, so the mempool can be updated during the iteration.
+type iterator struct {
+	txs []cosmos.Tx
+}
+
+// Next implements the sdk mempool.Iterator interface.
+func (i *iterator) Next() sdkmempool.Iterator {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+	return &iterator{txs: i.txs[1:]}
+}
+
+// Tx implements the sdk mempool.Iterator interface.
+func (i *iterator) Tx() cosmos.Tx {
+	return i.txs[0]
+}
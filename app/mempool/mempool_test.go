@@ -0,0 +1,205 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+type mockKeepers struct {
+	nonces  map[common.Address]uint64
+	baseFee *big.Int
+}
+
+func (m *mockKeepers) GetNonce(_ cosmos.Context, addr common.Address) uint64 {
+	return m.nonces[addr]
+}
+
+func (m *mockKeepers) GetBaseFee(_ cosmos.Context) *big.Int {
+	return m.baseFee
+}
+
+var (
+	alice = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	bob   = common.HexToAddress("0x0000000000000000000000000000000000000002")
+)
+
+type cosmosTx struct{}
+
+func (cosmosTx) GetMsgs() []cosmos.Msg { return nil }
+
+func (cosmosTx) ValidateBasic() error { return nil }
+
+func newTestMempool() (*EVMMempool, *mockKeepers) {
+	keepers := &mockKeepers{nonces: make(map[common.Address]uint64), baseFee: big.NewInt(10)}
+	return NewEVMMempool(Config{
+		PriceBump:    10,
+		AccountSlots: 2,
+		AccountQueue: 2,
+		GlobalSlots:  3,
+		GlobalQueue:  3,
+		Lifetime:     time.Hour,
+	}, keepers, keepers), keepers
+}
+
+func newTestTx(t *testing.T, sender common.Address, nonce uint64, tip int64) cosmos.Tx {
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethereum.NewTx(&ethereum.DynamicFeeTx{
+		Nonce:     nonce,
+		Gas:       21000,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(100 + tip),
+		// the txs are not signed, the data makes the hash unique per sender
+		Data: sender.Bytes(),
+	})))
+	msg.From = sender.Hex()
+	return msg
+}
+
+func selectNonces(mp *EVMMempool) []uint64 {
+	var nonces []uint64
+	for iterator := mp.Select(cosmos.Context{}, nil); iterator != nil; iterator = iterator.Next() {
+		msg, _ := unwrapEthereumTx(iterator.Tx())
+		nonces = append(nonces, msg.AsTransaction().Nonce())
+	}
+	return nonces
+}
+
+func TestEVMMempoolPromotion(t *testing.T) {
+	mp, _ := newTestMempool()
+	ctx := cosmos.Context{}
+
+	// nonce 0 is missing, the txs are queued
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 1, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 2, 1)))
+	pending, queued := mp.Stats()
+	require.Equal(t, []uint64{0, 2}, []uint64{pending, queued})
+	require.ErrorIs(t, mp.Insert(ctx, newTestTx(t, alice, 3, 1)), ErrAccountLimitExceeded)
+
+	// filling the gap promotes the queued txs up to the account slots
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 0, 1)))
+	pending, queued = mp.Stats()
+	require.Equal(t, []uint64{2, 1}, []uint64{pending, queued})
+	require.Equal(t, []uint64{0, 1}, selectNonces(mp))
+	require.Equal(t, 3, mp.CountTx())
+
+	// the txs following the account slots are queued
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 4, 1)))
+	require.ErrorIs(t, mp.Insert(ctx, newTestTx(t, alice, 5, 1)), ErrAccountLimitExceeded)
+}
+
+func TestEVMMempoolGlobalSlotsPromotion(t *testing.T) {
+	mp, _ := newTestMempool()
+	ctx := cosmos.Context{}
+
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 0, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 1, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, bob, 1, 1)))
+
+	// bob's txs would promote 2 txs with only 1 global slot left
+	require.ErrorIs(t, mp.Insert(ctx, newTestTx(t, bob, 0, 1)), sdkmempool.ErrMempoolTxMaxCapacity)
+	pending, queued := mp.Stats()
+	require.Equal(t, []uint64{2, 1}, []uint64{pending, queued})
+}
+
+func TestEVMMempoolReplacement(t *testing.T) {
+	mp, _ := newTestMempool()
+	ctx := cosmos.Context{}
+
+	original := newTestTx(t, alice, 0, 10)
+	require.NoError(t, mp.Insert(ctx, original))
+	require.ErrorIs(t, mp.Insert(ctx, original), ErrAlreadyKnown)
+	require.ErrorIs(t, mp.Insert(ctx, newTestTx(t, alice, 0, 10)), ErrAlreadyKnown)
+
+	// the tip cap is bumped by 10%, but not the fee cap
+	require.ErrorIs(t, mp.Insert(ctx, newTestTx(t, alice, 0, 11)), ErrReplaceUnderpriced)
+
+	replacement := newTestTx(t, alice, 0, 30)
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.True(t, mp.Dropped(original))
+	require.False(t, mp.Dropped(replacement))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func TestEVMMempoolSelect(t *testing.T) {
+	mp, keepers := newTestMempool()
+	ctx := cosmos.Context{}
+
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 0, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 1, 5)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, bob, 3, 3)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, bob, 4, 4)))
+
+	// bob's txs are queued until his nonce gets to 3
+	require.Equal(t, []uint64{0, 1}, selectNonces(mp))
+
+	// the heads of the senders are ordered by tip, the txs of a sender by nonce
+	keepers.nonces[bob] = 3
+	require.Equal(t, []uint64{3, 4, 0, 1}, selectNonces(mp))
+
+	// the txs with a fee cap lower than the base fee are skipped
+	keepers.baseFee = big.NewInt(103)
+	require.Equal(t, []uint64{3, 4}, selectNonces(mp))
+}
+
+func TestEVMMempoolDropped(t *testing.T) {
+	mp, keepers := newTestMempool()
+	ctx := cosmos.Context{}
+
+	included := newTestTx(t, alice, 0, 1)
+	next := newTestTx(t, alice, 1, 1)
+	queued := newTestTx(t, alice, 3, 1)
+	require.NoError(t, mp.Insert(ctx, included))
+	require.NoError(t, mp.Insert(ctx, next))
+	require.NoError(t, mp.Insert(ctx, queued))
+
+	// the txs included in a block is removed, the following txs is still executable
+	require.NoError(t, mp.Remove(included))
+	keepers.nonces[alice] = 1
+	require.True(t, mp.Dropped(included))
+	pending, _ := mp.Stats()
+	require.Equal(t, uint64(1), pending)
+
+	// the expired queued txs is evicted by the lookup, the pending txs never expires
+	for _, tx := range mp.all {
+		tx.time = time.Now().Add(-2 * time.Hour)
+	}
+	require.False(t, mp.Dropped(next))
+	require.True(t, mp.Dropped(queued))
+	require.Equal(t, 1, mp.CountTx())
+
+	// cosmos txs are not tracked
+	require.False(t, mp.Dropped(cosmosTx{}))
+	require.ErrorIs(t, mp.Remove(queued), sdkmempool.ErrTxNotFound)
+}
+
+func TestEVMMempoolContent(t *testing.T) {
+	mp, _ := newTestMempool()
+	ctx := cosmos.Context{}
+
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 2, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 0, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, alice, 1, 1)))
+	require.NoError(t, mp.Insert(ctx, newTestTx(t, bob, 1, 1)))
+
+	// the txs of alice following the account slots and the txs of bob after the nonce gap are queued
+	nonces := func(msgs []*txs.MsgEthereumTx) (n []uint64) {
+		for _, msg := range msgs {
+			n = append(n, msg.AsTransaction().Nonce())
+		}
+		return n
+	}
+	pending, queued := mp.Content()
+	require.Equal(t, []uint64{0, 1}, nonces(pending[alice]))
+	require.Equal(t, []uint64{2}, nonces(queued[alice]))
+	require.Empty(t, pending[bob])
+	require.Equal(t, []uint64{1}, nonces(queued[bob]))
+}
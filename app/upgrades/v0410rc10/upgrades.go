@@ -0,0 +1,29 @@
+package v0410rc10
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	aspectmodule "github.com/artela-network/artela/x/aspect"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v0410rc10, the evm module starts recording
+// the aspect gas in the tx results, and the fee module keeps the base fee mode of gas wanted and
+// disables the aspect fee sharing until changed by governance.
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// RunMigrations runs InitGenesis for the modules missing from the version map. The aspect module
+		// is new in the module manager, but its store was added in v049rc9 and already holds the aspect
+		// states written by the evm module, so it's set to the current version to skip InitGenesis.
+		if _, ok := vm[aspectmoduletypes.ModuleName]; !ok {
+			vm[aspectmoduletypes.ModuleName] = aspectmodule.ConsensusVersion
+		}
+
+		logger.Debug("v0410rc10 running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
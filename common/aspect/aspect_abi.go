@@ -96,6 +96,21 @@ var methods = map[string]abi.Method{
 	}, []abi.Argument{
 		{Name: "account", Type: AddressArr, Indexed: false},
 	}),
+	"schedule": abi.NewMethod("schedule", "schedule", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+		{Name: "target", Type: Address, Indexed: false},
+		{Name: "data", Type: Bytes, Indexed: false},
+		{Name: "gasLimit", Type: Uint64, Indexed: false},
+		{Name: "startBlock", Type: Uint64, Indexed: false},
+		{Name: "interval", Type: Uint64, Indexed: false},
+		{Name: "count", Type: Uint64, Indexed: false},
+		{Name: "sponsored", Type: Bool, Indexed: false},
+	}, []abi.Argument{
+		{Name: "scheduleId", Type: Uint64, Indexed: false},
+	}),
+	"cancelSchedule": abi.NewMethod("cancelSchedule", "cancelSchedule", abi.Function, "", false, false, []abi.Argument{
+		{Name: "scheduleId", Type: Uint64, Indexed: false},
+	}, nil),
 	"entrypoint": abi.NewMethod("entrypoint", "entrypoint", abi.Function, "", false, false, []abi.Argument{
 		{Name: "aspectId", Type: Address, Indexed: false},
 		{Name: "optArgs", Type: Bytes, Indexed: false},
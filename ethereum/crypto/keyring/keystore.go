@@ -0,0 +1,191 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+// KeystoreDirName is the name of the directory keeping the key files, under the keyring directory
+const KeystoreDirName = "keystore"
+
+// Keystore keeps the Web3 Secret Storage (v3 JSON) key files of the accounts managed through the
+// personal api, which protect the keys in the Cosmos keyring with per account passwords, and the
+// unlock states of the accounts.
+type Keystore struct {
+	dir     string
+	scryptN int
+	scryptP int
+	unlocks *UnlockCache
+}
+
+// NewKeystore creates a keystore keeping the key files in the given directory, the keys are
+// encrypted with the standard scrypt parameters of go-ethereum.
+func NewKeystore(dir string) *Keystore {
+	return &Keystore{
+		dir:     dir,
+		scryptN: keystore.StandardScryptN,
+		scryptP: keystore.StandardScryptP,
+		unlocks: NewUnlockCache(),
+	}
+}
+
+// Store encrypts the private key with the password and writes the key file, replacing the
+// existing one of the same account. The key JSON is returned.
+func (ks *Keystore) Store(privKey *ecdsa.PrivateKey, password string) ([]byte, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("could not create random uuid: %w", err)
+	}
+
+	key := &keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}
+	keyJSON, err := keystore.EncryptKey(key, password, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ks.Write(key.Address, keyJSON); err != nil {
+		return nil, err
+	}
+	return keyJSON, nil
+}
+
+// Import decrypts the key JSON with the password and writes the key file, replacing the existing
+// one of the same account. The decrypted private key is returned.
+func (ks *Keystore) Import(keyJSON []byte, password string) (*ecdsa.PrivateKey, error) {
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ks.Write(key.Address, keyJSON); err != nil {
+		return nil, err
+	}
+	return key.PrivateKey, nil
+}
+
+// Export returns the key JSON of the account, if the password decrypts it.
+func (ks *Keystore) Export(address common.Address, password string) ([]byte, error) {
+	keyJSON, _, err := ks.decrypt(address, password)
+	if err != nil {
+		return nil, err
+	}
+	return keyJSON, nil
+}
+
+// Unlock unlocks the account for the given duration if the password decrypts its key file,
+// zero duration unlocks the account until it's locked explicitly.
+func (ks *Keystore) Unlock(address common.Address, password string, duration time.Duration) error {
+	if _, _, err := ks.decrypt(address, password); err != nil {
+		return err
+	}
+
+	ks.unlocks.Unlock(address, duration)
+	return nil
+}
+
+// Lock locks the account, it returns false if the account is not unlocked.
+func (ks *Keystore) Lock(address common.Address) bool {
+	return ks.unlocks.Lock(address)
+}
+
+// Authorize returns nil if the account is unlocked, or the password decrypts its key file.
+func (ks *Keystore) Authorize(address common.Address, password string) error {
+	if ks.unlocks.IsUnlocked(address) {
+		return nil
+	}
+
+	_, _, err := ks.decrypt(address, password)
+	return err
+}
+
+// Has returns whether the account has a key file.
+func (ks *Keystore) Has(address common.Address) bool {
+	_, err := ks.find(address)
+	return err == nil
+}
+
+// decrypt reads the key file of the account and decrypts it with the password.
+func (ks *Keystore) decrypt(address common.Address, password string) ([]byte, *keystore.Key, error) {
+	path, err := ks.find(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyJSON, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	// make sure the file is not replaced by the key of another account
+	if key.Address != address {
+		return nil, nil, fmt.Errorf("key content mismatch: have account %x, want %x", key.Address, address)
+	}
+	return keyJSON, key, nil
+}
+
+// find returns the path of the key file of the account.
+func (ks *Keystore) find(address common.Address) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(ks.dir, "*--"+addressFileSuffix(address)))
+	if err != nil {
+		return "", err
+	}
+	if len(matches) == 0 {
+		return "", keystore.ErrNoMatch
+	}
+	return matches[len(matches)-1], nil
+}
+
+// Write writes the key JSON of the account as its key file with the file name format of
+// go-ethereum, the existing key files of the account are removed. The key JSON is not
+// verified, it must have been decrypted by the caller.
+func (ks *Keystore) Write(address common.Address, keyJSON []byte) error {
+	if err := os.MkdirAll(ks.dir, 0o700); err != nil {
+		return err
+	}
+
+	existing, err := filepath.Glob(filepath.Join(ks.dir, "*--"+addressFileSuffix(address)))
+	if err != nil {
+		return err
+	}
+
+	ts := time.Now().UTC()
+	name := fmt.Sprintf("UTC--%s--%s", toISO8601(ts), addressFileSuffix(address))
+	if err := os.WriteFile(filepath.Join(ks.dir, name), keyJSON, 0o600); err != nil {
+		return err
+	}
+
+	for _, path := range existing {
+		if filepath.Base(path) != name {
+			_ = os.Remove(path) // #nosec G104
+		}
+	}
+	return nil
+}
+
+// addressFileSuffix returns the suffix of the key file name of the account.
+func addressFileSuffix(address common.Address) string {
+	return strings.ToLower(strings.TrimPrefix(address.Hex(), "0x"))
+}
+
+// toISO8601 formats the time same as go-ethereum, the colons are replaced to be valid in file names.
+func toISO8601(t time.Time) string {
+	return fmt.Sprintf("%04d-%02d-%02dT%02d-%02d-%02d.%09dZ",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
@@ -0,0 +1,75 @@
+package keyring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeystore(t *testing.T) *Keystore {
+	ks := NewKeystore(t.TempDir())
+	ks.scryptN, ks.scryptP = keystore.LightScryptN, keystore.LightScryptP
+	return ks
+}
+
+func TestKeystoreUnlock(t *testing.T) {
+	ks := newTestKeystore(t)
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(priv.PublicKey)
+
+	_, err = ks.Store(priv, "foo")
+	require.NoError(t, err)
+
+	// locked account requires the password
+	require.ErrorIs(t, ks.Authorize(addr, "bar"), keystore.ErrDecrypt)
+	require.NoError(t, ks.Authorize(addr, "foo"))
+
+	require.ErrorIs(t, ks.Unlock(addr, "bar", 0), keystore.ErrDecrypt)
+	require.NoError(t, ks.Unlock(addr, "foo", 0))
+	require.NoError(t, ks.Authorize(addr, ""))
+
+	require.True(t, ks.Lock(addr))
+	require.False(t, ks.Lock(addr))
+	require.ErrorIs(t, ks.Authorize(addr, ""), keystore.ErrDecrypt)
+
+	// timed unlock expires
+	require.NoError(t, ks.Unlock(addr, "foo", 50*time.Millisecond))
+	require.NoError(t, ks.Authorize(addr, ""))
+	time.Sleep(100 * time.Millisecond)
+	require.ErrorIs(t, ks.Authorize(addr, ""), keystore.ErrDecrypt)
+}
+
+func TestKeystoreImportExport(t *testing.T) {
+	ks := newTestKeystore(t)
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(priv.PublicKey)
+
+	keyJSON, err := ks.Store(priv, "foo")
+	require.NoError(t, err)
+
+	_, err = ks.Export(addr, "bar")
+	require.ErrorIs(t, err, keystore.ErrDecrypt)
+	exported, err := ks.Export(addr, "foo")
+	require.NoError(t, err)
+	require.Equal(t, keyJSON, exported)
+
+	other := newTestKeystore(t)
+	_, err = other.Import(exported, "bar")
+	require.ErrorIs(t, err, keystore.ErrDecrypt)
+	require.False(t, other.Has(addr))
+	imported, err := other.Import(exported, "foo")
+	require.NoError(t, err)
+	require.True(t, other.Has(addr))
+	require.Equal(t, crypto.FromECDSA(priv), crypto.FromECDSA(imported))
+	require.NoError(t, other.Unlock(addr, "foo", 0))
+
+	unknown, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, err = other.Export(crypto.PubkeyToAddress(unknown.PublicKey), "foo")
+	require.ErrorIs(t, err, keystore.ErrNoMatch)
+}
@@ -0,0 +1,68 @@
+package keyring
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// UnlockCache keeps the unlock states of the accounts. An account unlocked with a
+// positive duration is locked again once the duration elapsed, an account unlocked
+// with zero duration stays unlocked until it's locked explicitly.
+type UnlockCache struct {
+	mu sync.Mutex
+	// expiry time of the unlocked accounts, zero if it never expires
+	unlocked map[common.Address]time.Time
+}
+
+// NewUnlockCache creates an unlock cache with all the accounts locked.
+func NewUnlockCache() *UnlockCache {
+	return &UnlockCache{
+		unlocked: make(map[common.Address]time.Time),
+	}
+}
+
+// Unlock unlocks the account for the given duration, it replaces the duration of
+// the account if it's already unlocked.
+func (c *UnlockCache) Unlock(address common.Address, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiry time.Time
+	if duration > 0 {
+		expiry = time.Now().Add(duration)
+	}
+	c.unlocked[address] = expiry
+}
+
+// Lock locks the account, it returns false if the account is not unlocked.
+func (c *UnlockCache) Lock(address common.Address) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	unlocked := c.isUnlocked(address)
+	delete(c.unlocked, address)
+	return unlocked
+}
+
+// IsUnlocked returns whether the account is unlocked.
+func (c *UnlockCache) IsUnlocked(address common.Address) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.isUnlocked(address)
+}
+
+// isUnlocked returns whether the account is unlocked, the expired unlock state is dropped.
+func (c *UnlockCache) isUnlocked(address common.Address) bool {
+	expiry, ok := c.unlocked[address]
+	if !ok {
+		return false
+	}
+	if !expiry.IsZero() && !time.Now().Before(expiry) {
+		delete(c.unlocked, address)
+		return false
+	}
+	return true
+}
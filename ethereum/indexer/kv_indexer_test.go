@@ -0,0 +1,173 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/ethereum/indexer"
+	"github.com/artela-network/artela/x/evm/txs"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func TestKVIndexer(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethereum.LatestSignerForChainID(big.NewInt(11820))
+	to := common.BigToAddress(big.NewInt(1))
+	ethTx, err := ethereum.SignNewTx(priv, signer, &ethereum.LegacyTx{
+		Nonce:    0,
+		To:       &to,
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	encodingConfig := app.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Marshaler)
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aart")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// a cosmos tx without the ethereum tx extension option
+	cosmosTxBz, err := clientCtx.TxConfig.TxEncoder()(clientCtx.TxConfig.NewTxBuilder().GetTx())
+	require.NoError(t, err)
+
+	txEvents := []abci.Event{
+		{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyEthereumTxHash, Value: ethTx.Hash().Hex()},
+			{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+			{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+		}},
+	}
+
+	testCases := []struct {
+		name        string
+		block       *tmtypes.Block
+		blockResult []*abci.ResponseDeliverTx
+		expSuccess  bool
+	}{
+		{
+			"success, format 1",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0, GasUsed: 21000, Events: txEvents}},
+			true,
+		},
+		{
+			"success, format 2",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0, GasUsed: 21000, Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: ethTx.Hash().Hex()},
+					{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+				}},
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+				}},
+			}}},
+			true,
+		},
+		{
+			"success, exceeds block gas limit",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 11, Log: "out of gas in location: block gas meter; gasWanted: 21000"}},
+			true,
+		},
+		{
+			"fail, failed eth tx",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 15, Log: "nonce mismatch"}},
+			false,
+		},
+		{
+			"fail, invalid events",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0, Events: []abci.Event{}}},
+			false,
+		},
+		{
+			"fail, not eth tx",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{cosmosTxBz}}},
+			[]*abci.ResponseDeliverTx{{Code: 0, GasUsed: 21000, Events: txEvents}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+			require.NoError(t, idxer.IndexBlock(tc.block, tc.blockResult))
+			if !tc.expSuccess {
+				first, err := idxer.FirstIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), first)
+
+				last, err := idxer.LastIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), last)
+
+				_, err = idxer.GetByTxHash(ethTx.Hash())
+				require.Error(t, err)
+				return
+			}
+
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, first)
+
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, last)
+
+			res1, err := idxer.GetByTxHash(ethTx.Hash())
+			require.NoError(t, err)
+			require.NotNil(t, res1)
+			require.Equal(t, uint64(21000), res1.GasUsed)
+
+			res2, err := idxer.GetByBlockAndIndex(1, 0)
+			require.NoError(t, err)
+			require.Equal(t, res1, res2)
+		})
+	}
+}
+
+func TestStartHeight(t *testing.T) {
+	testCases := []struct {
+		name           string
+		lastIndexed    int64
+		earliestBlock  int64
+		backfillHeight int64
+		expStart       int64
+	}{
+		{"nothing indexed", -1, 1, 0, 1},
+		{"nothing indexed, pruned node", -1, 100, 0, 100},
+		{"continue from the last indexed block", 50, 1, 0, 51},
+		{"backfill", 50, 1, 10, 10},
+		{"backfill the last indexed block", 50, 1, 50, 50},
+		{"backfill above the last indexed block is skipped", 50, 1, 60, 51},
+		{"backfill below the earliest block", 50, 20, 10, 20},
+		{"backfill with nothing indexed", -1, 1, 10, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start := indexer.StartHeight(log.NewNopLogger(), tc.lastIndexed, tc.earliestBlock, tc.backfillHeight)
+			require.Equal(t, tc.expStart, start)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Package proof defines the merkle proof format returned by the Artela json-rpc proof apis,
+// and provides the verifier to check the proven account and storage states against the app hash.
+//
+// Artela keeps the EVM states in the cosmos multistore instead of a Merkle Patricia Trie, so the
+// proofs are ICS23 commitment proofs rather than MPT node lists. Each key-value pair is proven by
+// a proof chain with two operations:
+//
+//  1. an "ics23:iavl" op, which proves the key (or its absence) in the IAVL tree of the module store,
+//     and computes the root hash of the module store;
+//  2. an "ics23:simple" op, which proves the module store root under the store name in the
+//     multistore, and computes the app hash.
+//
+// The states of an account are proven by several entries in different module stores:
+//
+//   - nonce and code hash: the account entry in the "acc" store, keyed by authtypes.AddressStoreKey(address);
+//   - balance: the balance entry of the evm denom in the "bank" store, keyed by
+//     banktypes.CreateAccountBalancesPrefix(address) + denom, absent if the balance is zero;
+//   - storage slot: the states entry in the "evm" store, keyed by evmtypes.StateKey(address, slot),
+//     absent if the slot is empty.
+//
+// The proofs of height H are generated against the committed state of block H, whose app hash is
+// recorded in the header of block H+1.
+package proof
+
+import (
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ProofOp is a single operation of the ICS23 proof chain.
+type ProofOp struct {
+	// Type is the type of the proof operation, "ics23:iavl" or "ics23:simple"
+	Type string `json:"type"`
+	// Key is the key proven by the operation, the key in module store for
+	// "ics23:iavl" and the store name for "ics23:simple"
+	Key hexutil.Bytes `json:"key"`
+	// Data is the protobuf encoded ics23.CommitmentProof
+	Data hexutil.Bytes `json:"data"`
+}
+
+// KeyProof proves a key-value pair, or the absence of the key, in a module store.
+type KeyProof struct {
+	StoreName string        `json:"storeName"`
+	Key       hexutil.Bytes `json:"key"`
+	// Value is the raw value of the key, empty if the key is absent
+	Value hexutil.Bytes `json:"value"`
+	Proof []ProofOp     `json:"proof"`
+}
+
+// StorageProof is the proof of a storage slot of an account.
+type StorageProof struct {
+	Key   common.Hash `json:"key"`
+	Value common.Hash `json:"value"`
+	Proof KeyProof    `json:"proof"`
+}
+
+// AccountProof is the full ICS23 proof chain of an account and its storage slots.
+type AccountProof struct {
+	Address common.Address `json:"address"`
+	// Height is the height of the proven states
+	Height hexutil.Uint64 `json:"height"`
+	// AppHash is the root hash the proofs can be verified against,
+	// which is the app hash in the header of block Height+1
+	AppHash  hexutil.Bytes  `json:"appHash"`
+	Nonce    hexutil.Uint64 `json:"nonce"`
+	Balance  *hexutil.Big   `json:"balance"`
+	CodeHash common.Hash    `json:"codeHash"`
+	// EvmDenom is the denom of the evm native token, used to build the balance key
+	EvmDenom     string         `json:"evmDenom"`
+	AccountProof KeyProof       `json:"accountProof"`
+	BalanceProof KeyProof       `json:"balanceProof"`
+	StorageProof []StorageProof `json:"storageProof"`
+}
+
+// NewProofOps converts the tendermint proof ops into the json-rpc proof format.
+func NewProofOps(ops *cmtcrypto.ProofOps) []ProofOp {
+	if ops == nil {
+		return nil
+	}
+
+	result := make([]ProofOp, 0, len(ops.Ops))
+	for _, op := range ops.Ops {
+		result = append(result, ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		})
+	}
+	return result
+}
+
+// ToProofOps converts the proof chain back to the tendermint proof ops.
+func (kp KeyProof) ToProofOps() *cmtcrypto.ProofOps {
+	ops := make([]cmtcrypto.ProofOp, 0, len(kp.Proof))
+	for _, op := range kp.Proof {
+		ops = append(ops, cmtcrypto.ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		})
+	}
+	return &cmtcrypto.ProofOps{Ops: ops}
+}
@@ -0,0 +1,173 @@
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+var (
+	emptyCodeHash = crypto.Keccak256Hash(nil)
+
+	accountCodec = newAccountCodec()
+)
+
+func newAccountCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	artela.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// Verify checks the account states and all the storage slots in the proof against the given app hash,
+// which should be the app hash in the header of block Height+1 obtained from a trusted source.
+func (p *AccountProof) Verify(appHash []byte) error {
+	if !bytes.Equal(p.AppHash, appHash) {
+		return fmt.Errorf("app hash mismatch, expected %X, got %X", appHash, []byte(p.AppHash))
+	}
+	if err := VerifyAccount(appHash, p.Address, uint64(p.Nonce), p.CodeHash, p.AccountProof); err != nil {
+		return err
+	}
+	if err := VerifyBalance(appHash, p.Address, p.EvmDenom, p.Balance.ToInt(), p.BalanceProof); err != nil {
+		return err
+	}
+	for _, storage := range p.StorageProof {
+		if err := VerifyStorage(appHash, p.Address, storage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyAccount checks the nonce and code hash of the account against the app hash.
+func VerifyAccount(appHash []byte, address common.Address, nonce uint64, codeHash common.Hash, proof KeyProof) error {
+	if err := verifyKey(proof, authtypes.StoreKey, authtypes.AddressStoreKey(sdktypes.AccAddress(address.Bytes()))); err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	// the account does not exist
+	if len(proof.Value) == 0 {
+		if nonce != 0 || codeHash != emptyCodeHash {
+			return fmt.Errorf("account %s does not exist, but got nonce %d and code hash %s", address.Hex(), nonce, codeHash.Hex())
+		}
+		return verifyAbsence(appHash, proof)
+	}
+
+	var account authtypes.AccountI
+	if err := accountCodec.UnmarshalInterface(proof.Value, &account); err != nil {
+		return fmt.Errorf("failed to decode account %s: %w", address.Hex(), err)
+	}
+	if account.GetSequence() != nonce {
+		return fmt.Errorf("nonce mismatch, expected %d, got %d", account.GetSequence(), nonce)
+	}
+	provenCodeHash := emptyCodeHash
+	if ethAccount, ok := account.(artela.EthAccountI); ok {
+		provenCodeHash = ethAccount.GetCodeHash()
+	}
+	if provenCodeHash != codeHash {
+		return fmt.Errorf("code hash mismatch, expected %s, got %s", provenCodeHash.Hex(), codeHash.Hex())
+	}
+
+	return verifyValue(appHash, proof)
+}
+
+// VerifyBalance checks the balance of the account in evm denom against the app hash.
+func VerifyBalance(appHash []byte, address common.Address, denom string, balance *big.Int, proof KeyProof) error {
+	key := append(banktypes.CreateAccountBalancesPrefix(address.Bytes()), []byte(denom)...)
+	if err := verifyKey(proof, banktypes.StoreKey, key); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+
+	// zero balances are not persisted
+	if len(proof.Value) == 0 {
+		if balance != nil && balance.Sign() != 0 {
+			return fmt.Errorf("balance of %s is zero, but got %s", address.Hex(), balance)
+		}
+		return verifyAbsence(appHash, proof)
+	}
+
+	amount, err := unmarshalBalance(proof.Value, denom)
+	if err != nil {
+		return fmt.Errorf("failed to decode balance of %s: %w", address.Hex(), err)
+	}
+	if balance == nil || amount.BigInt().Cmp(balance) != 0 {
+		return fmt.Errorf("balance mismatch, expected %s, got %s", amount, balance)
+	}
+
+	return verifyValue(appHash, proof)
+}
+
+// VerifyStorage checks the value of the storage slot of the account against the app hash.
+func VerifyStorage(appHash []byte, address common.Address, storage StorageProof) error {
+	if err := verifyKey(storage.Proof, evmtypes.StoreKey, evmtypes.StateKey(address, storage.Key.Bytes())); err != nil {
+		return fmt.Errorf("invalid storage proof of slot %s: %w", storage.Key.Hex(), err)
+	}
+
+	// empty slots are not persisted
+	if len(storage.Proof.Value) == 0 {
+		if storage.Value != (common.Hash{}) {
+			return fmt.Errorf("slot %s is empty, but got %s", storage.Key.Hex(), storage.Value.Hex())
+		}
+		return verifyAbsence(appHash, storage.Proof)
+	}
+
+	if value := common.BytesToHash(storage.Proof.Value); value != storage.Value {
+		return fmt.Errorf("value mismatch of slot %s, expected %s, got %s", storage.Key.Hex(), value.Hex(), storage.Value.Hex())
+	}
+
+	return verifyValue(appHash, storage.Proof)
+}
+
+// verifyKey checks the proof is generated for the expected key in the expected store.
+func verifyKey(proof KeyProof, storeName string, key []byte) error {
+	if proof.StoreName != storeName {
+		return fmt.Errorf("store name mismatch, expected %s, got %s", storeName, proof.StoreName)
+	}
+	if !bytes.Equal(proof.Key, key) {
+		return fmt.Errorf("key mismatch, expected %X, got %X", key, []byte(proof.Key))
+	}
+	return nil
+}
+
+func verifyValue(appHash []byte, proof KeyProof) error {
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof.ToProofOps(), appHash, keyPath(proof), proof.Value)
+}
+
+func verifyAbsence(appHash []byte, proof KeyProof) error {
+	return rootmulti.DefaultProofRuntime().VerifyAbsence(proof.ToProofOps(), appHash, keyPath(proof))
+}
+
+func keyPath(proof KeyProof) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(proof.StoreName), merkle.KeyEncodingURL).
+		AppendKey(proof.Key, merkle.KeyEncodingHex).
+		String()
+}
+
+// unmarshalBalance decodes the balance amount saved in the bank store,
+// the legacy format which saves the whole coin is also supported.
+func unmarshalBalance(bz []byte, denom string) (sdkmath.Int, error) {
+	amount := sdkmath.ZeroInt()
+	if err := amount.Unmarshal(bz); err != nil {
+		var coin sdktypes.Coin
+		if accountCodec.Unmarshal(bz, &coin) != nil || coin.Denom != denom {
+			return sdkmath.Int{}, err
+		}
+		return coin.Amount, nil
+	}
+	return amount, nil
+}
@@ -0,0 +1,249 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+const testDenom = "aart"
+
+var (
+	testAddress  = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	otherAddress = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	testCodeHash = crypto.Keccak256Hash([]byte("code"))
+	testSlot     = common.BigToHash(big.NewInt(1))
+	emptySlot    = common.BigToHash(big.NewInt(2))
+	testValue    = common.BigToHash(big.NewInt(100))
+)
+
+// proofTestStore is a committed multistore with the acc, bank and evm stores.
+type proofTestStore struct {
+	cms     *rootmulti.Store
+	appHash []byte
+}
+
+func newProofTestStore(t *testing.T) *proofTestStore {
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db, log.NewNopLogger())
+
+	keys := map[string]*storetypes.KVStoreKey{}
+	for _, name := range []string{authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey} {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		cms.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	account := &artela.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdktypes.AccAddress(testAddress.Bytes()), nil, 0, 5),
+		CodeHash:    testCodeHash.Hex(),
+	}
+	accountBz, err := accountCodec.MarshalInterface(account)
+	require.NoError(t, err)
+	cms.GetKVStore(keys[authtypes.StoreKey]).Set(authtypes.AddressStoreKey(sdktypes.AccAddress(testAddress.Bytes())), accountBz)
+
+	balanceBz, err := sdkmath.NewInt(1000).Marshal()
+	require.NoError(t, err)
+	cms.GetKVStore(keys[banktypes.StoreKey]).Set(balanceKey(testAddress), balanceBz)
+
+	cms.GetKVStore(keys[evmtypes.StoreKey]).Set(evmtypes.StateKey(testAddress, testSlot.Bytes()), testValue.Bytes())
+
+	commitID := cms.Commit()
+	return &proofTestStore{cms: cms, appHash: commitID.Hash}
+}
+
+func balanceKey(address common.Address) []byte {
+	return append(banktypes.CreateAccountBalancesPrefix(address.Bytes()), []byte(testDenom)...)
+}
+
+// prove queries the key with proof in the store, same as the json-rpc proof api.
+func (s *proofTestStore) prove(t *testing.T, storeName string, key []byte) KeyProof {
+	res := s.cms.Query(abci.RequestQuery{
+		Path:   "/" + storeName + "/key",
+		Data:   key,
+		Height: s.cms.LastCommitID().Version,
+		Prove:  true,
+	})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	return KeyProof{
+		StoreName: storeName,
+		Key:       key,
+		Value:     res.Value,
+		Proof:     NewProofOps(res.ProofOps),
+	}
+}
+
+func (s *proofTestStore) proveStorage(t *testing.T, address common.Address, slot common.Hash) KeyProof {
+	return s.prove(t, evmtypes.StoreKey, evmtypes.StateKey(address, slot.Bytes()))
+}
+
+func (s *proofTestStore) proveAccount(t *testing.T, address common.Address) KeyProof {
+	return s.prove(t, authtypes.StoreKey, authtypes.AddressStoreKey(sdktypes.AccAddress(address.Bytes())))
+}
+
+func TestVerifyAccount(t *testing.T) {
+	s := newProofTestStore(t)
+	accountProof := s.proveAccount(t, testAddress)
+	absentProof := s.proveAccount(t, otherAddress)
+	require.Empty(t, absentProof.Value)
+
+	testCases := []struct {
+		name     string
+		address  common.Address
+		nonce    uint64
+		codeHash common.Hash
+		proof    KeyProof
+		appHash  []byte
+		expPass  bool
+	}{
+		{"existing account", testAddress, 5, testCodeHash, accountProof, s.appHash, true},
+		{"absent account", otherAddress, 0, emptyCodeHash, absentProof, s.appHash, true},
+		{"nonce mismatch", testAddress, 4, testCodeHash, accountProof, s.appHash, false},
+		{"code hash mismatch", testAddress, 5, emptyCodeHash, accountProof, s.appHash, false},
+		{"proof of another account", otherAddress, 5, testCodeHash, accountProof, s.appHash, false},
+		{"absent account with nonce", otherAddress, 1, emptyCodeHash, absentProof, s.appHash, false},
+		{"wrong app hash", testAddress, 5, testCodeHash, accountProof, crypto.Keccak256([]byte("app hash")), false},
+		{"wrong app hash of absent account", otherAddress, 0, emptyCodeHash, absentProof, crypto.Keccak256([]byte("app hash")), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyAccount(tc.appHash, tc.address, tc.nonce, tc.codeHash, tc.proof)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAccountTamperedProof(t *testing.T) {
+	s := newProofTestStore(t)
+
+	// forge the nonce in the proven value
+	tampered := s.proveAccount(t, testAddress)
+	account := &artela.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdktypes.AccAddress(testAddress.Bytes()), nil, 0, 6),
+		CodeHash:    testCodeHash.Hex(),
+	}
+	accountBz, err := accountCodec.MarshalInterface(account)
+	require.NoError(t, err)
+	tampered.Value = accountBz
+	require.Error(t, VerifyAccount(s.appHash, testAddress, 6, testCodeHash, tampered))
+
+	// claim an existing account to be absent
+	hidden := s.proveAccount(t, testAddress)
+	hidden.Value = nil
+	require.Error(t, VerifyAccount(s.appHash, testAddress, 0, emptyCodeHash, hidden))
+
+	// drop the multistore proof op
+	truncated := s.proveAccount(t, testAddress)
+	truncated.Proof = truncated.Proof[:1]
+	require.Error(t, VerifyAccount(s.appHash, testAddress, 5, testCodeHash, truncated))
+}
+
+func TestVerifyStorage(t *testing.T) {
+	s := newProofTestStore(t)
+	slotProof := s.proveStorage(t, testAddress, testSlot)
+	emptyProof := s.proveStorage(t, testAddress, emptySlot)
+	require.Empty(t, emptyProof.Value)
+
+	testCases := []struct {
+		name    string
+		address common.Address
+		storage StorageProof
+		expPass bool
+	}{
+		{"existing slot", testAddress, StorageProof{Key: testSlot, Value: testValue, Proof: slotProof}, true},
+		{"empty slot", testAddress, StorageProof{Key: emptySlot, Proof: emptyProof}, true},
+		{"value mismatch", testAddress, StorageProof{Key: testSlot, Value: common.BigToHash(big.NewInt(101)), Proof: slotProof}, false},
+		{"empty slot with value", testAddress, StorageProof{Key: emptySlot, Value: testValue, Proof: emptyProof}, false},
+		{"proof of another slot", testAddress, StorageProof{Key: emptySlot, Value: testValue, Proof: slotProof}, false},
+		{"proof of another account", otherAddress, StorageProof{Key: testSlot, Value: testValue, Proof: slotProof}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyStorage(s.appHash, tc.address, tc.storage)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// forge the proven value
+	tampered := s.proveStorage(t, testAddress, testSlot)
+	forged := common.BigToHash(big.NewInt(101))
+	tampered.Value = forged.Bytes()
+	require.Error(t, VerifyStorage(s.appHash, testAddress, StorageProof{Key: testSlot, Value: forged, Proof: tampered}))
+
+	// proof from another store
+	wrongStore := s.proveStorage(t, testAddress, testSlot)
+	wrongStore.StoreName = banktypes.StoreKey
+	require.Error(t, VerifyStorage(s.appHash, testAddress, StorageProof{Key: testSlot, Value: testValue, Proof: wrongStore}))
+}
+
+func TestAccountProofVerify(t *testing.T) {
+	s := newProofTestStore(t)
+
+	newProof := func() *AccountProof {
+		return &AccountProof{
+			Address:      testAddress,
+			AppHash:      s.appHash,
+			Nonce:        5,
+			Balance:      (*hexutil.Big)(big.NewInt(1000)),
+			CodeHash:     testCodeHash,
+			EvmDenom:     testDenom,
+			AccountProof: s.proveAccount(t, testAddress),
+			BalanceProof: s.prove(t, banktypes.StoreKey, balanceKey(testAddress)),
+			StorageProof: []StorageProof{
+				{Key: testSlot, Value: testValue, Proof: s.proveStorage(t, testAddress, testSlot)},
+				{Key: emptySlot, Proof: s.proveStorage(t, testAddress, emptySlot)},
+			},
+		}
+	}
+
+	require.NoError(t, newProof().Verify(s.appHash))
+	require.Error(t, newProof().Verify(crypto.Keccak256([]byte("app hash"))))
+
+	p := newProof()
+	p.Balance = (*hexutil.Big)(big.NewInt(999))
+	require.Error(t, p.Verify(s.appHash))
+
+	p = newProof()
+	p.StorageProof[1].Value = testValue
+	require.Error(t, p.Verify(s.appHash))
+
+	// zero balance of an absent account
+	absent := &AccountProof{
+		Address:      otherAddress,
+		AppHash:      s.appHash,
+		Balance:      (*hexutil.Big)(big.NewInt(0)),
+		CodeHash:     emptyCodeHash,
+		EvmDenom:     testDenom,
+		AccountProof: s.proveAccount(t, otherAddress),
+		BalanceProof: s.prove(t, banktypes.StoreKey, balanceKey(otherAddress)),
+	}
+	require.NoError(t, absent.Verify(s.appHash))
+	absent.Balance = (*hexutil.Big)(big.NewInt(1))
+	require.Error(t, absent.Verify(s.appHash))
+}
@@ -0,0 +1,330 @@
+package api
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	aspecttypes "github.com/artela-network/artela/x/aspect/types"
+)
+
+// AspectAPI offers the aspect related RPC methods, all the queries are served
+// from the aspect stores at the given block.
+type AspectAPI struct {
+	b      rpctypes.AspectBackend
+	logger log.Logger
+}
+
+// NewAspectAPI creates a new aspect API instance.
+func NewAspectAPI(b rpctypes.AspectBackend, logger log.Logger) *AspectAPI {
+	return &AspectAPI{b, logger}
+}
+
+// AspectVersionResult is the meta of a deployed aspect version.
+type AspectVersionResult struct {
+	Version   hexutil.Uint64 `json:"version"`
+	JoinPoint hexutil.Uint64 `json:"joinPoint"`
+	CodeHash  common.Hash    `json:"codeHash"`
+}
+
+// AspectMetaResult is the meta of an aspect.
+type AspectMetaResult struct {
+	AspectID      common.Address        `json:"aspectId"`
+	PayMaster     common.Address        `json:"payMaster"`
+	Proof         hexutil.Bytes         `json:"proof"`
+	LatestVersion hexutil.Uint64        `json:"latestVersion"`
+	StoreVersion  hexutil.Uint          `json:"storeVersion"`
+	Versions      []AspectVersionResult `json:"versions"`
+}
+
+// AspectBindingResult is a binding between an aspect and an account.
+type AspectBindingResult struct {
+	AspectID  common.Address `json:"aspectId"`
+	Account   common.Address `json:"account"`
+	Version   hexutil.Uint64 `json:"version"`
+	Priority  int32          `json:"priority"`
+	JoinPoint hexutil.Uint   `json:"joinPoint"`
+}
+
+// AspectStateResult is a key-value pair in the state of an aspect.
+type AspectStateResult struct {
+	Key   hexutil.Bytes `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+}
+
+// AspectStateChangeResult is the change of a state key, From is empty if the key is created,
+// and To is empty if the key is deleted.
+type AspectStateChangeResult struct {
+	Key  hexutil.Bytes `json:"key"`
+	From hexutil.Bytes `json:"from"`
+	To   hexutil.Bytes `json:"to"`
+}
+
+// AspectStateDiffResult is the state changes of an aspect between two blocks.
+type AspectStateDiffResult struct {
+	AspectID  common.Address            `json:"aspectId"`
+	FromBlock hexutil.Uint64            `json:"fromBlock"`
+	ToBlock   hexutil.Uint64            `json:"toBlock"`
+	Changes   []AspectStateChangeResult `json:"changes"`
+}
+
+// AspectSimulationResult is the result of a simulated transaction, Aspects are the traces of
+// the aspects executed at each join point, with the gas used, output and revert reason.
+type AspectSimulationResult struct {
+	Sender      *common.Address `json:"sender"`
+	VerifyError string          `json:"verifyError,omitempty"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	ReturnData  hexutil.Bytes   `json:"returnData"`
+	Error       string          `json:"error,omitempty"`
+	Aspects     json.RawMessage `json:"aspects"`
+}
+
+// AspectScheduleResult is a call scheduled for an aspect, LastResult is nil if never executed.
+type AspectScheduleResult struct {
+	ID         hexutil.Uint64                 `json:"id"`
+	AspectID   common.Address                 `json:"aspectId"`
+	Creator    common.Address                 `json:"creator"`
+	Payer      common.Address                 `json:"payer"`
+	Target     common.Address                 `json:"target"`
+	Calldata   hexutil.Bytes                  `json:"calldata"`
+	GasLimit   hexutil.Uint64                 `json:"gasLimit"`
+	NextBlock  hexutil.Uint64                 `json:"nextBlock"`
+	Interval   hexutil.Uint64                 `json:"interval"`
+	Count      hexutil.Uint64                 `json:"count"`
+	Executed   hexutil.Uint64                 `json:"executed"`
+	Status     string                         `json:"status"`
+	LastResult *AspectScheduleExecutionResult `json:"lastResult"`
+}
+
+// AspectScheduleExecutionResult is the result of a scheduled call execution.
+type AspectScheduleExecutionResult struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	ReturnData  hexutil.Bytes  `json:"returnData"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// GetMeta returns the meta and the deployed versions of the aspect.
+func (api *AspectAPI) GetMeta(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*AspectMetaResult, error) {
+	meta, versions, err := api.b.GetAspectMeta(aspectID, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AspectMetaResult{
+		AspectID:      common.HexToAddress(meta.AspectId),
+		PayMaster:     common.HexToAddress(meta.PayMaster),
+		Proof:         meta.Proof,
+		LatestVersion: hexutil.Uint64(meta.LatestVersion),
+		StoreVersion:  hexutil.Uint(meta.StoreVersion),
+		Versions:      make([]AspectVersionResult, 0, len(versions.Versions)),
+	}
+	for _, version := range versions.Versions {
+		result.Versions = append(result.Versions, AspectVersionResult{
+			Version:   hexutil.Uint64(version.Version),
+			JoinPoint: hexutil.Uint64(version.JoinPoint),
+			CodeHash:  common.HexToHash(version.CodeHash),
+		})
+	}
+	return result, nil
+}
+
+// GetCode returns the code of the given version of the aspect, 0 for the latest version.
+func (api *AspectAPI) GetCode(aspectID common.Address, version hexutil.Uint64, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	res, err := api.b.GetAspectCode(aspectID, uint64(version), blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return res.Code, nil
+}
+
+// GetProperties returns the properties of the given version of the aspect, 0 for the latest version.
+func (api *AspectAPI) GetProperties(aspectID common.Address, version hexutil.Uint64, blockNrOrHash rpc.BlockNumberOrHash) (map[string]hexutil.Bytes, error) {
+	res, err := api.b.GetAspectProperties(aspectID, uint64(version), blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]hexutil.Bytes, len(res.Properties))
+	for _, prop := range res.Properties {
+		properties[prop.Key] = prop.Value
+	}
+	return properties, nil
+}
+
+// GetBoundAddresses returns the addresses of the accounts bound to the aspect.
+func (api *AspectAPI) GetBoundAddresses(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]common.Address, error) {
+	bindings, err := api.b.GetAspectBoundAccounts(aspectID, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]common.Address, 0, len(bindings))
+	for _, binding := range bindings {
+		addresses = append(addresses, common.HexToAddress(binding.Account))
+	}
+	return addresses, nil
+}
+
+// GetBindings returns the aspects bound to the account, along with the binding versions, priorities and join points.
+func (api *AspectAPI) GetBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]AspectBindingResult, error) {
+	bindings, err := api.b.GetAccountBindings(account, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAspectBindingResults(bindings), nil
+}
+
+// GetState returns the raw value of the key in the state of the aspect, empty if the key does not exist.
+func (api *AspectAPI) GetState(aspectID common.Address, key hexutil.Bytes, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	value, err := api.b.GetAspectState(aspectID, key, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return hexutil.Bytes{}, nil
+	}
+	return value, nil
+}
+
+// DumpState returns all the states of the aspect, sorted by key.
+func (api *AspectAPI) DumpState(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]AspectStateResult, error) {
+	states, err := api.b.GetAspectStates(aspectID, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]AspectStateResult, 0, len(states))
+	for _, state := range states {
+		result = append(result, AspectStateResult{
+			Key:   state.Key,
+			Value: state.Value,
+		})
+	}
+	return result, nil
+}
+
+// DiffState returns the states of the aspect which are created, updated or deleted from one block to another.
+func (api *AspectAPI) DiffState(aspectID common.Address, fromBlock, toBlock rpc.BlockNumberOrHash) (*AspectStateDiffResult, error) {
+	diff, err := api.b.GetAspectStateDiff(aspectID, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AspectStateDiffResult{
+		AspectID:  common.HexToAddress(diff.AspectId),
+		FromBlock: hexutil.Uint64(diff.FromHeight),
+		ToBlock:   hexutil.Uint64(diff.ToHeight),
+		Changes:   make([]AspectStateChangeResult, 0, len(diff.Changes)),
+	}
+	for _, change := range diff.Changes {
+		result.Changes = append(result.Changes, AspectStateChangeResult{
+			Key:  change.Key,
+			From: change.FromValue,
+			To:   change.ToValue,
+		})
+	}
+	return result, nil
+}
+
+// Simulate runs the raw transaction on top of the given block without committing, and returns the
+// results of all the aspects executed, including the verifier of the unsigned transaction.
+// The optional from address is used as the sender if the transaction is neither signed nor verified by aspect.
+func (api *AspectAPI) Simulate(input hexutil.Bytes, blockNrOrHash rpc.BlockNumberOrHash, from *common.Address) (*AspectSimulationResult, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return nil, err
+	}
+
+	res, err := api.b.SimulateAspects(tx, from, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AspectSimulationResult{
+		VerifyError: res.VerifyError,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		ReturnData:  res.Ret,
+		Error:       res.VmError,
+		Aspects:     json.RawMessage("[]"),
+	}
+	if res.Sender != "" {
+		sender := common.HexToAddress(res.Sender)
+		result.Sender = &sender
+	}
+	if len(res.Aspects) > 0 {
+		result.Aspects = res.Aspects
+	}
+	return result, nil
+}
+
+// GetSchedule returns the scheduled call with the given id.
+func (api *AspectAPI) GetSchedule(id hexutil.Uint64, blockNrOrHash rpc.BlockNumberOrHash) (*AspectScheduleResult, error) {
+	schedule, err := api.b.GetSchedule(uint64(id), blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAspectScheduleResult(schedule), nil
+}
+
+// GetSchedules returns the scheduled calls registered for the aspect, sorted by id.
+// The finished and cancelled ones are filtered out if activeOnly is set.
+func (api *AspectAPI) GetSchedules(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash, activeOnly *bool) ([]*AspectScheduleResult, error) {
+	schedules, err := api.b.GetAspectSchedules(aspectID, activeOnly != nil && *activeOnly, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AspectScheduleResult, 0, len(schedules))
+	for i := range schedules {
+		result = append(result, newAspectScheduleResult(&schedules[i]))
+	}
+	return result, nil
+}
+
+func newAspectScheduleResult(schedule *aspecttypes.Schedule) *AspectScheduleResult {
+	result := &AspectScheduleResult{
+		ID:        hexutil.Uint64(schedule.Id),
+		AspectID:  common.HexToAddress(schedule.AspectId),
+		Creator:   common.HexToAddress(schedule.Creator),
+		Payer:     common.HexToAddress(schedule.Payer),
+		Target:    common.HexToAddress(schedule.Target),
+		Calldata:  schedule.Calldata,
+		GasLimit:  hexutil.Uint64(schedule.GasLimit),
+		NextBlock: hexutil.Uint64(schedule.NextHeight),
+		Interval:  hexutil.Uint64(schedule.Interval),
+		Count:     hexutil.Uint64(schedule.Count),
+		Executed:  hexutil.Uint64(schedule.Executed),
+		Status:    schedule.Status.String(),
+	}
+	if schedule.LastResult != nil {
+		result.LastResult = &AspectScheduleExecutionResult{
+			BlockNumber: hexutil.Uint64(schedule.LastResult.Height),
+			GasUsed:     hexutil.Uint64(schedule.LastResult.GasUsed),
+			ReturnData:  schedule.LastResult.Ret,
+			Error:       schedule.LastResult.Error,
+		}
+	}
+	return result
+}
+
+func newAspectBindingResults(bindings []aspecttypes.AspectBinding) []AspectBindingResult {
+	result := make([]AspectBindingResult, 0, len(bindings))
+	for _, binding := range bindings {
+		result = append(result, AspectBindingResult{
+			AspectID:  common.HexToAddress(binding.AspectId),
+			Account:   common.HexToAddress(binding.Account),
+			Version:   hexutil.Uint64(binding.Version),
+			Priority:  binding.Priority,
+			JoinPoint: hexutil.Uint(binding.JoinPoint),
+		})
+	}
+	return result
+}
@@ -6,18 +6,18 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/consensus"
-	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
-	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela/ethereum/proof"
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	ethtypes "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs"
@@ -163,6 +163,13 @@ func (s *BlockChainAPI) GetProof(_ context.Context, address common.Address, stor
 	return s.b.GetProof(address, storageKeys, blockNrOrHash)
 }
 
+// GetICS23Proof returns the full ICS23 proof chain of the account states and the given storage slots,
+// which can be verified against the app hash in the next block header.
+func (s *BlockChainAPI) GetICS23Proof(_ context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*proof.AccountProof, error) {
+	s.logger.Debug("eth_getICS23Proof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
+	return s.b.GetICS23Proof(address, storageKeys, blockNrOrHash)
+}
+
 // GetHeaderByNumber returns the requested canonical block header.
 // * When blockNr is -1 the chain head is returned.
 // * When blockNr is -2 the pending chain head is returned.
@@ -286,101 +293,6 @@ func (s *BlockChainAPI) GetStorageAt(_ context.Context, address common.Address,
 	return s.b.GetStorageAt(address, hexKey, blockNrOrHash)
 }
 
-// OverrideAccount indicates the overriding fields of account during the execution
-// of a message call.
-// Note, states and stateDiff can't be specified at the same time. If states is
-// set, message execution will only use the data in the given states. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"states"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
-
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// Apply overrides the fields of specified accounts into the given states.
-func (diff *StateOverride) Apply(state *state.StateDB) error {
-	if diff == nil {
-		return nil
-	}
-	for addr, account := range *diff {
-		// Override account nonce.
-		if account.Nonce != nil {
-			state.SetNonce(addr, uint64(*account.Nonce))
-		}
-		// Override account(contract) code.
-		if account.Code != nil {
-			state.SetCode(addr, *account.Code)
-		}
-		// Override account balance.
-		if account.Balance != nil {
-			state.SetBalance(addr, (*big.Int)(*account.Balance))
-		}
-		if account.State != nil && account.StateDiff != nil {
-			return fmt.Errorf("account %s has both 'states' and 'stateDiff'", addr.Hex())
-		}
-		// Replace entire states if caller requires.
-		if account.State != nil {
-			state.SetStorage(addr, *account.State)
-		}
-		// Apply states diff into specified accounts.
-		if account.StateDiff != nil {
-			for key, value := range *account.StateDiff {
-				state.SetState(addr, key, value)
-			}
-		}
-	}
-	// Now finalize the changes. Finalize is normally performed between transactions.
-	// By using finalize, the overrides are semantically behaving as
-	// if they were created in a transaction just before the tracing occur.
-	state.Finalise(false)
-	return nil
-}
-
-// BlockOverrides is a set of header fields to override.
-type BlockOverrides struct {
-	Number     *hexutil.Big
-	Difficulty *hexutil.Big
-	Time       *hexutil.Uint64
-	GasLimit   *hexutil.Uint64
-	Coinbase   *common.Address
-	Random     *common.Hash
-	BaseFee    *hexutil.Big
-}
-
-// Apply overrides the given header fields into the given block context.
-func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
-	if diff == nil {
-		return
-	}
-	if diff.Number != nil {
-		blockCtx.BlockNumber = diff.Number.ToInt()
-	}
-	if diff.Difficulty != nil {
-		blockCtx.Difficulty = diff.Difficulty.ToInt()
-	}
-	if diff.Time != nil {
-		blockCtx.Time = uint64(*diff.Time)
-	}
-	if diff.GasLimit != nil {
-		blockCtx.GasLimit = uint64(*diff.GasLimit)
-	}
-	if diff.Coinbase != nil {
-		blockCtx.Coinbase = *diff.Coinbase
-	}
-	if diff.Random != nil {
-		blockCtx.Random = diff.Random
-	}
-	if diff.BaseFee != nil {
-		blockCtx.BaseFee = diff.BaseFee.ToInt()
-	}
-}
-
 // ChainContextBackend provides methods required to implement ChainContext.
 type ChainContextBackend interface {
 	Engine() consensus.Engine
@@ -419,8 +331,8 @@ func (context *ChainContext) GetHeader(hash common.Hash, number uint64) *types.H
 //
 // Note, this function doesn't make and changes in the states/blockchain and is
 // useful to execute and retrieve values.
-func (s *BlockChainAPI) Call(_ context.Context, args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, _ *StateOverride, _ *BlockOverrides) (hexutil.Bytes, error) {
-	data, err := s.b.DoCall(args, blockNrOrHash)
+func (s *BlockChainAPI) Call(_ context.Context, args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (hexutil.Bytes, error) {
+	data, err := s.b.DoCall(args, blockNrOrHash, overrides, blockOverrides)
 	if err != nil {
 		return hexutil.Bytes{}, err
 	}
@@ -430,8 +342,8 @@ func (s *BlockChainAPI) Call(_ context.Context, args rpctypes.TransactionArgs, b
 
 // EstimateGas returns an estimate of the amount of gas needed to execute the
 // given transaction against the current pending block.
-func (s *BlockChainAPI) EstimateGas(ctx context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
-	return s.b.EstimateGas(ctx, args, blockNrOrHash)
+func (s *BlockChainAPI) EstimateGas(ctx context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Uint64, error) {
+	return s.b.EstimateGas(ctx, args, blockNrOrHash, overrides)
 }
 
 // RPCMarshalHeader converts the given header to the RPC output .
@@ -464,6 +376,12 @@ func RPCMarshalHeader(head *types.Header, hash common.Hash) map[string]interface
 		result["withdrawalsRoot"] = head.WithdrawalsHash
 	}
 
+	// blob txs are not supported, no blob gas is ever used after cancun
+	if head.ExcessDataGas != nil {
+		result["excessBlobGas"] = hexutil.Uint64(head.ExcessDataGas.Uint64())
+		result["blobGasUsed"] = hexutil.Uint64(0)
+	}
+
 	return result
 }
 
@@ -527,8 +445,23 @@ type AccessListResult struct {
 
 // CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
 // Reexec and BlockNrOrHash can be specified to create the accessList on top of a certain states.
-func (s *BlockChainAPI) CreateAccessList(_ context.Context, _ rpctypes.TransactionArgs, _ *rpc.BlockNumberOrHash) (*AccessListResult, error) {
-	return nil, errors.New("CreateAccessList is not implemented")
+func (s *BlockChainAPI) CreateAccessList(_ context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*AccessListResult, error) {
+	res, err := s.b.CreateAccessList(args, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		// return an empty list instead of null like geth does
+		accessList = &types.AccessList{}
+	}
+
+	result := &AccessListResult{Accesslist: accessList, GasUsed: hexutil.Uint64(res.GasUsed)}
+	if res.VmError != "" {
+		result.Error = res.VmError
+	}
+	return result, nil
 }
 
 // TransactionAPI exposes methods for reading and creating transaction data.
@@ -624,8 +557,38 @@ func (s *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.
 	return s.b.GetTransactionReceipt(ctx, hash)
 }
 
+// txRejectedError is a JSON-RPC error for transactions rejected before entering the transaction pool.
+type txRejectedError struct {
+	error
+}
+
+// ErrorCode returns the "transaction rejected" JSON-RPC error code of EIP-1474.
+func (e *txRejectedError) ErrorCode() int {
+	return -32003
+}
+
+// Unwrap returns the reason of the rejection.
+func (e *txRejectedError) Unwrap() error {
+	return e.error
+}
+
+// errBlobTxNotSupported is returned for EIP-4844 blob transactions, which are not supported by the chain.
+var errBlobTxNotSupported = &txRejectedError{fmt.Errorf("%w: blob transactions are not supported", types.ErrTxTypeNotSupported)}
+
+// setCodeTxType is the type of the EIP-7702 set code transactions, which is not known by the go-ethereum
+// version in use. Set code txs are not supported until the tx type, the authorization list and the
+// delegation designators are supported by go-ethereum and the artela evm.
+const setCodeTxType = 0x04
+
+// errSetCodeTxNotSupported is returned for EIP-7702 set code transactions, which are not supported by the chain.
+var errSetCodeTxNotSupported = &txRejectedError{fmt.Errorf("%w: set code transactions are not supported", types.ErrTxTypeNotSupported)}
+
 // SubmitTransaction is a helper function that submits tx to txPool and logs a message.
 func SubmitTransaction(ctx context.Context, logger log.Logger, b rpctypes.TrancsactionBackend, tx *types.Transaction) (common.Hash, error) {
+	if tx.Type() == types.BlobTxType {
+		return common.Hash{}, errBlobTxNotSupported
+	}
+
 	// If the transaction fee cap is already specified, ensure the
 	// fee of the given transaction is _reasonable_.
 	if err := checkTxFee(tx.GasPrice(), tx.Gas(), b.RPCTxFeeCap()); err != nil {
@@ -669,6 +632,9 @@ func SubmitTransaction(ctx context.Context, logger log.Logger, b rpctypes.Trancs
 // SendTransaction creates a transaction for the given argument, sign it and submit it to the
 // transaction pool.
 func (s *TransactionAPI) SendTransaction(ctx context.Context, args rpctypes.TransactionArgs) (common.Hash, error) {
+	if err := s.authorizeUnlocked(args.FromAddr()); err != nil {
+		return common.Hash{}, err
+	}
 	if err := args.SetDefaults(ctx, s.b); err != nil {
 		return common.Hash{}, err
 	}
@@ -700,6 +666,14 @@ func (s *TransactionAPI) FillTransaction(ctx context.Context, args rpctypes.Tran
 // SendRawTransaction will add the signed transaction to the transaction pool.
 // The sender is responsible for signing the transaction and using the correct nonce.
 func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	// reject blob transactions before decoding, since the network form with sidecar is not decodable
+	if len(input) > 0 && input[0] == types.BlobTxType {
+		return common.Hash{}, errBlobTxNotSupported
+	}
+	if len(input) > 0 && input[0] == setCodeTxType {
+		return common.Hash{}, errSetCodeTxNotSupported
+	}
+
 	tx := new(types.Transaction)
 	if err := tx.UnmarshalBinary(input); err != nil {
 		return common.Hash{}, err
@@ -718,9 +692,23 @@ func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.B
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_sign
 func (s *TransactionAPI) Sign(addr common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
 	s.logger.Debug("eth_sign", "address", addr.Hex(), "data", common.Bytes2Hex(data))
+	if err := s.authorizeUnlocked(addr); err != nil {
+		return nil, err
+	}
 	return s.b.Sign(addr, data)
 }
 
+// authorizeUnlocked returns keystore.ErrLocked if the account is not unlocked, since the eth apis
+// take no password. An account with a key file encrypted with the empty password is always unlocked,
+// so are the accounts kept in the node's keyring without a key file.
+func (s *TransactionAPI) authorizeUnlocked(addr common.Address) error {
+	err := s.b.AuthorizeAccount(addr, "")
+	if errors.Is(err, keystore.ErrDecrypt) {
+		return keystore.ErrLocked
+	}
+	return err
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes      `json:"raw"`
@@ -731,6 +719,9 @@ type SignTransactionResult struct {
 // The node needs to have the private key of the account corresponding with
 // the given from address and it needs to be unlocked.
 func (s *TransactionAPI) SignTransaction(ctx context.Context, args rpctypes.TransactionArgs) (*SignTransactionResult, error) {
+	if err := s.authorizeUnlocked(args.FromAddr()); err != nil {
+		return nil, err
+	}
 	// gas, gas limit, nonce checking are made in SignTransaction
 	signed, err := s.b.SignTransaction(&args)
 	if err != nil {
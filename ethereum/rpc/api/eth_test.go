@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobTxRejected(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx, err := types.SignNewTx(key, types.NewCancunSigner(big.NewInt(1)), &types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        21000,
+		To:         &to,
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: []common.Hash{{0x01}},
+	})
+	require.NoError(t, err)
+	input, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	// blob txs are rejected before reaching the backend
+	api := &TransactionAPI{logger: log.Root()}
+	_, err = api.SendRawTransaction(context.Background(), input)
+	require.ErrorIs(t, err, types.ErrTxTypeNotSupported)
+	var rpcErr rpc.Error
+	require.True(t, errors.As(err, &rpcErr))
+	require.Equal(t, -32003, rpcErr.ErrorCode())
+
+	// the network form with the sidecar is not decodable, but rejected as well
+	_, err = api.SendRawTransaction(context.Background(), append(hexutil.Bytes{types.BlobTxType}, 0xc0))
+	require.ErrorIs(t, err, errBlobTxNotSupported)
+
+	_, err = SubmitTransaction(context.Background(), log.Root(), nil, tx)
+	require.ErrorIs(t, err, errBlobTxNotSupported)
+}
+
+func TestSetCodeTxRejected(t *testing.T) {
+	api := &TransactionAPI{logger: log.Root()}
+	_, err := api.SendRawTransaction(context.Background(), hexutil.Bytes{setCodeTxType, 0xc0})
+	require.ErrorIs(t, err, errSetCodeTxNotSupported)
+	require.ErrorIs(t, err, types.ErrTxTypeNotSupported)
+}
+
+func TestRPCMarshalHeaderBlobGas(t *testing.T) {
+	header := &types.Header{Number: big.NewInt(1), Difficulty: new(big.Int)}
+
+	// no blob gas fields before cancun
+	fields := RPCMarshalHeader(header, header.Hash())
+	require.NotContains(t, fields, "excessBlobGas")
+	require.NotContains(t, fields, "blobGasUsed")
+
+	// blob gas is never used after cancun
+	header.ExcessDataGas = new(big.Int)
+	fields = RPCMarshalHeader(header, header.Hash())
+	require.Equal(t, hexutil.Uint64(0), fields["excessBlobGas"])
+	require.Equal(t, hexutil.Uint64(0), fields["blobGasUsed"])
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
@@ -79,24 +81,56 @@ func (s *PersonalAccountAPI) ImportRawKey(privkey string, password string) (comm
 	return s.b.ImportRawKey(privkey, password)
 }
 
+// ImportKeystore imports the given Web3 Secret Storage (v3 JSON) key file, which
+// is decrypted with the passphrase.
+func (s *PersonalAccountAPI) ImportKeystore(keyJSON string, password string) (common.Address, error) {
+	return s.b.ImportKeystore([]byte(keyJSON), password)
+}
+
+// ExportKeystore returns the Web3 Secret Storage (v3 JSON) key file of the account
+// associated with the given address, if the password is able to decrypt it.
+func (s *PersonalAccountAPI) ExportKeystore(addr common.Address, password string) (string, error) {
+	keyJSON, err := s.b.ExportKeystore(addr, password)
+	if err != nil {
+		return "", err
+	}
+	return string(keyJSON), nil
+}
+
 // UnlockAccount will unlock the account associated with the given address with
 // the given password for duration seconds. If duration is nil it will use a
 // default of 300 seconds. It returns an indication if the account was unlocked.
-func (s *PersonalAccountAPI) UnlockAccount(_ context.Context, _ common.Address, _ string, duration *uint64) (bool, error) {
-	// not implemented
-	return false, errors.New("UnlockAccount is not valid")
+func (s *PersonalAccountAPI) UnlockAccount(_ context.Context, addr common.Address, password string, duration *uint64) (bool, error) {
+	const max = uint64(time.Duration(math.MaxInt64) / time.Second)
+	var d time.Duration
+	if duration == nil {
+		d = 300 * time.Second
+	} else if *duration > max {
+		return false, errors.New("unlock duration too large")
+	} else {
+		d = time.Duration(*duration) * time.Second
+	}
+
+	err := s.b.UnlockAccount(addr, password, d)
+	if err != nil {
+		s.logger.Warn("Failed account unlock attempt", "address", addr, "err", err)
+	}
+	return err == nil, err
 }
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
-func (s *PersonalAccountAPI) LockAccount(_ common.Address) bool {
-	// not implemented"
-	return false
+func (s *PersonalAccountAPI) LockAccount(addr common.Address) bool {
+	return s.b.LockAccount(addr)
 }
 
-// signTransaction sets defaults and signs the given transaction
+// signTransaction sets defaults and signs the given transaction, the account must be
+// unlocked or the passwd must be able to decrypt its key file.
 // NOTE: the caller needs to ensure that the nonceLock is held, if applicable,
 // and release it after the transaction has been submitted to the tx pool
-func (s *PersonalAccountAPI) signTransaction(ctx context.Context, args *rpctypes.TransactionArgs, _ string) (*types.Transaction, error) {
+func (s *PersonalAccountAPI) signTransaction(ctx context.Context, args *rpctypes.TransactionArgs, passwd string) (*types.Transaction, error) {
+	if err := s.b.AuthorizeAccount(args.FromAddr(), passwd); err != nil {
+		return nil, err
+	}
 	if err := args.SetDefaults(ctx, s.b); err != nil {
 		return nil, err
 	}
@@ -109,8 +143,8 @@ func (s *PersonalAccountAPI) signTransaction(ctx context.Context, args *rpctypes
 }
 
 // SendTransaction will create a transaction from the given arguments and
-// tries to sign it with the key associated with args.From. If the given
-// passwd isn't able to decrypt the key it fails.
+// tries to sign it with the key associated with args.From. If the account is
+// locked and the given passwd isn't able to decrypt the key it fails.
 func (s *PersonalAccountAPI) SendTransaction(ctx context.Context, args rpctypes.TransactionArgs, passwd string) (common.Hash, error) {
 	if args.Nonce == nil {
 		// Hold the mutex around signing to prevent concurrent assignment of
@@ -150,10 +184,13 @@ func (s *PersonalAccountAPI) SignTransaction(ctx context.Context, args rpctypes.
 // Note, the produced signature conforms to the secp256k1 curve R, S and V values,
 // where the V value will be 27 or 28 for legacy reasons.
 //
-// The key used to calculate the signature is decrypted with the given password.
+// The account must be unlocked or the given password must be able to decrypt its key file.
 //
 // https://github.com/ethereum/go-ethereum/wiki/Management-APIs#personal_sign
-func (s *PersonalAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, _ string) (hexutil.Bytes, error) {
+func (s *PersonalAccountAPI) Sign(_ context.Context, data hexutil.Bytes, addr common.Address, passwd string) (hexutil.Bytes, error) {
+	if err := s.b.AuthorizeAccount(addr, passwd); err != nil {
+		return nil, err
+	}
 	return s.b.Sign(addr, data)
 }
 
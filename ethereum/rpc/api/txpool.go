@@ -4,12 +4,13 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
 )
 
 // TxPoolAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
@@ -27,26 +28,64 @@ func NewTxPoolAPI(b rpctypes.TxPoolBackend, logger log.Logger) *TxPoolAPI {
 func (s *TxPoolAPI) Content() map[string]map[string]map[string]*rpctypes.RPCTransaction {
 	content := map[string]map[string]map[string]*rpctypes.RPCTransaction{
 		"pending": make(map[string]map[string]*rpctypes.RPCTransaction),
-		"queued":  s.getPendingContent(common.Address{}),
+		"queued":  make(map[string]map[string]*rpctypes.RPCTransaction),
+	}
+
+	pending, queued, err := s.b.TxPoolContent()
+	if err != nil {
+		s.logger.Debug("txpool_content, get txpool content failed", "err", err.Error())
+		return content
+	}
+
+	cfg := s.b.ChainConfig()
+	if cfg == nil {
+		s.logger.Debug("txpool_content, failed to get chain config")
+		return content
 	}
 
+	// Flatten the pending transactions
+	for account, msgs := range pending {
+		content["pending"][account.Hex()] = dump(msgs, cfg)
+	}
+	// Flatten the queued transactions
+	for account, msgs := range queued {
+		content["queued"][account.Hex()] = dump(msgs, cfg)
+	}
 	return content
 }
 
 // ContentFrom returns the transactions contained within the transaction pool.
 func (s *TxPoolAPI) ContentFrom(address common.Address) map[string]map[string]*rpctypes.RPCTransaction {
-	return s.getPendingContent(address)
+	content := make(map[string]map[string]*rpctypes.RPCTransaction, 2)
+
+	pending, queued, err := s.b.TxPoolContentFrom(address)
+	if err != nil {
+		s.logger.Debug("txpool_contentFrom, get txpool content failed", "err", err.Error())
+		return content
+	}
+
+	cfg := s.b.ChainConfig()
+	if cfg == nil {
+		s.logger.Debug("txpool_contentFrom, failed to get chain config")
+		return content
+	}
+
+	// Build the pending and queued transactions
+	content["pending"] = dump(pending, cfg)
+	content["queued"] = dump(queued, cfg)
+	return content
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (s *TxPoolAPI) Status() map[string]hexutil.Uint {
-	pending, err := s.b.PendingTransactionsCount()
+	pending, queued, err := s.b.TxPoolStatus()
 	if err != nil {
-		s.logger.Debug("get pending transaction count failed", "error", err.Error())
+		s.logger.Debug("txpool_status, get txpool status failed", "err", err.Error())
 	}
+
 	return map[string]hexutil.Uint{
 		"pending": hexutil.Uint(pending),
-		"queued":  hexutil.Uint(0),
+		"queued":  hexutil.Uint(queued),
 	}
 }
 
@@ -57,65 +96,46 @@ func (s *TxPoolAPI) Inspect() map[string]map[string]map[string]string {
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
-	pending := s.getPendingContent(common.Address{})
+
+	pending, queued, err := s.b.TxPoolContent()
+	if err != nil {
+		s.logger.Debug("txpool_inspect, get txpool content failed", "err", err.Error())
+		return content
+	}
 
 	// Define a formatter to flatten a transaction into a string
-	var format = func(tx *rpctypes.RPCTransaction) string {
-		if to := tx.To; to != nil {
-			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value, tx.Gas, tx.GasPrice)
+	var format = func(msg *txs.MsgEthereumTx) string {
+		tx := msg.AsTransaction()
+		if to := tx.To(); to != nil {
+			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To().Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
 		}
-		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value, tx.Gas, tx.GasPrice)
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
 	}
-	// Flatten the pending transactions
-	for account, txs := range pending {
+	flatten := func(msgs []*txs.MsgEthereumTx) map[string]string {
 		dump := make(map[string]string)
-		for _, tx := range txs {
-			dump[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+		for _, msg := range msgs {
+			dump[strconv.FormatUint(msg.AsTransaction().Nonce(), 10)] = format(msg)
 		}
-		content["pending"][account] = dump
+		return dump
 	}
-	return content
-}
 
-func (s *TxPoolAPI) getPendingContent(addr common.Address) map[string]map[string]*rpctypes.RPCTransaction {
-	pendingContent := make(map[string]map[string]*rpctypes.RPCTransaction)
-	pendingTxs, err := s.b.PendingTransactions()
-	if err != nil {
-		s.logger.Debug("txpool_context, get pending transactions failed", "err", err.Error())
-		return pendingContent
+	// Flatten the pending transactions
+	for account, msgs := range pending {
+		content["pending"][account.Hex()] = flatten(msgs)
 	}
+	// Flatten the queued transactions
+	for account, msgs := range queued {
+		content["queued"][account.Hex()] = flatten(msgs)
+	}
+	return content
+}
 
-	cfg := s.b.ChainConfig()
-	if cfg == nil {
-		s.logger.Debug("txpool_context, failed to get chain config")
-		return pendingContent
-	}
-	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
-			if ethMsg, ok := msg.(*txs.MsgEthereumTx); ok {
-				sender, err := s.b.GetSender(ethMsg, cfg.ChainID)
-				if err != nil {
-					s.logger.Debug("txpool_context, get pending transaction sender", "err", err.Error())
-					continue
-				}
-
-				if (addr != common.Address{} && addr != sender) {
-					continue
-				}
-
-				txData, err := txs.UnpackTxData(ethMsg.Data)
-				if err != nil {
-					s.logger.Debug("txpool_context, unpack pending transaction failed", "err", err.Error())
-					continue
-				}
-
-				rpctx := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, cfg)
-				if pendingContent[sender.String()] == nil {
-					pendingContent[sender.String()] = make(map[string]*rpctypes.RPCTransaction)
-				}
-				pendingContent[sender.String()][strconv.FormatUint(txData.GetNonce(), 10)] = rpctx
-			}
-		}
+// dump returns the rpc transactions indexed by nonce.
+func dump(msgs []*txs.MsgEthereumTx, cfg *params.ChainConfig) map[string]*rpctypes.RPCTransaction {
+	dump := make(map[string]*rpctypes.RPCTransaction)
+	for _, msg := range msgs {
+		rpctx := rpctypes.NewTransactionFromMsg(msg, common.Hash{}, uint64(0), uint64(0), nil, cfg)
+		dump[strconv.FormatUint(msg.AsTransaction().Nonce(), 10)] = rpctx
 	}
-	return pendingContent
+	return dump
 }
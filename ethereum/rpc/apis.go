@@ -44,6 +44,9 @@ func GetAPIs(clientCtx client.Context, serverCtx *server.Context, wsClient *rpcc
 		}, {
 			Namespace: "web3",
 			Service:   api.NewWeb3API(apiBackend),
+		}, {
+			Namespace: "aspect",
+			Service:   api.NewAspectAPI(apiBackend, logger),
 		},
 	}
 }
@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	aspecttypes "github.com/artela-network/artela/x/aspect/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// GetAspectMeta returns the meta and all the deployed versions of an aspect at the given block.
+func (b *BackendImpl) GetAspectMeta(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectMetaResponse, *aspecttypes.QueryAspectVersionsResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	meta, err := b.queryClient.Aspect.AspectMeta(ctx, &aspecttypes.QueryAspectMetaRequest{
+		AspectId: aspectID.Hex(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions, err := b.queryClient.Aspect.AspectVersions(ctx, &aspecttypes.QueryAspectVersionsRequest{
+		AspectId: aspectID.Hex(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return meta, versions, nil
+}
+
+// GetAspectCode returns the code of the given version of an aspect at the given block, 0 for the latest version.
+func (b *BackendImpl) GetAspectCode(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectCodeResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.queryClient.Aspect.AspectCode(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryAspectCodeRequest{
+		AspectId: aspectID.Hex(),
+		Version:  version,
+	})
+}
+
+// GetAspectProperties returns the properties of the given version of an aspect at the given block, 0 for the latest version.
+func (b *BackendImpl) GetAspectProperties(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectPropertiesResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.queryClient.Aspect.AspectProperties(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryAspectPropertiesRequest{
+		AspectId: aspectID.Hex(),
+		Version:  version,
+	})
+}
+
+// GetAspectBoundAccounts returns the bindings of the accounts bound to an aspect at the given block.
+func (b *BackendImpl) GetAspectBoundAccounts(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Aspect.BoundAccounts(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryBoundAccountsRequest{
+		AspectId: aspectID.Hex(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Bindings, nil
+}
+
+// GetAccountBindings returns the bindings of the aspects bound to an account at the given block.
+func (b *BackendImpl) GetAccountBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Aspect.AccountBindings(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryAccountBindingsRequest{
+		Account: account.Hex(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Bindings, nil
+}
+
+// GetAspectState returns the raw value of a key in the state store of an aspect at the given block.
+func (b *BackendImpl) GetAspectState(aspectID common.Address, key []byte, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Aspect.AspectState(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryAspectStateRequest{
+		AspectId: aspectID.Hex(),
+		Key:      key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Value, nil
+}
+
+// GetAspectStates returns all the states of an aspect at the given block, sorted by key.
+func (b *BackendImpl) GetAspectStates(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectStateEntry, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.getAspectStates(aspectID, blockNum.Int64())
+}
+
+// GetAspectStateDiff returns the state changes of an aspect from one block to another.
+func (b *BackendImpl) GetAspectStateDiff(aspectID common.Address, fromBlock, toBlock rpc.BlockNumberOrHash) (*aspecttypes.AspectStateDiff, error) {
+	fromNum, err := b.blockNumberFromCosmos(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	toNum, err := b.blockNumberFromCosmos(toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// resolve the tags, so that the heights in the result are the actual ones
+	fromHeight, toHeight := fromNum.Int64(), toNum.Int64()
+	if fromHeight <= 0 || toHeight <= 0 {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		if fromHeight <= 0 {
+			fromHeight = int64(latest)
+		}
+		if toHeight <= 0 {
+			toHeight = int64(latest)
+		}
+	}
+
+	from, err := b.getAspectStates(aspectID, fromHeight)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.getAspectStates(aspectID, toHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aspecttypes.AspectStateDiff{
+		AspectId:   aspectID.Hex(),
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Changes:    aspecttypes.DiffAspectStates(from, to),
+	}, nil
+}
+
+func (b *BackendImpl) getAspectStates(aspectID common.Address, height int64) ([]aspecttypes.AspectStateEntry, error) {
+	res, err := b.queryClient.Aspect.AspectStates(rpctypes.ContextWithHeight(height), &aspecttypes.QueryAspectStatesRequest{
+		AspectId: aspectID.Hex(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.States, nil
+}
+
+// GetSchedule returns the schedule with the given id at the given block.
+func (b *BackendImpl) GetSchedule(id uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.Schedule, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Aspect.Schedule(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryScheduleRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Schedule, nil
+}
+
+// GetAspectSchedules returns the schedules registered for an aspect at the given block.
+func (b *BackendImpl) GetAspectSchedules(aspectID common.Address, activeOnly bool, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.Schedule, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.Aspect.AspectSchedules(rpctypes.ContextWithHeight(blockNum.Int64()), &aspecttypes.QueryAspectSchedulesRequest{
+		AspectId:   aspectID.Hex(),
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Schedules, nil
+}
+
+// SimulateAspects runs the transaction on top of the given block without committing,
+// and returns the results of the aspects executed. The from address is used as the sender
+// only if the transaction is neither signed nor verified by aspect.
+func (b *BackendImpl) SimulateAspects(tx *ethtypes.Transaction, from *common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*txs.QuerySimulateAspectsResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &txs.MsgEthereumTx{}
+	if err := msg.FromEthereumTx(tx); err != nil {
+		return nil, err
+	}
+	if from != nil {
+		msg.From = from.Hex()
+	}
+
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := &txs.QuerySimulateAspectsRequest{
+		Msg:             msg,
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	return b.queryClient.SimulateAspects(ctx, req)
+}
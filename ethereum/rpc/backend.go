@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"strconv"
 	"time"
 
@@ -20,13 +21,13 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	artelakeyring "github.com/artela-network/artela/ethereum/crypto/keyring"
 	"github.com/artela-network/artela/ethereum/rpc/filters"
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/server/config"
@@ -36,8 +37,7 @@ import (
 )
 
 var (
-	_ gasprice.OracleBackend = (*BackendImpl)(nil)
-	_ filters.Backend        = (*BackendImpl)(nil)
+	_ filters.Backend = (*BackendImpl)(nil)
 
 	_ rpctypes.Backend             = (*BackendImpl)(nil)
 	_ rpctypes.EthereumBackend     = (*BackendImpl)(nil)
@@ -57,7 +57,8 @@ type BackendImpl struct {
 	cfg           *Config
 	appConf       config.Config
 	chainID       *big.Int
-	gpo           *gasprice.Oracle
+	tipCache      gasTipCache
+	cancunCache   forkCache
 	logger        log.Logger
 
 	scope           event.SubscriptionScope
@@ -72,8 +73,17 @@ type BackendImpl struct {
 	ctx         context.Context
 	clientCtx   client.Context
 	queryClient *rpctypes.QueryClient
-
-	db db.DB
+	// key files and unlock states of the accounts managed by the personal api
+	keystore *artelakeyring.Keystore
+
+	db      db.DB
+	indexer ethereumtypes.EVMTxIndexer
+	// app-side ethereum tx pool, nil if the CometBFT mempool is used
+	txPool rpctypes.TxPool
+	// CometBFT mempool of the in-process node, nil if the node is not in-process
+	mempool rpctypes.Mempool
+	// catching up state of the node shared with the syncing subscriptions
+	syncStatus *SyncStatus
 }
 
 // NewBackend create the backend implements
@@ -85,6 +95,9 @@ func NewBackend(
 	cfg *Config,
 	logger log.Logger,
 	db db.DB,
+	indexer ethereumtypes.EVMTxIndexer,
+	txPool rpctypes.TxPool,
+	mempool rpctypes.Mempool,
 ) *BackendImpl {
 	b := &BackendImpl{
 		ctx:           context.Background(),
@@ -95,8 +108,14 @@ func NewBackend(
 		clientCtx:     clientCtx,
 		queryClient:   rpctypes.NewQueryClient(clientCtx),
 
-		scope: event.SubscriptionScope{},
-		db:    db,
+		scope:   event.SubscriptionScope{},
+		db:      db,
+		indexer: indexer,
+		txPool:  txPool,
+		mempool: mempool,
+	}
+	if clientCtx.Client != nil {
+		b.syncStatus = NewSyncStatus(clientCtx.Client, logger)
 	}
 
 	var err error
@@ -110,10 +129,11 @@ func NewBackend(
 		panic(err)
 	}
 
-	if cfg.GPO.Default == nil {
-		panic("cfg.GPO.Default is nil")
+	keyringDir := clientCtx.KeyringDir
+	if keyringDir == "" {
+		keyringDir = clientCtx.HomeDir
 	}
-	b.gpo = gasprice.NewOracle(b, *cfg.GPO)
+	b.keystore = artelakeyring.NewKeystore(filepath.Join(keyringDir, artelakeyring.KeystoreDirName))
 	return b
 }
 
@@ -220,10 +240,22 @@ func (b *BackendImpl) ChainConfig() *params.ChainConfig {
 
 // General Ethereum DebugAPI
 
+// SyncProgress returns the sync progress of the node, the highest block is unknown and left zero, since
+// CometBFT doesn't expose the heights of the peers.
 func (b *BackendImpl) SyncProgress() ethereum.SyncProgress {
+	if b.syncStatus == nil {
+		return ethereum.SyncProgress{}
+	}
+
+	progress, err := b.syncStatus.Progress()
+	if err != nil || progress == false {
+		return ethereum.SyncProgress{}
+	}
+
+	fields := progress.(map[string]interface{})
 	return ethereum.SyncProgress{
-		CurrentBlock: 0,
-		HighestBlock: 0,
+		StartingBlock: uint64(fields["startingBlock"].(hexutil.Uint64)),
+		CurrentBlock:  uint64(fields["currentBlock"].(hexutil.Uint64)),
 	}
 }
 
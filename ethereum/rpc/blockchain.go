@@ -10,12 +10,15 @@ import (
 	"math/big"
 	"sort"
 	"strconv"
+	"sync"
 
 	sdkmath "cosmossdk.io/math"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/misc"
@@ -30,6 +33,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela/ethereum/proof"
 	"github.com/artela-network/artela/ethereum/rpc/api"
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/rpc/utils"
@@ -39,7 +43,11 @@ import (
 
 // Blockchain API
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs.
+// Each proof is a list of hex encoded ics23 commitment proofs, the first one proves
+// the key in the module store, the second one proves the module store in the multistore.
+// The account proof only covers the account entry in the auth store, use GetICS23Proof to
+// get the proofs of all the account states together with the raw values.
 func (b *BackendImpl) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
 	numberOrHash := rpc.BlockNumberOrHash{
 		BlockNumber:      (*rpc.BlockNumber)(blockNrOrHash.BlockNumber),
@@ -126,7 +134,110 @@ func (b *BackendImpl) GetProof(address common.Address, storageKeys []string, blo
 	}, nil
 }
 
-func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error) {
+// GetICS23Proof returns the full ICS23 proof chain of an account and the given storage slots,
+// which can be verified against the app hash with the proof package.
+// Since the app hash of block H is only available in the header of block H+1, the states
+// of the latest block minus one are proven for "latest" and "pending".
+func (b *BackendImpl) GetICS23Proof(address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*proof.AccountProof, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockNrOrHash.BlockNumber != nil && (*blockNrOrHash.BlockNumber == rpc.LatestBlockNumber ||
+		*blockNrOrHash.BlockNumber == rpc.PendingBlockNumber) {
+		bn, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		blockNum = rpc.BlockNumber(bn) - 1
+	}
+
+	height := blockNum.Int64()
+	if height <= 0 {
+		return nil, fmt.Errorf("invalid block number %d", height)
+	}
+
+	// the app hash of the states committed at height is in the header of the next block
+	nextBlock, err := b.CosmosBlockByNumber(blockNum + 1)
+	if err != nil {
+		return nil, fmt.Errorf("app hash of height %d is not available yet, %v", height, err)
+	}
+
+	ctx := rpctypes.ContextWithHeight(height)
+	clientCtx := b.clientCtx.WithHeight(height)
+
+	params, err := b.queryClient.Params(ctx, &txs.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	evmDenom := params.Params.EvmDenom
+
+	res, err := b.queryClient.Account(ctx, &txs.QueryAccountRequest{Address: address.String()})
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := sdkmath.NewIntFromString(res.Balance)
+	if !ok {
+		return nil, errors.New("invalid balance")
+	}
+
+	accountProof, err := b.getKeyProof(clientCtx, authtypes.StoreKey, authtypes.AddressStoreKey(sdktypes.AccAddress(address.Bytes())))
+	if err != nil {
+		return nil, err
+	}
+
+	balanceKey := append(banktypes.CreateAccountBalancesPrefix(address.Bytes()), []byte(evmDenom)...)
+	balanceProof, err := b.getKeyProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	storageProofs := make([]proof.StorageProof, len(storageKeys))
+	for i, key := range storageKeys {
+		slot := common.HexToHash(key)
+		keyProof, err := b.getKeyProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, slot.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+
+		storageProofs[i] = proof.StorageProof{
+			Key:   slot,
+			Value: common.BytesToHash(keyProof.Value),
+			Proof: *keyProof,
+		}
+	}
+
+	return &proof.AccountProof{
+		Address:      address,
+		Height:       hexutil.Uint64(height),
+		AppHash:      hexutil.Bytes(nextBlock.Block.AppHash),
+		Nonce:        hexutil.Uint64(res.Nonce),
+		Balance:      (*hexutil.Big)(balance.BigInt()),
+		CodeHash:     common.HexToHash(res.CodeHash),
+		EvmDenom:     evmDenom,
+		AccountProof: *accountProof,
+		BalanceProof: *balanceProof,
+		StorageProof: storageProofs,
+	}, nil
+}
+
+// getKeyProof queries the value and the ICS23 proof chain of the key in the given store.
+func (b *BackendImpl) getKeyProof(clientCtx client.Context, storeName string, key []byte) (*proof.KeyProof, error) {
+	value, proofOps, err := b.queryClient.GetProof(clientCtx, storeName, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proof.KeyProof{
+		StoreName: storeName,
+		Key:       key,
+		Value:     value,
+		Proof:     proof.NewProofOps(proofOps),
+	}, nil
+}
+
+func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*txs.MsgEthereumTxResponse, error) {
 	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -148,6 +259,16 @@ func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.Bl
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -183,7 +304,7 @@ func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.Bl
 	return res, nil
 }
 
-func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Uint64, error) {
 	blockNum := rpc.LatestBlockNumber
 	if blockNrOrHash != nil {
 		blockNum, _ = b.blockNumberFromCosmos(*blockNrOrHash)
@@ -206,6 +327,11 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.Transaction
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return 0, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -217,6 +343,50 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.Transaction
 	return hexutil.Uint64(res.Gas), nil
 }
 
+func (b *BackendImpl) CreateAccessList(args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*txs.QueryCreateAccessListResponse, error) {
+	blockNum := rpc.LatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = b.blockNumberFromCosmos(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := txs.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	return b.queryClient.CreateAccessList(ctx, &req)
+}
+
 func (b *BackendImpl) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*ethtypes.Header, error) {
 	resBlock, err := b.CosmosBlockByNumber(number)
 	if err != nil {
@@ -244,6 +414,9 @@ func (b *BackendImpl) HeaderByNumber(_ context.Context, number rpc.BlockNumber)
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	if b.isCancun(resBlock.Block.Height) {
+		ethHeader.ExcessDataGas = new(big.Int)
+	}
 	return ethHeader, nil
 }
 
@@ -507,6 +680,9 @@ func (b *BackendImpl) BlockFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blo
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	if b.isCancun(height) {
+		ethHeader.ExcessDataGas = new(big.Int)
+	}
 	msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -708,3 +884,36 @@ func (s sortGasAndReward) Swap(i, j int) {
 func (s sortGasAndReward) Less(i, j int) bool {
 	return s[i].reward.Cmp(s[j].reward) < 0
 }
+
+// forkCache caches the activation height of a fork once it's activated
+type forkCache struct {
+	sync.Mutex
+	activated bool
+	height    int64
+}
+
+// isCancun returns whether the cancun fork is activated at the given height, with the chain config
+// at that height. Blob txs are not supported, so the blob gas fields of the block headers are zero.
+// The chain config is queried until the fork is seen activated, the activation height is cached then.
+func (b *BackendImpl) isCancun(height int64) bool {
+	b.cancunCache.Lock()
+	defer b.cancunCache.Unlock()
+	if b.cancunCache.activated {
+		return height >= b.cancunCache.height
+	}
+
+	res, err := b.queryClient.Params(rpctypes.ContextWithHeight(height), &txs.QueryParamsRequest{})
+	if err != nil {
+		b.logger.Debug("failed to query evm params", "error", err)
+		return false
+	}
+
+	cancunBlock := res.Params.ChainConfig.CancunBlock
+	if cancunBlock == nil || cancunBlock.IsNegative() || height < cancunBlock.Int64() {
+		return false
+	}
+
+	b.cancunCache.activated = true
+	b.cancunCache.height = cancunBlock.Int64()
+	return true
+}
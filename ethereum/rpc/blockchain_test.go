@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// mockEVMQueryClient returns the evm params with the given cancun block
+type mockEVMQueryClient struct {
+	txs.QueryClient
+
+	cancunBlock *sdktypes.Int
+	height      int64
+	nonces      map[string]uint64
+	calls       int
+}
+
+func (c *mockEVMQueryClient) Account(_ context.Context, req *txs.QueryAccountRequest, _ ...grpc.CallOption) (*txs.QueryAccountResponse, error) {
+	return &txs.QueryAccountResponse{Nonce: c.nonces[req.Address]}, nil
+}
+
+func (c *mockEVMQueryClient) Params(_ context.Context, _ *txs.QueryParamsRequest, opts ...grpc.CallOption) (*txs.QueryParamsResponse, error) {
+	c.calls++
+	// the latest block height is returned in the header
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(c.height, 10))
+		}
+	}
+	params := support.DefaultParams()
+	params.ChainConfig.CancunBlock = c.cancunBlock
+	return &txs.QueryParamsResponse{Params: params}, nil
+}
+
+func TestIsCancun(t *testing.T) {
+	cancunBlock := sdktypes.NewInt(100)
+	client := &mockEVMQueryClient{cancunBlock: &cancunBlock}
+	b := &BackendImpl{
+		queryClient: &rpctypes.QueryClient{QueryClient: client},
+		logger:      log.Root(),
+	}
+
+	// the params are queried until the fork is activated
+	require.False(t, b.isCancun(50))
+	require.False(t, b.isCancun(99))
+	require.Equal(t, 2, client.calls)
+
+	require.True(t, b.isCancun(100))
+	require.Equal(t, 3, client.calls)
+
+	// the activation height is cached
+	require.True(t, b.isCancun(200))
+	require.False(t, b.isCancun(99))
+	require.Equal(t, 3, client.calls)
+
+	// the fork is not activated without the cancun block
+	client = &mockEVMQueryClient{}
+	b = &BackendImpl{
+		queryClient: &rpctypes.QueryClient{QueryClient: client},
+		logger:      log.Root(),
+	}
+	require.False(t, b.isCancun(200))
+}
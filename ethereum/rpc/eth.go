@@ -2,9 +2,11 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -13,12 +15,9 @@ import (
 	feetypes "github.com/artela-network/artela/x/fee/types"
 )
 
-func (b *BackendImpl) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
-	if baseFee == nil {
-		// london hardfork not enabled or feemarket not enabled
-		return big.NewInt(0), nil
-	}
-
+// maxBaseFeeDelta returns the max base fee increase of the next block, which is the fallback
+// of the suggested tip if no tips are paid in the recent blocks.
+func (b *BackendImpl) maxBaseFeeDelta(baseFee *big.Int) (*big.Int, error) {
 	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feetypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
@@ -88,6 +87,11 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 		return nil, fmt.Errorf("FeeHistory user block count %d higher than %d", blocks, maxBlockCount)
 	}
 
+	return b.feeHistory(blockEnd, blocks, rewardPercentiles)
+}
+
+// feeHistory returns the fee history of the given number of blocks ending at blockEnd.
+func (b *BackendImpl) feeHistory(blockEnd, blocks int64, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
 	if blockEnd+1 < blocks {
 		blocks = blockEnd + 1
 	}
@@ -95,6 +99,12 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 || (i > 0 && p < rewardPercentiles[i-1]) {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+	}
+
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
 	for i := 0; i < int(blocks); i++ {
@@ -105,30 +115,65 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	thisGasUsedRatio := make([]float64, blocks)
 	calculateRewards := rewardCount != 0
 
+	// use the fee history kept by the fee module, blocks pruned from the history are
+	// reconstructed from the tendermint blocks
+	history, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feetypes.QueryFeeHistoryRequest{
+		LastBlock:  blockEnd,
+		BlockCount: uint64(blocks),
+	})
+	if err != nil {
+		b.logger.Debug("failed to query fee history", "error", err.Error())
+		history = &feetypes.QueryFeeHistoryResponse{}
+	}
+	records := make(map[int64]*feetypes.BlockFeeHistory, len(history.Blocks))
+	for i := range history.Blocks {
+		records[history.Blocks[i].Height] = &history.Blocks[i]
+	}
+
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart) // #nosec G701
-		// tendermint block
-		tendermintblock, err := b.CosmosBlockByNumber(rpc.BlockNumber(blockID))
-		if tendermintblock == nil {
-			return nil, err
-		}
 
-		// eth block
-		ethBlock, err := b.GetBlockByNumber(rpc.BlockNumber(blockID), true)
-		if ethBlock == nil {
-			return nil, err
+		// the next base fee is only used for the last block, it is overwritten by the
+		// base fee of the following block otherwise
+		var oneFeeHistory *rpctypes.OneFeeHistory
+		if record, ok := records[blockID]; ok {
+			oneFeeHistory = feeHistoryFromRecord(record, rewardPercentiles)
+			if blockID < blockEnd {
+				oneFeeHistory.NextBaseFee = new(big.Int)
+			} else if history.NextBaseFee != nil {
+				oneFeeHistory.NextBaseFee = history.NextBaseFee.BigInt()
+			}
 		}
 
-		// tendermint block result
-		tendermintBlockResult, err := b.CosmosBlockResultByNumber(&tendermintblock.Block.Height)
-		if tendermintBlockResult == nil {
-			b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
-			return nil, err
-		}
+		if oneFeeHistory == nil || oneFeeHistory.NextBaseFee == nil {
+			// tendermint block
+			tendermintblock, err := b.CosmosBlockByNumber(rpc.BlockNumber(blockID))
+			if tendermintblock == nil {
+				return nil, err
+			}
 
-		oneFeeHistory, err := b.processBlock(tendermintblock, &ethBlock, rewardPercentiles, tendermintBlockResult)
-		if err != nil {
-			return nil, err
+			// eth block
+			ethBlock, err := b.GetBlockByNumber(rpc.BlockNumber(blockID), true)
+			if ethBlock == nil {
+				return nil, err
+			}
+
+			// tendermint block result
+			tendermintBlockResult, err := b.CosmosBlockResultByNumber(&tendermintblock.Block.Height)
+			if tendermintBlockResult == nil {
+				b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
+				return nil, err
+			}
+
+			processed, err := b.processBlock(tendermintblock, &ethBlock, rewardPercentiles, tendermintBlockResult)
+			if err != nil {
+				return nil, err
+			}
+			if oneFeeHistory == nil {
+				oneFeeHistory = processed
+			} else {
+				oneFeeHistory.NextBaseFee = processed.NextBaseFee
+			}
 		}
 
 		// copy
@@ -158,6 +203,30 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	return &feeHistory, nil
 }
 
+// feeHistoryFromRecord converts the fee history record of a block kept by the fee module, the rewards
+// are computed from the sorted tx rewards of the block, the same as go-ethereum.
+func feeHistoryFromRecord(record *feetypes.BlockFeeHistory, rewardPercentiles []float64) *rpctypes.OneFeeHistory {
+	oneFeeHistory := &rpctypes.OneFeeHistory{
+		BaseFee: intToBig(record.BaseFee),
+		Reward:  make([]*big.Int, len(rewardPercentiles)),
+	}
+	if record.GasLimit > 0 {
+		oneFeeHistory.GasUsedRatio = float64(record.GasUsed) / float64(record.GasLimit)
+	}
+
+	for i, p := range rewardPercentiles {
+		oneFeeHistory.Reward[i] = record.Reward(p)
+	}
+	return oneFeeHistory
+}
+
+func intToBig(i *sdkmath.Int) *big.Int {
+	if i == nil || i.IsNil() {
+		return big.NewInt(0)
+	}
+	return i.BigInt()
+}
+
 func (b *BackendImpl) Engine() consensus.Engine {
 	// only for ethereum, pow -> pos
 	b.logger.Error("Engine is not valid")
@@ -165,20 +234,8 @@ func (b *BackendImpl) Engine() consensus.Engine {
 }
 
 func (b *BackendImpl) Syncing() (interface{}, error) {
-	status, err := b.clientCtx.Client.Status(b.ctx)
-	if err != nil {
-		return false, err
-	}
-
-	if !status.SyncInfo.CatchingUp {
-		return false, nil
+	if b.syncStatus == nil {
+		return false, errors.New("syncing status requires a CometBFT client")
 	}
-
-	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+	return b.syncStatus.Progress()
 }
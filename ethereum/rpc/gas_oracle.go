@@ -1,37 +1,73 @@
 package rpc
 
 import (
-	"context"
-
-	"github.com/ethereum/go-ethereum/core/types"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"sort"
+	"sync"
 )
 
-// BlockByNumber for OracleBackend, should not use it anywhere, unless you know about the block hash diffs.
-// Use ArtBlockByNumber instead.
-func (b *BackendImpl) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*ethtypes.Block, error) {
-	// DO NOT USE THIS METHOD, THIS JUST FOR OracleBackend
-	block, err := b.ArtBlockByNumber(ctx, number)
+// gasTipCache caches the tip suggested at the latest block height
+type gasTipCache struct {
+	sync.Mutex
+	height int64
+	tip    *big.Int
+}
+
+// SuggestGasTipCap suggests a priority fee from the effective tips paid in the recent blocks. The tip at the
+// configured percentile of the gas used is sampled from each of the recent blocks, the sampled tips below
+// the ignore price are dropped and the configured percentile of the rest is suggested, capped by the max
+// price. If no tip is sampled, e.g. the recent blocks are empty, the max base fee increase of the next
+// block is suggested to make sure the txs is includable.
+func (b *BackendImpl) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
+	if baseFee == nil {
+		// london hardfork not enabled or feemarket not enabled
+		return big.NewInt(0), nil
+	}
+
+	head, err := b.BlockNumber()
 	if err != nil {
 		return nil, err
 	}
-	// BEWARE THE HASH OF THIS BLOCK IS NOT MATCH TO WHAT WAS STORED IN COSMOS DB
-	return block.EthBlock(), nil
-}
 
-// BlockByNumberOrHash for OracleBackend, should not use it anywhere, unless you know about the block hash diffs.
-// Use ArtBlockByNumberOrHash instead.
-func (b *BackendImpl) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*ethtypes.Block, error) {
-	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	b.tipCache.Lock()
+	defer b.tipCache.Unlock()
+	if b.tipCache.tip != nil && b.tipCache.height == int64(head) {
+		return new(big.Int).Set(b.tipCache.tip), nil
+	}
+
+	gpo := b.cfg.GPO
+	history, err := b.feeHistory(int64(head), int64(gpo.Blocks), []float64{float64(gpo.Percentile)})
 	if err != nil {
 		return nil, err
 	}
 
-	// BEWARE THE HASH OF THIS BLOCK IS NOT MATCH TO WHAT WAS STORED IN COSMOS DB
-	return b.BlockByNumber(ctx, blockNum)
-}
+	tips := make([]*big.Int, 0, len(history.Reward))
+	for _, rewards := range history.Reward {
+		if len(rewards) == 0 || rewards[0] == nil {
+			continue
+		}
+		tip := rewards[0].ToInt()
+		if gpo.IgnorePrice != nil && tip.Cmp(gpo.IgnorePrice) < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+
+	var tip *big.Int
+	if len(tips) == 0 {
+		if tip, err = b.maxBaseFeeDelta(baseFee); err != nil {
+			return nil, err
+		}
+	} else {
+		sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+		tip = new(big.Int).Set(tips[(len(tips)-1)*gpo.Percentile/100])
+	}
+
+	if gpo.MaxPrice != nil && tip.Cmp(gpo.MaxPrice) > 0 {
+		tip = new(big.Int).Set(gpo.MaxPrice)
+	}
 
-func (b *BackendImpl) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
-	return nil, nil
+	b.tipCache.height = int64(head)
+	b.tipCache.tip = tip
+	return new(big.Int).Set(tip), nil
 }
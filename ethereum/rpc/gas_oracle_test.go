@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	feetypes "github.com/artela-network/artela/x/fee/types"
+)
+
+// mockFeeQueryClient returns the fee history of the blocks with a single tx paying the given tip
+type mockFeeQueryClient struct {
+	feetypes.QueryClient
+
+	tips map[int64]int64
+}
+
+func (c *mockFeeQueryClient) Params(context.Context, *feetypes.QueryParamsRequest, ...grpc.CallOption) (*feetypes.QueryParamsResponse, error) {
+	return &feetypes.QueryParamsResponse{Params: feetypes.DefaultParams()}, nil
+}
+
+func (c *mockFeeQueryClient) FeeHistory(_ context.Context, req *feetypes.QueryFeeHistoryRequest, _ ...grpc.CallOption) (*feetypes.QueryFeeHistoryResponse, error) {
+	baseFee := sdkmath.NewInt(1000)
+	res := &feetypes.QueryFeeHistoryResponse{NextBaseFee: &baseFee}
+	for height := req.LastBlock - int64(req.BlockCount) + 1; height <= req.LastBlock; height++ {
+		record := feetypes.BlockFeeHistory{Height: height, BaseFee: &baseFee, GasUsed: 21000, GasLimit: 1_000_000}
+		if tip, ok := c.tips[height]; ok {
+			record.TxRewards = []feetypes.TxReward{{GasUsed: 21000, Reward: sdkmath.NewInt(tip)}}
+		}
+		res.Blocks = append(res.Blocks, record)
+	}
+	return res, nil
+}
+
+func newGasOracleTestBackend(tips map[int64]int64, gpo gasprice.Config) (*BackendImpl, *mockEVMQueryClient) {
+	evmClient := &mockEVMQueryClient{height: 10}
+	return &BackendImpl{
+		ctx: context.Background(),
+		cfg: &Config{GPO: &gpo},
+		queryClient: &rpctypes.QueryClient{
+			QueryClient: evmClient,
+			FeeMarket:   &mockFeeQueryClient{tips: tips},
+		},
+		logger: log.Root(),
+	}, evmClient
+}
+
+func TestSuggestGasTipCap(t *testing.T) {
+	gpo := gasprice.Config{Blocks: 5, Percentile: 50, MaxPrice: big.NewInt(500), IgnorePrice: big.NewInt(2)}
+	baseFee := big.NewInt(1000)
+
+	// the tips of the blocks 6 to 10, the tip below the ignore price is dropped, the median of the rest is suggested
+	b, evmClient := newGasOracleTestBackend(map[int64]int64{6: 1, 7: 40, 8: 10, 9: 30, 10: 20}, gpo)
+	tip, err := b.SuggestGasTipCap(baseFee)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(20), tip)
+
+	// the tip is cached at the same height
+	b.queryClient.FeeMarket = &mockFeeQueryClient{tips: map[int64]int64{10: 100}}
+	tip, err = b.SuggestGasTipCap(baseFee)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(20), tip)
+
+	// and capped by the max price
+	evmClient.height = 11
+	b.queryClient.FeeMarket = &mockFeeQueryClient{tips: map[int64]int64{11: 1000}}
+	tip, err = b.SuggestGasTipCap(baseFee)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), tip)
+
+	// no tip before london
+	tip, err = b.SuggestGasTipCap(nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), tip)
+}
+
+func TestSuggestGasTipCapFallback(t *testing.T) {
+	gpo := gasprice.Config{Blocks: 5, Percentile: 50, MaxPrice: big.NewInt(500), IgnorePrice: big.NewInt(2)}
+
+	// without tips sampled from the recent blocks, the max base fee increase of the next block is suggested,
+	// which is 1000 * (2 - 1) / 8 with the default params
+	b, _ := newGasOracleTestBackend(map[int64]int64{9: 1}, gpo)
+	tip, err := b.SuggestGasTipCap(big.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(125), tip)
+
+	// capped by the max price as well
+	b, _ = newGasOracleTestBackend(nil, gpo)
+	tip, err = b.SuggestGasTipCap(big.NewInt(100_000))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(500), tip)
+}
@@ -1,74 +1,153 @@
 package rpc
 
 import (
-	"fmt"
+	"crypto/ecdsa"
+	"errors"
+	"strings"
 	"time"
 
 	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
-	"github.com/artela-network/artela/ethereum/crypto/hd"
-	types2 "github.com/artela-network/artela/ethereum/types"
 )
 
-func (b *BackendImpl) NewAccount(password string) (common.AddressEIP55, error) {
-	name := "key_" + time.Now().UTC().Format(time.RFC3339)
-
-	cfg := sdktypes.GetConfig()
-	basePath := cfg.GetFullBIP44Path()
-
-	hdPathIter, err := types2.NewHDPathIterator(basePath, true)
-	if err != nil {
-		b.logger.Info("NewHDPathIterator failed", "error", err)
-		return common.AddressEIP55{}, err
-	}
-	// create the mnemonic and save the account
-	hdPath := hdPathIter()
+// errKeyringOnlyAccount is returned for the accounts kept in the node's keyring without a key file
+var errKeyringOnlyAccount = errors.New("account has no key file, import its private key with personal_importRawKey to set its password")
 
-	info, _, err := b.clientCtx.Keyring.NewMnemonic(name, keyring.English, hdPath.String(), password, hd.EthSecp256k1)
+// NewAccount creates a new account, the key is kept in the node's keyring and its key file
+// is encrypted with the password.
+func (b *BackendImpl) NewAccount(password string) (common.AddressEIP55, error) {
+	priv, err := crypto.GenerateKey()
 	if err != nil {
-		b.logger.Info("NewMnemonic failed", "error", err)
+		b.logger.Info("GenerateKey failed", "error", err)
 		return common.AddressEIP55{}, err
 	}
 
-	pubKey, err := info.GetPubKey()
+	name := "key_" + time.Now().UTC().Format(time.RFC3339)
+	addr, err := b.importKey(name, priv, password, func() error {
+		_, err := b.keystore.Store(priv, password)
+		return err
+	})
 	if err != nil {
-		b.logger.Info("GetPubKey failed", "error", err)
+		b.logger.Info("import key failed", "error", err)
 		return common.AddressEIP55{}, err
 	}
-	addr := common.BytesToAddress(pubKey.Address().Bytes())
 	return common.AddressEIP55(addr), nil
 }
 
+// ImportRawKey imports the hex encoded private key, the key is kept in the node's keyring and
+// its key file is encrypted with the password.
 func (b *BackendImpl) ImportRawKey(privkey, password string) (common.Address, error) {
 	priv, err := crypto.HexToECDSA(privkey)
 	if err != nil {
 		return common.Address{}, err
 	}
 
+	return b.importKey(personalKeyName(crypto.PubkeyToAddress(priv.PublicKey)), priv, password, func() error {
+		_, err := b.keystore.Store(priv, password)
+		return err
+	})
+}
+
+// ImportKeystore imports the key of the Web3 Secret Storage (v3 JSON) key file, if the password
+// decrypts it. The key is kept in the node's keyring.
+func (b *BackendImpl) ImportKeystore(keyJSON []byte, password string) (common.Address, error) {
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return b.importKey(personalKeyName(key.Address), key.PrivateKey, password, func() error {
+		return b.keystore.Write(key.Address, keyJSON)
+	})
+}
+
+// ExportKeystore returns the Web3 Secret Storage (v3 JSON) key file of the account, if the
+// password decrypts it.
+func (b *BackendImpl) ExportKeystore(address common.Address, password string) ([]byte, error) {
+	if err := b.checkKeyFile(address); err != nil {
+		return nil, err
+	}
+	return b.keystore.Export(address, password)
+}
+
+// UnlockAccount unlocks the account for the given duration if the password decrypts its key file,
+// zero duration unlocks the account until it's locked.
+func (b *BackendImpl) UnlockAccount(address common.Address, password string, duration time.Duration) error {
+	if err := b.checkKeyFile(address); err != nil {
+		return err
+	}
+	return b.keystore.Unlock(address, password, duration)
+}
+
+// LockAccount locks the account, it returns false if the account is not unlocked.
+func (b *BackendImpl) LockAccount(address common.Address) bool {
+	return b.keystore.Lock(address)
+}
+
+// AuthorizeAccount returns an error if the account is locked and the password doesn't decrypt its key file.
+// The accounts kept in the node's keyring without a key file are always authorized, same as before the key
+// files were introduced, since the keyring keeps no password to check.
+func (b *BackendImpl) AuthorizeAccount(address common.Address, password string) error {
+	if !b.keystore.Has(address) {
+		if _, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(address.Bytes())); err != nil {
+			return keystore.ErrNoMatch
+		}
+		return nil
+	}
+	return b.keystore.Authorize(address, password)
+}
+
+// checkKeyFile returns an error if the account has no key file. The key files are only created with the
+// passwords given by the personal api, an account kept in the node's keyring without a key file must be
+// imported again with its private key to set its password.
+func (b *BackendImpl) checkKeyFile(address common.Address) error {
+	if b.keystore.Has(address) {
+		return nil
+	}
+	if _, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(address.Bytes())); err != nil {
+		return keystore.ErrNoMatch
+	}
+	return errKeyringOnlyAccount
+}
+
+// importKey imports the private key into the node's keyring with the given name, and writes its key file
+// with storeKeyFile. The keyring import is skipped if the key has already been imported, or reverted if the
+// key file can't be written.
+func (b *BackendImpl) importKey(name string, priv *ecdsa.PrivateKey, password string, storeKeyFile func() error) (common.Address, error) {
 	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
 
 	addr := sdktypes.AccAddress(privKey.PubKey().Address().Bytes())
 	ethereumAddr := common.BytesToAddress(addr)
 
-	// return if the key has already been imported
-	if _, err := b.clientCtx.Keyring.KeyByAddress(addr); err == nil {
-		return ethereumAddr, nil
-	}
+	// skip the keyring import if the key has already been imported
+	_, err := b.clientCtx.Keyring.KeyByAddress(addr)
+	imported := err != nil
+	if imported {
+		armor := sdkcrypto.EncryptArmorPrivKey(privKey, password, ethsecp256k1.KeyType)
 
-	// ignore error as we only care about the length of the list
-	list, _ := b.clientCtx.Keyring.List() // #nosec G703
-	privKeyName := fmt.Sprintf("personal_%d", len(list))
-
-	armor := sdkcrypto.EncryptArmorPrivKey(privKey, password, ethsecp256k1.KeyType)
+		if err := b.clientCtx.Keyring.ImportPrivKey(name, armor, password); err != nil {
+			return common.Address{}, err
+		}
+	}
 
-	if err := b.clientCtx.Keyring.ImportPrivKey(privKeyName, armor, password); err != nil {
+	if err := storeKeyFile(); err != nil {
+		if imported {
+			if err := b.clientCtx.Keyring.DeleteByAddress(addr); err != nil {
+				b.logger.Error("revert keyring import failed", "address", ethereumAddr.Hex(), "error", err)
+			}
+		}
 		return common.Address{}, err
 	}
 
 	return ethereumAddr, nil
 }
+
+// personalKeyName returns the keyring name of the key imported through the personal api.
+func personalKeyName(address common.Address) string {
+	return "personal_" + strings.ToLower(strings.TrimPrefix(address.Hex(), "0x"))
+}
@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	cryptocodec "github.com/artela-network/artela/ethereum/crypto/codec"
+	"github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
+	"github.com/artela-network/artela/ethereum/crypto/hd"
+	artelakeyring "github.com/artela-network/artela/ethereum/crypto/keyring"
+)
+
+func init() {
+	// register the ethsecp256k1 keys to the amino codec of the armored keys
+	cryptocodec.RegisterCrypto(codec.NewLegacyAmino())
+}
+
+func newPersonalTestBackend(t *testing.T) *BackendImpl {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry), hd.EthSecp256k1Option())
+
+	return &BackendImpl{
+		clientCtx: client.Context{Keyring: kr},
+		keystore:  artelakeyring.NewKeystore(t.TempDir()),
+		logger:    log.Root(),
+	}
+}
+
+// newTestKeyJSON returns the key file of a new key encrypted with the light scrypt parameters
+func newTestKeyJSON(t *testing.T, password string) (common.Address, []byte) {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key := &keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(priv.PublicKey), PrivateKey: priv}
+	keyJSON, err := keystore.EncryptKey(key, password, keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	return key.Address, keyJSON
+}
+
+func TestKeyringOnlyAccount(t *testing.T) {
+	b := newPersonalTestBackend(t)
+
+	// the account imported into the keyring before the key files were introduced
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
+	armor := sdkcrypto.EncryptArmorPrivKey(privKey, "keyring", ethsecp256k1.KeyType)
+	require.NoError(t, b.clientCtx.Keyring.ImportPrivKey("validator", armor, "keyring"))
+	addr := crypto.PubkeyToAddress(priv.PublicKey)
+
+	// signing keeps working without a password
+	require.NoError(t, b.AuthorizeAccount(addr, ""))
+	require.NoError(t, b.AuthorizeAccount(addr, "any"))
+
+	// no password is minted for the account, it can't be unlocked or exported
+	require.ErrorIs(t, b.UnlockAccount(addr, "any", time.Minute), errKeyringOnlyAccount)
+	_, err = b.ExportKeystore(addr, "any")
+	require.ErrorIs(t, err, errKeyringOnlyAccount)
+	require.False(t, b.keystore.Has(addr))
+
+	// the unknown accounts are not found
+	unknown := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	require.ErrorIs(t, b.AuthorizeAccount(unknown, ""), keystore.ErrNoMatch)
+	require.ErrorIs(t, b.UnlockAccount(unknown, "any", time.Minute), keystore.ErrNoMatch)
+}
+
+func TestImportKeystoreKeyName(t *testing.T) {
+	b := newPersonalTestBackend(t)
+
+	alice, aliceJSON := newTestKeyJSON(t, "alice")
+	bob, bobJSON := newTestKeyJSON(t, "bob")
+	carol, carolJSON := newTestKeyJSON(t, "carol")
+
+	for _, key := range []struct {
+		addr     common.Address
+		keyJSON  []byte
+		password string
+	}{{alice, aliceJSON, "alice"}, {bob, bobJSON, "bob"}} {
+		addr, err := b.ImportKeystore(key.keyJSON, key.password)
+		require.NoError(t, err)
+		require.Equal(t, key.addr, addr)
+	}
+
+	// the names are derived from the addresses, so they don't collide after a key is deleted
+	require.NoError(t, b.clientCtx.Keyring.DeleteByAddress(sdktypes.AccAddress(alice.Bytes())))
+	addr, err := b.ImportKeystore(carolJSON, "carol")
+	require.NoError(t, err)
+	require.Equal(t, carol, addr)
+
+	for _, addr := range []common.Address{bob, carol} {
+		record, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(addr.Bytes()))
+		require.NoError(t, err)
+		require.Equal(t, personalKeyName(addr), record.Name)
+	}
+
+	// the imported accounts are protected by the passwords of their key files
+	require.ErrorIs(t, b.UnlockAccount(bob, "carol", time.Minute), keystore.ErrDecrypt)
+	require.NoError(t, b.UnlockAccount(bob, "bob", time.Minute))
+	require.NoError(t, b.AuthorizeAccount(bob, ""))
+}
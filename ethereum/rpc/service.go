@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/artela-network/artela/ethereum/rpc/types"
+	ethereumtypes "github.com/artela-network/artela/ethereum/types"
 )
 
 type ArtelaService struct {
@@ -29,6 +30,9 @@ func NewArtelaService(
 	stack types.NetworkingStack,
 	logger log.Logger,
 	db db.DB,
+	indexer ethereumtypes.EVMTxIndexer,
+	txPool types.TxPool,
+	mempool types.Mempool,
 ) *ArtelaService {
 	art := &ArtelaService{
 		cfg:       cfg,
@@ -39,7 +43,7 @@ func NewArtelaService(
 		logger:    logger,
 	}
 
-	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger, db)
+	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger, db, indexer, txPool, mempool)
 	return art
 }
 
@@ -47,6 +51,11 @@ func (art *ArtelaService) APIs() []rpc.API {
 	return GetAPIs(art.clientCtx, art.serverCtx, art.wsClient, art.logger, art.backend)
 }
 
+// SyncStatus returns the sync status tracker shared with the syncing subscriptions of the websockets server.
+func (art *ArtelaService) SyncStatus() *SyncStatus {
+	return art.backend.syncStatus
+}
+
 // Start start the ethereum JsonRPC service
 func (art *ArtelaService) Start() error {
 	if err := art.registerAPIs(); err != nil {
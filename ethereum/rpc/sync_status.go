@@ -0,0 +1,160 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// syncingPollInterval is the interval the node status is polled for the syncing subscriptions
+const syncingPollInterval = time.Second
+
+// statusClient is the CometBFT client querying the node status
+type statusClient interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+}
+
+// SyncEvent is sent to the syncing subscriptions on a transition of the catching up state, the progress
+// is false if the node is not catching up.
+type SyncEvent struct {
+	Progress interface{}
+}
+
+// SyncStatus tracks the catching up state of the node, shared by eth_syncing and the syncing subscriptions.
+// The starting block is the latest block of the node when it's first seen catching up. The highest block is
+// not reported, since CometBFT doesn't expose the heights of the peers. The node status is polled by a single
+// poller while there are syncing subscriptions, which are notified on the transitions of the catching up state.
+type SyncStatus struct {
+	client       statusClient
+	logger       log.Logger
+	pollInterval time.Duration
+
+	mu            sync.Mutex
+	catchingUp    bool
+	startingBlock int64
+	subs          int
+	done          chan struct{}
+
+	feed event.Feed
+}
+
+// NewSyncStatus creates the sync status tracker of the node.
+func NewSyncStatus(client statusClient, logger log.Logger) *SyncStatus {
+	return &SyncStatus{client: client, logger: logger, pollInterval: syncingPollInterval}
+}
+
+// Progress queries the node status, and returns the sync progress in the go-ethereum format, or false if the
+// node is not catching up.
+func (s *SyncStatus) Progress() (interface{}, error) {
+	if s.client == nil {
+		return false, nil
+	}
+
+	status, err := s.client.Status(context.Background())
+	if err != nil {
+		return false, err
+	}
+	return s.update(status), nil
+}
+
+// Subscribe starts polling the node status if not yet, and sends the sync progress to the channel on every
+// transition of the catching up state. The current sync progress is returned, and the poller is stopped when
+// all the subscriptions are unsubscribed.
+func (s *SyncStatus) Subscribe(ch chan<- SyncEvent) (interface{}, event.Subscription, error) {
+	if s.client == nil {
+		return nil, nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	progress, err := s.Progress()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := s.feed.Subscribe(ch)
+
+	s.mu.Lock()
+	s.subs++
+	if s.subs == 1 {
+		s.done = make(chan struct{})
+		go s.poll(s.done, s.catchingUp)
+	}
+	s.mu.Unlock()
+
+	return progress, event.NewSubscription(func(quit <-chan struct{}) error {
+		defer s.unsubscribe(sub)
+		select {
+		case <-quit:
+		case <-sub.Err():
+		}
+		return nil
+	}), nil
+}
+
+func (s *SyncStatus) unsubscribe(sub event.Subscription) {
+	sub.Unsubscribe()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs--
+	if s.subs == 0 {
+		close(s.done)
+	}
+}
+
+// poll polls the node status until done, the transitions are detected against the catching up state last
+// seen by the poller, so none is missed if the state is updated by eth_syncing in between.
+func (s *SyncStatus) poll(done chan struct{}, catchingUp bool) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		status, err := s.client.Status(context.Background())
+		if err != nil {
+			s.logger.Debug("failed to get node status for syncing subscriptions", "error", err.Error())
+			continue
+		}
+
+		progress := s.update(status)
+		if status.SyncInfo.CatchingUp != catchingUp {
+			catchingUp = status.SyncInfo.CatchingUp
+			s.feed.Send(SyncEvent{Progress: progress})
+		}
+	}
+}
+
+// update records the catching up state of the node status, and returns the sync progress.
+func (s *SyncStatus) update(status *coretypes.ResultStatus) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	syncInfo := status.SyncInfo
+	if !syncInfo.CatchingUp {
+		s.catchingUp = false
+		s.startingBlock = 0
+		return false
+	}
+
+	if !s.catchingUp {
+		s.catchingUp = true
+		s.startingBlock = syncInfo.LatestBlockHeight
+	}
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(s.startingBlock),
+		"currentBlock":  hexutil.Uint64(syncInfo.LatestBlockHeight),
+		// "highestBlock":  nil, // NA
+		// "pulledStates":  nil, // NA
+		// "knownStates":   nil, // NA
+	}
+}
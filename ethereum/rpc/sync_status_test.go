@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+// mockStatusClient returns the configured sync info of the node
+type mockStatusClient struct {
+	mu         sync.Mutex
+	catchingUp bool
+	latest     int64
+	calls      int
+}
+
+func (c *mockStatusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	status := &coretypes.ResultStatus{}
+	status.SyncInfo.CatchingUp = c.catchingUp
+	status.SyncInfo.LatestBlockHeight = c.latest
+	status.SyncInfo.EarliestBlockHeight = 1
+	return status, nil
+}
+
+func (c *mockStatusClient) set(catchingUp bool, latest int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.catchingUp = catchingUp
+	c.latest = latest
+}
+
+func (c *mockStatusClient) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
+
+func TestSyncStatusProgress(t *testing.T) {
+	client := &mockStatusClient{latest: 10}
+	s := NewSyncStatus(client, log.Root())
+
+	progress, err := s.Progress()
+	require.NoError(t, err)
+	require.Equal(t, false, progress)
+
+	// the starting block is the latest block when the node is first seen catching up
+	client.set(true, 20)
+	progress, err = s.Progress()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"startingBlock": hexutil.Uint64(20),
+		"currentBlock":  hexutil.Uint64(20),
+	}, progress)
+
+	client.set(true, 50)
+	progress, err = s.Progress()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"startingBlock": hexutil.Uint64(20),
+		"currentBlock":  hexutil.Uint64(50),
+	}, progress)
+
+	// the starting block is reset when caught up
+	client.set(false, 100)
+	progress, err = s.Progress()
+	require.NoError(t, err)
+	require.Equal(t, false, progress)
+
+	client.set(true, 120)
+	progress, err = s.Progress()
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(120), progress.(map[string]interface{})["startingBlock"])
+}
+
+func TestSyncStatusSubscribe(t *testing.T) {
+	client := &mockStatusClient{latest: 10}
+	s := NewSyncStatus(client, log.Root())
+	s.pollInterval = 10 * time.Millisecond
+
+	ch1, ch2 := make(chan SyncEvent, 4), make(chan SyncEvent, 4)
+	progress, sub1, err := s.Subscribe(ch1)
+	require.NoError(t, err)
+	require.Equal(t, false, progress)
+	_, sub2, err := s.Subscribe(ch2)
+	require.NoError(t, err)
+
+	// both subscriptions are notified of the transitions polled once
+	client.set(true, 20)
+	for _, ch := range []chan SyncEvent{ch1, ch2} {
+		select {
+		case ev := <-ch:
+			require.Equal(t, hexutil.Uint64(20), ev.Progress.(map[string]interface{})["startingBlock"])
+		case <-time.After(time.Second):
+			t.Fatal("syncing start not notified")
+		}
+	}
+
+	// a single poller is running for the subscriptions
+	calls := client.callCount()
+	time.Sleep(100 * time.Millisecond)
+	require.LessOrEqual(t, client.callCount()-calls, 15)
+
+	client.set(false, 30)
+	for _, ch := range []chan SyncEvent{ch1, ch2} {
+		select {
+		case ev := <-ch:
+			require.Equal(t, false, ev.Progress)
+		case <-time.After(time.Second):
+			t.Fatal("syncing done not notified")
+		}
+	}
+
+	// the poller is stopped when all the subscriptions are unsubscribed
+	sub1.Unsubscribe()
+	sub2.Unsubscribe()
+	time.Sleep(20 * time.Millisecond)
+	calls = client.callCount()
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, calls, client.callCount())
+}
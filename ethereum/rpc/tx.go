@@ -7,6 +7,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -249,6 +250,9 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		receipt["logs"] = []*ethtypes.Log{}
 	}
 
+	// gas consumed by the aspects, extension of the ethereum receipt
+	receipt["aspectGasUsed"] = b.aspectGasUsage(blockRes.TxsResults[res.TxIndex].Data, msgIndex)
+
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
 	if txData.GetTo() == nil || aspect.IsAspectDeploy(txData.GetTo(), txData.GetData()) {
 		receipt["contractAddress"] = crypto.CreateAddress(common.HexToAddress(res.Sender), txData.GetNonce())
@@ -264,6 +268,28 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 	return receipt, nil
 }
 
+// aspectGasUsage returns the gas consumed by each aspect at each join point of the message.
+func (b *BackendImpl) aspectGasUsage(data []byte, msgIndex int) []rpctypes.AspectGasUsage {
+	usages := make([]rpctypes.AspectGasUsage, 0)
+	responses, err := txs.DecodeTxResponses(data)
+	if err != nil {
+		b.logger.Debug("failed to decode tx responses", "error", err)
+		return usages
+	}
+	if msgIndex >= len(responses) {
+		return usages
+	}
+
+	for _, usage := range responses[msgIndex].AspectGasUsed {
+		usages = append(usages, rpctypes.AspectGasUsage{
+			AspectID:  common.HexToAddress(usage.AspectId),
+			JoinPoint: usage.JoinPoint,
+			GasUsed:   hexutil.Uint64(usage.GasUsed),
+		})
+	}
+	return usages
+}
+
 func (b *BackendImpl) RPCTxFeeCap() float64 {
 	return b.cfg.RPCTxFeeCap
 }
@@ -276,21 +302,44 @@ func (b *BackendImpl) UnprotectedAllowed() bool {
 }
 
 func (b *BackendImpl) PendingTransactions() ([]*sdktypes.Tx, error) {
-	res, err := b.clientCtx.Client.UnconfirmedTxs(b.ctx, nil)
-	if err != nil {
-		return nil, err
+	result, _, err := b.unconfirmedTxs()
+	return result, err
+}
+
+// unconfirmedTxs returns the txs in the CometBFT mempool and the total number of txs in the mempool. All the txs
+// are read from the mempool of the in-process node, otherwise the unconfirmed_txs query returns no more than
+// maxUnconfirmedTxs txs without paging, and the truncation is logged.
+func (b *BackendImpl) unconfirmedTxs() ([]*sdktypes.Tx, int, error) {
+	var (
+		txsBz tmtypes.Txs
+		total int
+	)
+	if b.mempool != nil {
+		txsBz = b.mempool.ReapMaxTxs(-1)
+		total = len(txsBz)
+	} else {
+		limit := maxUnconfirmedTxs
+		res, err := b.clientCtx.Client.UnconfirmedTxs(b.ctx, &limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		txsBz, total = res.Txs, res.Total
+		if total > len(txsBz) {
+			b.logger.Warn("txpool, only part of the unconfirmed txs are returned by CometBFT",
+				"returned", len(txsBz), "total", total)
+		}
 	}
 
-	result := make([]*sdktypes.Tx, 0, len(res.Txs))
-	for _, txBz := range res.Txs {
+	result := make([]*sdktypes.Tx, 0, len(txsBz))
+	for _, txBz := range txsBz {
 		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		result = append(result, &tx)
 	}
 
-	return result, nil
+	return result, total, nil
 }
 
 func (b *BackendImpl) GetResendArgs(args rpctypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (rpctypes.TransactionArgs, error) {
@@ -501,9 +550,14 @@ func (b *BackendImpl) version() (string, error) {
 }
 
 func (b *BackendImpl) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
-	// if b.indexer != nil {
-	// 	return b.indexer.GetByTxHash(hash)
-	// }
+	if b.indexer != nil {
+		txResult, err := b.indexer.GetByTxHash(hash)
+		if err == nil {
+			return txResult, nil
+		}
+		// the indexer may still be catching up, try the tendermint tx indexer
+		b.logger.Debug("eth tx not found in custom indexer", "hash", hash.Hex(), "error", err)
+	}
 
 	// fallback to tendermint tx indexer
 	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
@@ -606,31 +660,16 @@ func (b *BackendImpl) getAccountNonce(accAddr common.Address, pending bool, heig
 	}
 
 	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
-	// to manually add them.
-	pendingTxs, err := b.PendingTransactions()
+	// to manually add the executable ones, with consecutive nonces from the account nonce.
+	pendingTxs, _, err := b.TxPoolContentFrom(accAddr)
 	if err != nil {
 		return nonce, nil
 	}
 
-	// add the uncommitted txs to the nonce counter
-	// only supports `MsgEthereumTx` style tx
-	for _, tx := range pendingTxs {
-		for _, msg := range (*tx).GetMsgs() {
-			ethMsg, ok := msg.(*txs.MsgEthereumTx)
-			if !ok {
-				// not ethereum tx
-				break
-			}
-
-			sender, err := b.GetSender(ethMsg, b.chainID)
-			if err != nil {
-				continue
-			}
-			if sender == accAddr {
-				nonce++
-			}
+	for _, msg := range pendingTxs {
+		if msg.AsTransaction().Nonce() == nonce {
+			nonce++
 		}
 	}
-
 	return nonce, nil
 }
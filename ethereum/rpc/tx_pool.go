@@ -1,9 +1,177 @@
 package rpc
 
-func (b *BackendImpl) PendingTransactionsCount() (int, error) {
-	res, err := b.clientCtx.Client.UnconfirmedTxs(b.ctx, nil)
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// maxUnconfirmedTxs is the max number of txs returned by the unconfirmed_txs query of CometBFT.
+const maxUnconfirmedTxs = 100
+
+// TxPoolContent returns the ethereum txs in the mempool of each sender ordered by nonce, split into the pending
+// txs, executable with consecutive nonces from the account nonce, and the queued txs waiting for a nonce gap to
+// be filled. The app-side evm mempool is read if it's enabled, the txs in the CometBFT mempool otherwise.
+func (b *BackendImpl) TxPoolContent() (pending, queued map[common.Address][]*txs.MsgEthereumTx, err error) {
+	if b.txPool != nil {
+		pending, queued = b.txPool.Content()
+		return pending, queued, nil
+	}
+
+	poolTxs, _, err := b.txPoolTxs(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending = make(map[common.Address][]*txs.MsgEthereumTx)
+	queued = make(map[common.Address][]*txs.MsgEthereumTx)
+	for sender, txsByNonce := range poolTxs {
+		nonce, err := b.accountNonce(sender)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		senderPending, senderQueued := splitByNonce(txsByNonce, nonce)
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued ethereum txs of the sender in the mempool ordered by nonce.
+func (b *BackendImpl) TxPoolContentFrom(sender common.Address) (pending, queued []*txs.MsgEthereumTx, err error) {
+	if b.txPool != nil {
+		poolPending, poolQueued := b.txPool.Content()
+		return poolPending[sender], poolQueued[sender], nil
+	}
+
+	poolTxs, _, err := b.txPoolTxs(&sender)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce, err := b.accountNonce(sender)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending, queued = splitByNonce(poolTxs[sender], nonce)
+	return pending, queued, nil
+}
+
+// TxPoolStatus returns the number of pending and queued ethereum txs in the mempool. For the CometBFT mempool,
+// the txs not returned by the query are counted as pending, since the mempool only accepts the txs with the
+// next sequence of the sender.
+func (b *BackendImpl) TxPoolStatus() (pending, queued uint64, err error) {
+	if b.txPool != nil {
+		pending, queued = b.txPool.Stats()
+		return pending, queued, nil
+	}
+
+	poolTxs, missing, err := b.txPoolTxs(nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pending = uint64(missing)
+	for sender, txsByNonce := range poolTxs {
+		nonce, err := b.accountNonce(sender)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		senderPending, senderQueued := splitByNonce(txsByNonce, nonce)
+		pending += uint64(len(senderPending))
+		queued += uint64(len(senderQueued))
+	}
+	return pending, queued, nil
+}
+
+// txPoolTxs returns the ethereum txs in the CometBFT mempool by sender and nonce, and the number of txs in the
+// mempool not returned by the unconfirmed_txs query. Only the txs of the given sender are returned if it's not nil.
+// Of the txs with the same sender and nonce, the one replacing the others by the fee caps is returned.
+func (b *BackendImpl) txPoolTxs(from *common.Address) (map[common.Address]map[uint64]*txs.MsgEthereumTx, int, error) {
+	pendingTxs, total, err := b.unconfirmedTxs()
+	if err != nil {
+		return nil, 0, err
+	}
+	missing := 0
+	if total > len(pendingTxs) {
+		missing = total - len(pendingTxs)
+	}
+
+	poolTxs := make(map[common.Address]map[uint64]*txs.MsgEthereumTx)
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*txs.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := b.GetSender(ethMsg, b.chainID)
+			if err != nil {
+				b.logger.Debug("txpool, get pending transaction sender failed", "err", err.Error())
+				continue
+			}
+			if from != nil && *from != sender {
+				continue
+			}
+
+			if poolTxs[sender] == nil {
+				poolTxs[sender] = make(map[uint64]*txs.MsgEthereumTx)
+			}
+			nonce := ethMsg.AsTransaction().Nonce()
+			if existing, ok := poolTxs[sender][nonce]; !ok || replaces(ethMsg, existing) {
+				poolTxs[sender][nonce] = ethMsg
+			}
+		}
+	}
+	return poolTxs, missing, nil
+}
+
+// replaces reports whether the tx replaces the existing tx with the same nonce, both the fee cap and the tip cap
+// must be higher like in the replacement by the evm mempool. The first seen tx is kept otherwise.
+func replaces(msg, existing *txs.MsgEthereumTx) bool {
+	tx, old := msg.AsTransaction(), existing.AsTransaction()
+	return tx.GasFeeCapCmp(old) > 0 && tx.GasTipCapCmp(old) > 0
+}
+
+// accountNonce returns the nonce of the account at the latest block.
+func (b *BackendImpl) accountNonce(address common.Address) (uint64, error) {
+	res, err := b.queryClient.Account(b.ctx, &txs.QueryAccountRequest{Address: address.Hex()})
 	if err != nil {
 		return 0, err
 	}
-	return res.Total, nil
+	return res.Nonce, nil
+}
+
+// splitByNonce splits the txs of a sender ordered by nonce into the pending txs, with consecutive nonces from
+// the account nonce, and the queued txs after the first nonce gap. The txs with a nonce lower than the account
+// nonce are already executed or replaced, and are dropped.
+func splitByNonce(txsByNonce map[uint64]*txs.MsgEthereumTx, nonce uint64) (pending, queued []*txs.MsgEthereumTx) {
+	nonces := make([]uint64, 0, len(txsByNonce))
+	for txNonce := range txsByNonce {
+		if txNonce >= nonce {
+			nonces = append(nonces, txNonce)
+		}
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	for i, txNonce := range nonces {
+		if txNonce != nonce+uint64(i) {
+			for _, queuedNonce := range nonces[i:] {
+				queued = append(queued, txsByNonce[queuedNonce])
+			}
+			break
+		}
+		pending = append(pending, txsByNonce[txNonce])
+	}
+	return pending, queued
 }
@@ -0,0 +1,178 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// mockMempool returns the txs in the CometBFT mempool
+type mockMempool struct {
+	txs tmtypes.Txs
+}
+
+func (m *mockMempool) ReapMaxTxs(max int) tmtypes.Txs {
+	if max < 0 || max > len(m.txs) {
+		return m.txs
+	}
+	return m.txs[:max]
+}
+
+type txPoolTestSuite struct {
+	backend  *BackendImpl
+	mempool  *mockMempool
+	chainID  *big.Int
+	txConfig client.TxConfig
+}
+
+func newTxPoolTestSuite(nonces map[common.Address]uint64) *txPoolTestSuite {
+	registry := codectypes.NewInterfaceRegistry()
+	txs.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	accountNonces := make(map[string]uint64, len(nonces))
+	for address, nonce := range nonces {
+		accountNonces[address.Hex()] = nonce
+	}
+
+	mempool := &mockMempool{}
+	chainID := big.NewInt(1)
+	return &txPoolTestSuite{
+		backend: &BackendImpl{
+			chainID:     chainID,
+			clientCtx:   client.Context{}.WithTxConfig(txConfig),
+			queryClient: &rpctypes.QueryClient{QueryClient: &mockEVMQueryClient{nonces: accountNonces}},
+			mempool:     mempool,
+			logger:      log.Root(),
+		},
+		mempool:  mempool,
+		chainID:  chainID,
+		txConfig: txConfig,
+	}
+}
+
+// addTx signs an ethereum tx and adds it to the mempool
+func (s *txPoolTestSuite) addTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64, feeCap, tipCap int64) common.Hash {
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(s.chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   s.chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tipCap),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       21000,
+		To:        &to,
+	})
+	require.NoError(t, err)
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	cosmosTx, err := msg.BuildTx(s.txConfig.NewTxBuilder(), "aart")
+	require.NoError(t, err)
+	bz, err := s.txConfig.TxEncoder()(cosmosTx)
+	require.NoError(t, err)
+
+	s.mempool.txs = append(s.mempool.txs, bz)
+	return tx.Hash()
+}
+
+func hashes(msgs []*txs.MsgEthereumTx) []common.Hash {
+	result := make([]common.Hash, len(msgs))
+	for i, msg := range msgs {
+		result[i] = msg.AsTransaction().Hash()
+	}
+	return result
+}
+
+func TestTxPoolContent(t *testing.T) {
+	alice, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bob, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	aliceAddr, bobAddr := crypto.PubkeyToAddress(alice.PublicKey), crypto.PubkeyToAddress(bob.PublicKey)
+
+	s := newTxPoolTestSuite(map[common.Address]uint64{aliceAddr: 0, bobAddr: 5})
+
+	// more txs than returned by the unconfirmed_txs query of CometBFT
+	alicePending := make([]common.Hash, 0, maxUnconfirmedTxs+10)
+	for nonce := uint64(0); nonce < maxUnconfirmedTxs+10; nonce++ {
+		alicePending = append(alicePending, s.addTx(t, alice, nonce, 100, 10))
+	}
+	// the replacing tx with higher caps is kept, the tx not raising both caps doesn't replace
+	alicePending[3] = s.addTx(t, alice, 3, 200, 20)
+	s.addTx(t, alice, 3, 300, 20)
+
+	// the executed txs are dropped, the txs after the nonce gap are queued
+	s.addTx(t, bob, 4, 100, 10)
+	bobPending := []common.Hash{s.addTx(t, bob, 5, 100, 10), s.addTx(t, bob, 6, 100, 10)}
+	bobQueued := []common.Hash{s.addTx(t, bob, 8, 100, 10), s.addTx(t, bob, 9, 100, 10)}
+
+	pending, queued, err := s.backend.TxPoolContent()
+	require.NoError(t, err)
+	require.Equal(t, alicePending, hashes(pending[aliceAddr]))
+	require.Equal(t, bobPending, hashes(pending[bobAddr]))
+	require.Empty(t, queued[aliceAddr])
+	require.Equal(t, bobQueued, hashes(queued[bobAddr]))
+
+	pendingFrom, queuedFrom, err := s.backend.TxPoolContentFrom(bobAddr)
+	require.NoError(t, err)
+	require.Equal(t, bobPending, hashes(pendingFrom))
+	require.Equal(t, bobQueued, hashes(queuedFrom))
+
+	pendingCount, queuedCount, err := s.backend.TxPoolStatus()
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxUnconfirmedTxs+12), pendingCount)
+	require.Equal(t, uint64(2), queuedCount)
+}
+
+// mockCometRPC returns the first txs of the mempool for the unconfirmed_txs query
+type mockCometRPC struct {
+	client.TendermintRPC
+
+	mempool *mockMempool
+}
+
+func (c *mockCometRPC) UnconfirmedTxs(_ context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	txs := c.mempool.ReapMaxTxs(*limit)
+	return &coretypes.ResultUnconfirmedTxs{Count: len(txs), Total: len(c.mempool.txs), Txs: txs}, nil
+}
+
+func TestTxPoolStatusTruncated(t *testing.T) {
+	alice, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	aliceAddr := crypto.PubkeyToAddress(alice.PublicKey)
+
+	s := newTxPoolTestSuite(map[common.Address]uint64{aliceAddr: 0})
+	for nonce := uint64(0); nonce < maxUnconfirmedTxs+10; nonce++ {
+		s.addTx(t, alice, nonce, 100, 10)
+	}
+
+	// without the in-process node, only the first txs are returned by the query
+	s.backend.mempool = nil
+	s.backend.clientCtx = s.backend.clientCtx.WithClient(&mockCometRPC{mempool: s.mempool})
+
+	pending, _, err := s.backend.TxPoolContent()
+	require.NoError(t, err)
+	require.Len(t, pending[aliceAddr], maxUnconfirmedTxs)
+
+	// the txs not returned are still counted
+	pendingCount, queuedCount, err := s.backend.TxPoolStatus()
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxUnconfirmedTxs+10), pendingCount)
+	require.Equal(t, uint64(0), queuedCount)
+}
@@ -3,8 +3,10 @@ package types
 import (
 	"context"
 	"math/big"
+	"time"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,6 +15,8 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/proof"
+	aspecttypes "github.com/artela-network/artela/x/aspect/types"
 	"github.com/artela-network/artela/x/evm/txs"
 	evmtxs "github.com/artela-network/artela/x/evm/txs"
 	evmsupport "github.com/artela-network/artela/x/evm/txs/support"
@@ -47,8 +51,10 @@ type (
 		Backend
 
 		GetProof(address common.Address, storageKeys []string, blockNrOrHash BlockNumberOrHash) (*AccountResult, error)
-		DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error)
-		EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
+		GetICS23Proof(address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*proof.AccountProof, error)
+		DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (*txs.MsgEthereumTxResponse, error)
+		EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *StateOverride) (hexutil.Uint64, error)
+		CreateAccessList(args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*txs.QueryCreateAccessListResponse, error)
 
 		HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
 		HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
@@ -80,6 +86,7 @@ type (
 		GetResendArgs(args TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (TransactionArgs, error)
 		Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 		GetSender(msg *txs.MsgEthereumTx, chainID *big.Int) (from common.Address, err error)
+		AuthorizeAccount(address common.Address, password string) error
 	}
 
 	DebugBackend interface {
@@ -102,12 +109,46 @@ type (
 
 		NewAccount(password string) (common.AddressEIP55, error)
 		ImportRawKey(privkey, password string) (common.Address, error)
+		ImportKeystore(keyJSON []byte, password string) (common.Address, error)
+		ExportKeystore(address common.Address, password string) ([]byte, error)
+		UnlockAccount(address common.Address, password string, duration time.Duration) error
+		LockAccount(address common.Address) bool
 	}
 
 	TxPoolBackend interface {
 		TrancsactionBackend
 
-		PendingTransactionsCount() (int, error)
+		TxPoolContent() (pending, queued map[common.Address][]*txs.MsgEthereumTx, err error)
+		TxPoolContentFrom(sender common.Address) (pending, queued []*txs.MsgEthereumTx, err error)
+		TxPoolStatus() (pending, queued uint64, err error)
+	}
+
+	// TxPool is the app-side ethereum tx pool of the node, read by the txpool api instead of the
+	// CometBFT mempool if enabled.
+	TxPool interface {
+		Content() (pending, queued map[common.Address][]*txs.MsgEthereumTx)
+		Stats() (pending, queued uint64)
+	}
+
+	// Mempool is the CometBFT mempool of the in-process node, read by the txpool api for all the txs
+	// in the mempool, which are not paged by the unconfirmed_txs query.
+	Mempool interface {
+		ReapMaxTxs(max int) tmtypes.Txs
+	}
+
+	// AspectBackend defines the aspect store query interfaces
+	AspectBackend interface {
+		GetAspectMeta(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectMetaResponse, *aspecttypes.QueryAspectVersionsResponse, error)
+		GetAspectCode(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectCodeResponse, error)
+		GetAspectProperties(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectPropertiesResponse, error)
+		GetAspectBoundAccounts(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error)
+		GetAccountBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error)
+		GetAspectState(aspectID common.Address, key []byte, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, error)
+		GetAspectStates(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectStateEntry, error)
+		GetAspectStateDiff(aspectID common.Address, fromBlock, toBlock rpc.BlockNumberOrHash) (*aspecttypes.AspectStateDiff, error)
+		SimulateAspects(tx *types.Transaction, from *common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*txs.QuerySimulateAspectsResponse, error)
+		GetSchedule(id uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.Schedule, error)
+		GetAspectSchedules(aspectID common.Address, activeOnly bool, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.Schedule, error)
 	}
 
 	// NetBackend is the collection of methods required to satisfy the net
@@ -34,18 +34,29 @@ type StateOverride map[common.Address]OverrideAccount
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, states and stateDiff can't be specified at the same time. If states is
-// set, message execution will only use the data in the given states. Otherwise
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
 // if statDiff is set, all diff will be applied first and then execute the call
 // message.
 type OverrideAccount struct {
 	Nonce     *hexutil.Uint64              `json:"nonce"`
 	Code      *hexutil.Bytes               `json:"code"`
 	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"states"`
+	State     *map[common.Hash]common.Hash `json:"state"`
 	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
 }
 
+// BlockOverrides is a set of header fields to override.
+type BlockOverrides struct {
+	Number     *hexutil.Big    `json:"number"`
+	Difficulty *hexutil.Big    `json:"difficulty"`
+	Time       *hexutil.Uint64 `json:"time"`
+	GasLimit   *hexutil.Uint64 `json:"gasLimit"`
+	Coinbase   *common.Address `json:"coinbase"`
+	Random     *common.Hash    `json:"random"`
+	BaseFee    *hexutil.Big    `json:"baseFee"`
+}
+
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
 	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
@@ -64,3 +75,10 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// AspectGasUsage is the gas consumed by an aspect at a join point, reported in the receipt.
+type AspectGasUsage struct {
+	AspectID  common.Address `json:"aspectId"`
+	JoinPoint string         `json:"joinPoint"`
+	GasUsed   hexutil.Uint64 `json:"gasUsed"`
+}
@@ -77,7 +77,9 @@ type websocketsServer struct {
 	logger   log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, tmWSClient *rpcclient.WSClient, cfg *config.Config, logger log.Logger) WebsocketsServer {
+func NewWebsocketsServer(clientCtx client.Context, tmWSClient *rpcclient.WSClient, cfg *config.Config, logger log.Logger,
+	syncStatus *SyncStatus,
+) WebsocketsServer {
 	logger = logger.New("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -86,7 +88,7 @@ func NewWebsocketsServer(clientCtx client.Context, tmWSClient *rpcclient.WSClien
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, syncStatus),
 		logger:   logger,
 	}
 }
@@ -352,18 +354,20 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
-	events    *rpcfilter.EventSystem
-	logger    log.Logger
-	clientCtx client.Context
+	events     *rpcfilter.EventSystem
+	logger     log.Logger
+	clientCtx  client.Context
+	syncStatus *SyncStatus
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, syncStatus *SyncStatus) *pubSubAPI {
 	logger = logger.New("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilter.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:     rpcfilter.NewEventSystem(logger, tmWSClient),
+		logger:     logger,
+		clientCtx:  clientCtx,
+		syncStatus: syncStatus,
 	}
 }
 
@@ -741,8 +745,72 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// syncingResult is the notification of the syncing subscription when the node starts to synchronize,
+// same as go-ethereum. A false is notified when the synchronization is done.
+type syncingResult struct {
+	Syncing bool        `json:"syncing"`
+	Status  interface{} `json:"status"`
+}
+
+// subscribeSyncing notifies the transitions of the catching up state polled by the shared sync status.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.syncStatus == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	ch := make(chan SyncEvent)
+	progress, sub, err := api.syncStatus.Subscribe(ch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node status")
+	}
+
+	notify := func(progress interface{}) bool {
+		var result interface{} = false
+		if progress != false {
+			result = &syncingResult{Syncing: true, Status: progress}
+		}
+
+		err := wsConn.WriteJSON(&SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		})
+		if err != nil {
+			api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if !errors.Is(websocket.ErrCloseSent, err) {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+			return false
+		}
+		return true
+	}
+
+	go func() {
+		defer sub.Unsubscribe()
+
+		// the current state is notified only if the node is already syncing, the same as go-ethereum
+		if progress != false && !notify(progress) {
+			return
+		}
+		for {
+			select {
+			case ev := <-ch:
+				if !notify(ev.Progress) {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+
+	return sub.Unsubscribe, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -53,6 +53,18 @@ const (
 
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultGPOBlocks is the default number of recent blocks sampled by the gas price oracle
+	DefaultGPOBlocks int32 = 20
+
+	// DefaultGPOPercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGPOPercentile int32 = 60
+
+	// DefaultGPOIgnorePrice is the default tip in wei below which the sampled tips are ignored
+	DefaultGPOIgnorePrice int64 = 2
+
+	// DefaultGPOMaxPrice is the default max tip in wei suggested by the gas price oracle, 500 gwei
+	DefaultGPOMaxPrice int64 = 500_000_000_000
+
 	DefaultLogsCap int32 = 10000
 
 	DefaultBlockRangeCap int32 = 10000
@@ -71,6 +83,34 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultEVMMempoolEnable is false, the txs are proposed in the FIFO order of the CometBFT mempool
+	DefaultEVMMempoolEnable = false
+
+	// DefaultEVMMempoolPriceBump is the default minimum price bump percentage to replace a pending txs
+	DefaultEVMMempoolPriceBump uint64 = 10
+
+	// DefaultEVMMempoolAccountSlots is the default max number of executable txs per account
+	DefaultEVMMempoolAccountSlots uint64 = 16
+
+	// DefaultEVMMempoolAccountQueue is the default max number of non-executable txs per account
+	DefaultEVMMempoolAccountQueue uint64 = 64
+
+	// DefaultEVMMempoolGlobalSlots is the default max number of executable txs of all accounts
+	DefaultEVMMempoolGlobalSlots uint64 = 5120
+
+	// DefaultEVMMempoolGlobalQueue is the default max number of non-executable txs of all accounts
+	DefaultEVMMempoolGlobalQueue uint64 = 1024
+
+	// DefaultEVMMempoolLifetime is the default max time the non-executable txs are queued
+	DefaultEVMMempoolLifetime = 3 * time.Hour
+
+	// DefaultEVMMempoolAspectPrecheck is false, the aspects of the proposed txs are executed at delivery only
+	DefaultEVMMempoolAspectPrecheck = false
+
+	// DefaultEVMMempoolMaxBlockAspectGas is the default max gas consumed by the pre-executed aspects of
+	// the txs in a block proposal, 0 means unlimited
+	DefaultEVMMempoolMaxBlockAspectGas uint64 = 0
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -80,10 +120,11 @@ var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 type Config struct {
 	config.Config
 
-	EVM     EVMConfig     `mapstructure:"evm"`
-	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
-	TLS     TLSConfig     `mapstructure:"tls"`
-	Aspect  AspectConfig  `mapstructure:"aspect"`
+	EVM        EVMConfig        `mapstructure:"evm"`
+	EVMMempool EVMMempoolConfig `mapstructure:"evm-mempool"`
+	JSONRPC    JSONRPCConfig    `mapstructure:"json-rpc"`
+	TLS        TLSConfig        `mapstructure:"tls"`
+	Aspect     AspectConfig     `mapstructure:"aspect"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -95,6 +136,30 @@ type EVMConfig struct {
 	MaxTxGasWanted uint64 `mapstructure:"max-txs-gas-wanted"`
 }
 
+// EVMMempoolConfig defines the application configuration values for the app-side EVM mempool.
+type EVMMempoolConfig struct {
+	// Enable defines if the app-side EVM mempool should be used to order the proposed txs.
+	Enable bool `mapstructure:"enable"`
+	// PriceBump is the minimum price bump percentage to replace a txs with the same nonce.
+	PriceBump uint64 `mapstructure:"price-bump"`
+	// AccountSlots is the max number of executable txs per account.
+	AccountSlots uint64 `mapstructure:"account-slots"`
+	// AccountQueue is the max number of non-executable txs per account.
+	AccountQueue uint64 `mapstructure:"account-queue"`
+	// GlobalSlots is the max number of executable txs of all accounts.
+	GlobalSlots uint64 `mapstructure:"global-slots"`
+	// GlobalQueue is the max number of non-executable txs of all accounts.
+	GlobalQueue uint64 `mapstructure:"global-queue"`
+	// Lifetime is the max time the non-executable txs are queued.
+	Lifetime time.Duration `mapstructure:"lifetime"`
+	// AspectPrecheck defines if the VERIFY_TX and PRE_TX_EXECUTE aspects of the txs should be pre-executed
+	// when preparing a block proposal, to drop the txs rejected by the aspects.
+	AspectPrecheck bool `mapstructure:"aspect-precheck"`
+	// MaxBlockAspectGas is the max gas consumed by the pre-executed aspects of the txs in a block proposal,
+	// 0 means unlimited. It only applies to the proposals of this node.
+	MaxBlockAspectGas uint64 `mapstructure:"max-block-aspect-gas"`
+}
+
 // AspectConfig defines the application configuration values for Aspect.
 type AspectConfig struct {
 	// ApplyPoolSize defines capacity of aspect runtime instance pool for applying txs
@@ -139,10 +204,20 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackfillHeight defines the height from which the custom indexer re-indexes the blocks on start, 0 to disable.
+	IndexerBackfillHeight int64 `mapstructure:"indexer-backfill-height"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when txs reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// GPOBlocks defines the number of recent blocks sampled by the gas price oracle
+	GPOBlocks int32 `mapstructure:"gpo-blocks"`
+	// GPOPercentile defines the percentile of the sampled tips suggested by the gas price oracle
+	GPOPercentile int32 `mapstructure:"gpo-percentile"`
+	// GPOIgnorePrice defines the tip in wei below which the sampled tips are ignored by the gas price oracle
+	GPOIgnorePrice int64 `mapstructure:"gpo-ignore-price"`
+	// GPOMaxPrice defines the max tip in wei suggested by the gas price oracle
+	GPOMaxPrice int64 `mapstructure:"gpo-max-price"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -177,10 +252,11 @@ func AppConfig(denom string) (string, interface{}) {
 	}
 
 	customAppConfig := Config{
-		Config:  *srvCfg,
-		EVM:     *DefaultEVMConfig(),
-		JSONRPC: *DefaultJSONRPCConfig(),
-		TLS:     *DefaultTLSConfig(),
+		Config:     *srvCfg,
+		EVM:        *DefaultEVMConfig(),
+		EVMMempool: *DefaultEVMMempoolConfig(),
+		JSONRPC:    *DefaultJSONRPCConfig(),
+		TLS:        *DefaultTLSConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -191,11 +267,12 @@ func AppConfig(denom string) (string, interface{}) {
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		Config:  *DefaultServerConfig(),
-		EVM:     *DefaultEVMConfig(),
-		JSONRPC: *DefaultJSONRPCConfig(),
-		TLS:     *DefaultTLSConfig(),
-		Aspect:  *DefaultAspectConfig(),
+		Config:     *DefaultServerConfig(),
+		EVM:        *DefaultEVMConfig(),
+		EVMMempool: *DefaultEVMMempoolConfig(),
+		JSONRPC:    *DefaultJSONRPCConfig(),
+		TLS:        *DefaultTLSConfig(),
+		Aspect:     *DefaultAspectConfig(),
 	}
 }
 
@@ -289,6 +366,46 @@ func (c EVMConfig) Validate() error {
 	return nil
 }
 
+// DefaultEVMMempoolConfig returns the default app-side EVM mempool configuration
+func DefaultEVMMempoolConfig() *EVMMempoolConfig {
+	return &EVMMempoolConfig{
+		Enable:            DefaultEVMMempoolEnable,
+		PriceBump:         DefaultEVMMempoolPriceBump,
+		AccountSlots:      DefaultEVMMempoolAccountSlots,
+		AccountQueue:      DefaultEVMMempoolAccountQueue,
+		GlobalSlots:       DefaultEVMMempoolGlobalSlots,
+		GlobalQueue:       DefaultEVMMempoolGlobalQueue,
+		Lifetime:          DefaultEVMMempoolLifetime,
+		AspectPrecheck:    DefaultEVMMempoolAspectPrecheck,
+		MaxBlockAspectGas: DefaultEVMMempoolMaxBlockAspectGas,
+	}
+}
+
+// Validate returns an error if the app-side EVM mempool configuration fields are invalid.
+func (c EVMMempoolConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.AccountSlots == 0 || c.GlobalSlots == 0 {
+		return errors.New("account and global slots must be positive")
+	}
+
+	if c.AccountSlots > c.GlobalSlots || c.AccountQueue > c.GlobalQueue {
+		return errors.New("account slots and queue cannot exceed the global ones")
+	}
+
+	if c.Lifetime <= 0 {
+		return errors.New("lifetime must be positive")
+	}
+
+	if c.MaxBlockAspectGas > 0 && !c.AspectPrecheck {
+		return errors.New("max block aspect gas requires the aspect precheck to be enabled")
+	}
+
+	return nil
+}
+
 // DefaultAspectConfig returns the default Aspect configuration
 func DefaultAspectConfig() *AspectConfig {
 	return &AspectConfig{
@@ -317,7 +434,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "aspect"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -339,8 +456,13 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		IndexerBackfillHeight:    0,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		GPOBlocks:                DefaultGPOBlocks,
+		GPOPercentile:            DefaultGPOPercentile,
+		GPOIgnorePrice:           DefaultGPOIgnorePrice,
+		GPOMaxPrice:              DefaultGPOMaxPrice,
 	}
 }
 
@@ -354,6 +476,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC filter-cap cannot be negative")
 	}
 
+	if c.IndexerBackfillHeight < 0 {
+		return errors.New("JSON-RPC indexer-backfill-height cannot be negative")
+	}
+
 	if c.FeeHistoryCap <= 0 {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
@@ -382,6 +508,22 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.GPOBlocks <= 0 {
+		return errors.New("JSON-RPC gpo-blocks cannot be negative or 0")
+	}
+
+	if c.GPOPercentile < 0 || c.GPOPercentile > 100 {
+		return errors.New("JSON-RPC gpo-percentile must be between 0 and 100")
+	}
+
+	if c.GPOIgnorePrice < 0 {
+		return errors.New("JSON-RPC gpo-ignore-price cannot be negative")
+	}
+
+	if c.GPOMaxPrice <= 0 {
+		return errors.New("JSON-RPC gpo-max-price cannot be negative or 0")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -433,6 +575,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			Tracer:         v.GetString("evm.tracer"),
 			MaxTxGasWanted: v.GetUint64("evm.max-txs-gas-wanted"),
 		},
+		EVMMempool: EVMMempoolConfig{
+			Enable:            v.GetBool("evm-mempool.enable"),
+			PriceBump:         v.GetUint64("evm-mempool.price-bump"),
+			AccountSlots:      v.GetUint64("evm-mempool.account-slots"),
+			AccountQueue:      v.GetUint64("evm-mempool.account-queue"),
+			GlobalSlots:       v.GetUint64("evm-mempool.global-slots"),
+			GlobalQueue:       v.GetUint64("evm-mempool.global-queue"),
+			Lifetime:          v.GetDuration("evm-mempool.lifetime"),
+			AspectPrecheck:    v.GetBool("evm-mempool.aspect-precheck"),
+			MaxBlockAspectGas: v.GetUint64("evm-mempool.max-block-aspect-gas"),
+		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
 			API:                      v.GetStringSlice("json-rpc.api"),
@@ -449,9 +602,14 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
+			IndexerBackfillHeight:    v.GetInt64("json-rpc.indexer-backfill-height"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			AllowUnprotectedTxs:      v.GetBool("json-rpc.allow-unprotected-txs"),
+			GPOBlocks:                getInt32(v, "json-rpc.gpo-blocks", DefaultGPOBlocks),
+			GPOPercentile:            getInt32(v, "json-rpc.gpo-percentile", DefaultGPOPercentile),
+			GPOIgnorePrice:           getInt64(v, "json-rpc.gpo-ignore-price", DefaultGPOIgnorePrice),
+			GPOMaxPrice:              getInt64(v, "json-rpc.gpo-max-price", DefaultGPOMaxPrice),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -464,6 +622,23 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	}, nil
 }
 
+// getInt32 returns the value of the key, or the default value if the key is not set,
+// for the settings missing in the config files created by older versions.
+func getInt32(v *viper.Viper, key string, defaultValue int32) int32 {
+	if !v.IsSet(key) {
+		return defaultValue
+	}
+	return v.GetInt32(key)
+}
+
+// getInt64 returns the value of the key, or the default value if the key is not set.
+func getInt64(v *viper.Viper, key string, defaultValue int64) int64 {
+	if !v.IsSet(key) {
+		return defaultValue
+	}
+	return v.GetInt64(key)
+}
+
 // ParseConfig retrieves the default environment configuration for the
 // application.
 func ParseConfig(v *viper.Viper) (*Config, error) {
@@ -479,6 +654,10 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid evm config value: %s", err.Error())
 	}
 
+	if err := c.EVMMempool.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid evm mempool config value: %s", err.Error())
+	}
+
 	if err := c.JSONRPC.Validate(); err != nil {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid json-rpc config value: %s", err.Error())
 	}
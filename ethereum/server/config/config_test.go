@@ -12,3 +12,15 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidateGPO(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.GPOPercentile = 101
+	require.Error(t, cfg.Validate())
+
+	cfg = DefaultJSONRPCConfig()
+	cfg.GPOBlocks = 0
+	require.Error(t, cfg.Validate())
+}
@@ -16,6 +16,43 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth txs returned in ante handler in check txs mode.
 max-txs-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+###############################################################################
+###                          EVM Mempool Configuration                      ###
+###############################################################################
+
+[evm-mempool]
+
+# Enable defines if the app-side EVM mempool should be used to order the proposed txs by
+# sender nonce and effective tip, instead of the FIFO order of the CometBFT mempool.
+enable = {{ .EVMMempool.Enable }}
+
+# PriceBump is the minimum price bump percentage to replace a txs with the same nonce.
+price-bump = {{ .EVMMempool.PriceBump }}
+
+# AccountSlots is the max number of executable txs per account.
+account-slots = {{ .EVMMempool.AccountSlots }}
+
+# AccountQueue is the max number of non-executable (future nonce) txs per account.
+account-queue = {{ .EVMMempool.AccountQueue }}
+
+# GlobalSlots is the max number of executable txs of all accounts.
+global-slots = {{ .EVMMempool.GlobalSlots }}
+
+# GlobalQueue is the max number of non-executable txs of all accounts.
+global-queue = {{ .EVMMempool.GlobalQueue }}
+
+# Lifetime is the max time the non-executable txs are queued.
+lifetime = "{{ .EVMMempool.Lifetime }}"
+
+# AspectPrecheck defines if the VERIFY_TX and PRE_TX_EXECUTE aspects of the txs should be pre-executed
+# when preparing a block proposal, the txs rejected by the aspects are dropped from the proposal.
+aspect-precheck = {{ .EVMMempool.AspectPrecheck }}
+
+# MaxBlockAspectGas is the max gas consumed by the pre-executed aspects of the txs in a block proposal,
+# 0 means unlimited. It requires the aspect precheck to be enabled, and it only applies to the proposals
+# of this node, the received proposals are not checked against it.
+max-block-aspect-gas = {{ .EVMMempool.MaxBlockAspectGas }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -73,6 +110,9 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom txs indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexerBackfillHeight re-indexes the blocks from the given height when the custom indexer starts, 0 to disable.
+indexer-backfill-height = {{ .JSONRPC.IndexerBackfillHeight }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -80,6 +120,18 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when txs reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# GPOBlocks defines the number of recent blocks sampled by the gas price oracle.
+gpo-blocks = {{ .JSONRPC.GPOBlocks }}
+
+# GPOPercentile defines the percentile of the sampled priority fees suggested by the gas price oracle.
+gpo-percentile = {{ .JSONRPC.GPOPercentile }}
+
+# GPOIgnorePrice defines the priority fee in wei below which the sampled priority fees are ignored.
+gpo-ignore-price = {{ .JSONRPC.GPOIgnorePrice }}
+
+# GPOMaxPrice defines the max priority fee in wei suggested by the gas price oracle.
+gpo-max-price = {{ .JSONRPC.GPOMaxPrice }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -51,6 +51,7 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCIndexerBackfill     = "json-rpc.indexer-backfill-height"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -64,6 +65,19 @@ const (
 	EVMMaxTxGasWanted = "evm.max-txs-gas-wanted"
 )
 
+// EVM mempool flags
+const (
+	EVMMempoolEnable            = "evm-mempool.enable"
+	EVMMempoolPriceBump         = "evm-mempool.price-bump"
+	EVMMempoolAccountSlots      = "evm-mempool.account-slots"
+	EVMMempoolAccountQueue      = "evm-mempool.account-queue"
+	EVMMempoolGlobalSlots       = "evm-mempool.global-slots"
+	EVMMempoolGlobalQueue       = "evm-mempool.global-queue"
+	EVMMempoolLifetime          = "evm-mempool.lifetime"
+	EVMMempoolAspectPrecheck    = "evm-mempool.aspect-precheck"
+	EVMMempoolMaxBlockAspectGas = "evm-mempool.max-block-aspect-gas"
+)
+
 // Aspect flags
 const (
 	ApplyPoolSize = "aspect.apply-pool-size"
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+
+	"github.com/artela-network/artela/ethereum/indexer"
+)
+
+const (
+	ServiceName = "EVMIndexerService"
+
+	NewBlockWaitTimeout = 60 * time.Second
+)
+
+// EVMIndexerService indexes transactions for json-rpc service.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr         *indexer.KVIndexer
+	client         rpcclient.Client
+	backfillHeight int64
+}
+
+// NewEVMIndexerService returns a new service instance. If backfillHeight is positive,
+// the service re-indexes the blocks from backfillHeight before following the chain.
+func NewEVMIndexerService(
+	txIdxr *indexer.KVIndexer,
+	client rpcclient.Client,
+	backfillHeight int64,
+) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client, backfillHeight: backfillHeight}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing them by events.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latestBlock := status.SyncInfo.LatestBlockHeight
+	newBlockSignal := make(chan struct{}, 1)
+
+	// Use SubscribeUnbuffered here to ensure both subscriptions does not get
+	// canceled due to not pulling messages fast enough. Cause this might
+	// sometimes happen when there are no other subscribers.
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		ServiceName,
+		types.QueryForEvent(types.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			msg := <-blockHeadersChan
+			eventDataHeader := msg.Data.(types.EventDataNewBlockHeader)
+			if eventDataHeader.Header.Height > latestBlock {
+				latestBlock = eventDataHeader.Header.Height
+				// notify
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	lastIndexed, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	lastBlock := indexer.StartHeight(eis.Logger, lastIndexed, status.SyncInfo.EarliestBlockHeight, eis.backfillHeight) - 1
+
+	for {
+		if latestBlock <= lastBlock {
+			// nothing to index. wait for signal of new block
+			select {
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+			}
+			continue
+		}
+		for i := lastBlock + 1; i <= latestBlock; i++ {
+			block, err := eis.client.Block(ctx, &i)
+			if err != nil {
+				eis.Logger.Error("failed to fetch block", "height", i, "err", err)
+				break
+			}
+			blockResult, err := eis.client.BlockResults(ctx, &i)
+			if err != nil {
+				eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
+				break
+			}
+			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+				eis.Logger.Error("failed to index block", "height", i, "err", err)
+			}
+			lastBlock = blockResult.Height
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/local"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -37,9 +38,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
+	"github.com/artela-network/artela/ethereum/indexer"
 	"github.com/artela-network/artela/ethereum/rpc"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/server/config"
 	artelaflag "github.com/artela-network/artela/ethereum/server/flags"
+	artela "github.com/artela-network/artela/ethereum/types"
 	aspecttypes "github.com/artela-network/aspect-core/types"
 )
 
@@ -223,11 +227,22 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Int32(artelaflag.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(artelaflag.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(artelaflag.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Int64(artelaflag.JSONRPCIndexerBackfill, 0, "Re-index the eth txs from the given height when the custom tx indexer starts (0=disabled)")
 	cmd.Flags().Bool(artelaflag.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(artelaflag.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(artelaflag.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 
+	cmd.Flags().Bool(artelaflag.EVMMempoolEnable, config.DefaultEVMMempoolEnable, "Define if the app-side EVM mempool should be used to order the proposed txs")
+	cmd.Flags().Uint64(artelaflag.EVMMempoolPriceBump, config.DefaultEVMMempoolPriceBump, "the minimum price bump percentage to replace a tx with the same nonce")
+	cmd.Flags().Uint64(artelaflag.EVMMempoolAccountSlots, config.DefaultEVMMempoolAccountSlots, "the max number of executable txs per account")
+	cmd.Flags().Uint64(artelaflag.EVMMempoolAccountQueue, config.DefaultEVMMempoolAccountQueue, "the max number of non-executable txs per account")
+	cmd.Flags().Uint64(artelaflag.EVMMempoolGlobalSlots, config.DefaultEVMMempoolGlobalSlots, "the max number of executable txs of all accounts")
+	cmd.Flags().Uint64(artelaflag.EVMMempoolGlobalQueue, config.DefaultEVMMempoolGlobalQueue, "the max number of non-executable txs of all accounts")
+	cmd.Flags().Duration(artelaflag.EVMMempoolLifetime, config.DefaultEVMMempoolLifetime, "the max time the non-executable txs are queued")
+	cmd.Flags().Bool(artelaflag.EVMMempoolAspectPrecheck, config.DefaultEVMMempoolAspectPrecheck, "Define if the verify tx and pre tx execute aspects should be pre-executed to drop the rejected txs from the proposal")
+	cmd.Flags().Uint64(artelaflag.EVMMempoolMaxBlockAspectGas, config.DefaultEVMMempoolMaxBlockAspectGas, "the max gas consumed by the pre-executed aspects of the txs in a block proposal, 0 means unlimited")
+
 	cmd.Flags().String(artelaflag.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(artelaflag.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
 
@@ -380,6 +395,36 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 		app.RegisterNodeService(clientCtx)
 	}
 
+	var idxer artela.EVMTxIndexer
+	if tmNode != nil && config.JSONRPC.EnableIndexer {
+		idxDB, err := OpenIndexerDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		kvIndexer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		indexerService := NewEVMIndexerService(kvIndexer, clientCtx.Client.(rpcclient.Client), config.JSONRPC.IndexerBackfillHeight)
+		indexerService.SetLogger(idxLogger)
+
+		errCh := make(chan error)
+		go func() {
+			if err := indexerService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+
+		case <-time.After(types.ServerStartTime): // assume server started successfully
+		}
+
+		idxer = kvIndexer
+	}
+
 	metrics, err := startTelemetry(config.Config)
 	if err != nil {
 		return err
@@ -467,7 +512,18 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		jsonrpcSrv, err = CreateJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, db)
+
+		// the txpool api reads the app-side evm mempool if it's enabled
+		var txPool rpctypes.TxPool
+		if provider, ok := app.(interface{ TxPool() rpctypes.TxPool }); ok {
+			txPool = provider.TxPool()
+		}
+		// the txs in the CometBFT mempool are read from the in-process node otherwise
+		var mempool rpctypes.Mempool
+		if tmNode != nil {
+			mempool = tmNode.Mempool()
+		}
+		jsonrpcSrv, err = CreateJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, db, idxer, txPool, mempool)
 		if err != nil {
 			return err
 		}
@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"io"
+	"math/big"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -22,7 +23,9 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 
 	ethrpc "github.com/artela-network/artela/ethereum/rpc"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/server/config"
+	artela "github.com/artela-network/artela/ethereum/types"
 	ethNode "github.com/ethereum/go-ethereum/node"
 )
 
@@ -63,6 +66,9 @@ func CreateJSONRPC(ctx *sdkserver.Context,
 	tmEndpoint string,
 	config *config.Config,
 	db db.DB,
+	indexer artela.EVMTxIndexer,
+	txPool rpctypes.TxPool,
+	mempool rpctypes.Mempool,
 ) (*ethrpc.ArtelaService, error) {
 	cfg := getRpcConfig(config)
 
@@ -78,11 +84,11 @@ func CreateJSONRPC(ctx *sdkserver.Context,
 
 	wsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
 
-	serv := ethrpc.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, nodeCfg.Logger, db)
+	serv := ethrpc.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, nodeCfg.Logger, db, indexer, txPool, mempool)
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
-	wsSrv := ethrpc.NewWebsocketsServer(clientCtx, tmWsClient, config, nodeCfg.Logger)
+	wsSrv := ethrpc.NewWebsocketsServer(clientCtx, tmWsClient, config, nodeCfg.Logger, serv.SyncStatus())
 	wsSrv.Start()
 
 	return serv, nil
@@ -129,6 +135,10 @@ func getRpcConfig(config *config.Config) *ethrpc.Config {
 	cfg.RPCGasCap = config.JSONRPC.GasCap
 	cfg.RPCEVMTimeout = config.JSONRPC.EVMTimeout
 	cfg.RPCTxFeeCap = config.JSONRPC.TxFeeCap
+	cfg.GPO.Blocks = int(config.JSONRPC.GPOBlocks)
+	cfg.GPO.Percentile = int(config.JSONRPC.GPOPercentile)
+	cfg.GPO.IgnorePrice = big.NewInt(config.JSONRPC.GPOIgnorePrice)
+	cfg.GPO.MaxPrice = big.NewInt(config.JSONRPC.GPOMaxPrice)
 	cfg.AppCfg = config
 	return cfg
 }
@@ -138,6 +148,12 @@ func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	return dbm.NewDB("application", backendType, dataDir)
 }
 
+// OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
+func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.WriteCloser, err error) {
 	if traceWriterFile == "" {
 		return
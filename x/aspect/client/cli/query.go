@@ -0,0 +1,421 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+const (
+	flagVersion    = "version"
+	flagActiveOnly = "active-only"
+)
+
+// GetQueryCmd returns the parent command for all x/aspect CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the aspect module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetAspectMetaCmd(),
+		GetAspectVersionsCmd(),
+		GetAspectCodeCmd(),
+		GetAspectPropertiesCmd(),
+		GetBoundAccountsCmd(),
+		GetAccountBindingsCmd(),
+		GetAspectStateCmd(),
+		GetAspectStatesCmd(),
+		GetAspectStateDiffCmd(),
+		GetScheduleCmd(),
+		GetAspectSchedulesCmd(),
+	)
+	return cmd
+}
+
+// GetAspectMetaCmd queries the meta of an aspect
+func GetAspectMetaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "meta ASPECT_ID",
+		Short: "Get the meta of an aspect",
+		Long:  "Get the pay master, proof, latest version and store version of an aspect.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectMeta(cmd.Context(), &types.QueryAspectMetaRequest{
+				AspectId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectVersionsCmd queries all the versions of an aspect
+func GetAspectVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions ASPECT_ID",
+		Short: "Get all the versions of an aspect",
+		Long:  "Get the join points and code hashes of all the deployed versions of an aspect.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectVersions(cmd.Context(), &types.QueryAspectVersionsRequest{
+				AspectId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectCodeCmd queries the code of an aspect
+func GetAspectCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code ASPECT_ID",
+		Short: "Get the code of an aspect",
+		Long: `Get the code and code hash of an aspect.
+If the version is not provided, it will return the code of the latest version`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint64(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectCode(cmd.Context(), &types.QueryAspectCodeRequest{
+				AspectId: args[0],
+				Version:  version,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagVersion, 0, "version of the aspect, 0 for the latest version")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectPropertiesCmd queries the properties of an aspect
+func GetAspectPropertiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "properties ASPECT_ID",
+		Short: "Get the properties of an aspect",
+		Long: `Get the properties of an aspect.
+If the version is not provided, it will return the properties of the latest version`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint64(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectProperties(cmd.Context(), &types.QueryAspectPropertiesRequest{
+				AspectId: args[0],
+				Version:  version,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagVersion, 0, "version of the aspect, 0 for the latest version")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBoundAccountsCmd queries the accounts bound to an aspect
+func GetBoundAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bound-accounts ASPECT_ID",
+		Short: "Get the accounts bound to an aspect",
+		Long:  "Get all the accounts bound to an aspect, along with the binding versions and priorities.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BoundAccounts(cmd.Context(), &types.QueryBoundAccountsRequest{
+				AspectId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAccountBindingsCmd queries the aspects bound to an account
+func GetAccountBindingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bindings ACCOUNT",
+		Short: "Get the aspects bound to an account",
+		Long:  "Get all the aspects bound to an account, along with the binding versions, priorities and join points.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountBindings(cmd.Context(), &types.QueryAccountBindingsRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectStateCmd queries the value of a key in the state store of an aspect
+func GetAspectStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state ASPECT_ID KEY",
+		Short: "Get the value of a key in the aspect state",
+		Long:  "Get the raw value of a key saved by an aspect in its state store.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectState(cmd.Context(), &types.QueryAspectStateRequest{
+				AspectId: args[0],
+				Key:      []byte(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectStatesCmd dumps all the states of an aspect
+func GetAspectStatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "states ASPECT_ID",
+		Short: "Dump all the states of an aspect",
+		Long: `Dump all the key-value pairs saved by an aspect in its state store.
+Use the --height flag to dump the states at a historical height`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectStates(cmd.Context(), &types.QueryAspectStatesRequest{
+				AspectId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectStateDiffCmd diffs the states of an aspect between two heights
+func GetAspectStateDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff ASPECT_ID FROM_HEIGHT TO_HEIGHT",
+		Short: "Diff the states of an aspect between two heights",
+		Long:  "Get all the states of an aspect which are created, updated or deleted from one height to another.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height %s: %w", args[1], err)
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height %s: %w", args[2], err)
+			}
+
+			req := &types.QueryAspectStatesRequest{AspectId: args[0]}
+			from, err := types.NewQueryClient(clientCtx.WithHeight(fromHeight)).AspectStates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			to, err := types.NewQueryClient(clientCtx.WithHeight(toHeight)).AspectStates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&types.AspectStateDiff{
+				AspectId:   common.HexToAddress(args[0]).Hex(),
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Changes:    types.DiffAspectStates(from.States, to.States),
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetScheduleCmd queries a schedule by id
+func GetScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule ID",
+		Short: "Get a scheduled call by id",
+		Long:  "Get the target, calldata, payer, next execution height and the last execution result of a schedule.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Schedule(cmd.Context(), &types.QueryScheduleRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAspectSchedulesCmd queries the schedules registered for an aspect
+func GetAspectSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules ASPECT_ID",
+		Short: "Get the scheduled calls of an aspect",
+		Long: `Get all the scheduled calls registered for an aspect.
+Use the --active-only flag to filter out the finished and cancelled ones`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			activeOnly, err := cmd.Flags().GetBool(flagActiveOnly)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectSchedules(cmd.Context(), &types.QueryAspectSchedulesRequest{
+				AspectId:   args[0],
+				ActiveOnly: activeOnly,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagActiveOnly, false, "only return the active schedules")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,35 @@
+package aspect
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/aspect/keeper"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+// InitGenesis initializes genesis states based on exported genesis
+func InitGenesis(
+	ctx cosmos.Context,
+	k *keeper.Keeper,
+	genState types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.ImportStoreEntries(ctx, genState.StoreEntries); err != nil {
+		panic(errorsmod.Wrap(err, "could not import aspect store at genesis"))
+	}
+
+	if err := k.ImportLegacyStoreEntries(ctx, genState.LegacyStoreEntries); err != nil {
+		panic(errorsmod.Wrap(err, "could not import legacy aspect store at genesis"))
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis states of the aspect module
+func ExportGenesis(ctx cosmos.Context, k *keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		StoreEntries:       k.ExportStoreEntries(ctx),
+		LegacyStoreEntries: k.ExportLegacyStoreEntries(ctx),
+	}
+}
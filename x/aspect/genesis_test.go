@@ -0,0 +1,100 @@
+package aspect_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/aspect"
+	"github.com/artela-network/artela/x/aspect/keeper"
+	aspectstore "github.com/artela-network/artela/x/aspect/store"
+	v0 "github.com/artela-network/artela/x/aspect/store/v0"
+	_ "github.com/artela-network/artela/x/aspect/store/v1"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+// newTestKeeper creates an aspect keeper on top of fresh in-memory aspect and evm stores
+func newTestKeeper(t *testing.T) (cosmos.Context, *keeper.Keeper) {
+	aspectKey := storetypes.NewKVStoreKey(types.StoreKey)
+	evmKey := storetypes.NewKVStoreKey("evm")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(aspectKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := cosmos.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+	return ctx, keeper.NewKeeper(aspectKey, evmKey)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	account := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	genState := types.NewGenesisState(
+		[]types.StoreEntry{
+			// account protocol info, version 1
+			{Key: append(common.CopyBytes(aspectstore.AspectProtocolInfoKeyPrefix), account.Bytes()...), Value: []byte{0, 1}},
+			// aspect protocol info, version 1 with meta version 1 and state version 1
+			{Key: append(common.CopyBytes(aspectstore.AspectProtocolInfoKeyPrefix), aspectID.Bytes()...), Value: []byte{0, 1, 0, 1, 0, 1}},
+			{Key: append([]byte{aspectstore.AspectScope}, aspectID.Bytes()...), Value: []byte("meta")},
+		},
+		[]types.StoreEntry{
+			{Key: []byte(v0.V0AspectCodeKeyPrefix + "code"), Value: []byte("code")},
+			// v0 store saves empty values
+			{Key: []byte(v0.V0AspectCodeVersionKeyPrefix + "version"), Value: []byte{}},
+		},
+	)
+	require.NoError(t, genState.Validate())
+
+	ctx, k := newTestKeeper(t)
+	aspect.InitGenesis(ctx, k, *genState)
+	exported := aspect.ExportGenesis(ctx, k)
+
+	// marshal and unmarshal the exported genesis as it's written to the genesis file
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz, err := cdc.MarshalJSON(exported)
+	require.NoError(t, err)
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &imported))
+	require.NoError(t, imported.Validate())
+
+	newCtx, newKeeper := newTestKeeper(t)
+	aspect.InitGenesis(newCtx, newKeeper, imported)
+	reexported := aspect.ExportGenesis(newCtx, newKeeper)
+
+	require.Len(t, reexported.StoreEntries, len(genState.StoreEntries))
+	require.Len(t, reexported.LegacyStoreEntries, len(genState.LegacyStoreEntries))
+	require.Equal(t, exported.StoreEntries, reexported.StoreEntries)
+	for i, entry := range exported.LegacyStoreEntries {
+		require.Equal(t, entry.Key, reexported.LegacyStoreEntries[i].Key)
+		require.Equal(t, len(entry.Value), len(reexported.LegacyStoreEntries[i].Value))
+	}
+}
+
+func TestGenesisInvalidProtocolInfo(t *testing.T) {
+	account := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	ctx, k := newTestKeeper(t)
+	err := k.ImportStoreEntries(ctx, []types.StoreEntry{
+		// unknown protocol version
+		{Key: append(common.CopyBytes(aspectstore.AspectProtocolInfoKeyPrefix), account.Bytes()...), Value: []byte{0xff, 0xff}},
+	})
+	require.Error(t, err)
+
+	err = k.ImportLegacyStoreEntries(ctx, []types.StoreEntry{{Key: []byte("Other/key"), Value: []byte{1}}})
+	require.Error(t, err)
+
+	dup := types.StoreEntry{Key: []byte{1}, Value: []byte{1}}
+	require.Error(t, types.NewGenesisState([]types.StoreEntry{dup, dup}, nil).Validate())
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/aspect/store"
+	v0 "github.com/artela-network/artela/x/aspect/store/v0"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+// protocolInfoKeyLen is the length of the protocol info key, {2B prefix}{20B address}
+var protocolInfoKeyLen = len(store.AspectProtocolInfoKeyPrefix) + common.AddressLength
+
+// ExportStoreEntries returns all the raw entries in the aspect store
+func (k Keeper) ExportStoreEntries(ctx cosmos.Context) []types.StoreEntry {
+	return exportEntries(ctx.KVStore(k.storeKey), nil)
+}
+
+// ExportLegacyStoreEntries returns all the raw v0 aspect entries in the evm store
+func (k Keeper) ExportLegacyStoreEntries(ctx cosmos.Context) []types.StoreEntry {
+	return exportEntries(ctx.KVStore(k.evmStoreKey), []byte(v0.V0AspectStoreKeyPrefix))
+}
+
+// ImportStoreEntries writes the given raw entries to the aspect store,
+// protocol info headers are validated before any entry is written.
+func (k Keeper) ImportStoreEntries(ctx cosmos.Context, entries []types.StoreEntry) error {
+	for _, entry := range entries {
+		if !bytes.HasPrefix(entry.Key, store.AspectProtocolInfoKeyPrefix) {
+			continue
+		}
+
+		if len(entry.Key) != protocolInfoKeyLen {
+			return fmt.Errorf("invalid protocol info key %x", entry.Key)
+		}
+		if err := store.ValidateProtocolInfo(entry.Value); err != nil {
+			return fmt.Errorf("invalid protocol info of %s: %w",
+				common.BytesToAddress(entry.Key[len(store.AspectProtocolInfoKeyPrefix):]).Hex(), err)
+		}
+	}
+
+	importEntries(ctx.KVStore(k.storeKey), entries)
+	return nil
+}
+
+// ImportLegacyStoreEntries writes the given raw v0 aspect entries to the evm store
+func (k Keeper) ImportLegacyStoreEntries(ctx cosmos.Context, entries []types.StoreEntry) error {
+	for _, entry := range entries {
+		if !bytes.HasPrefix(entry.Key, []byte(v0.V0AspectStoreKeyPrefix)) {
+			return fmt.Errorf("invalid legacy aspect store key %x", entry.Key)
+		}
+	}
+
+	importEntries(ctx.KVStore(k.evmStoreKey), entries)
+	return nil
+}
+
+func exportEntries(kvStore storetypes.KVStore, prefix []byte) []types.StoreEntry {
+	iterator := storetypes.KVStorePrefixIterator(kvStore, prefix)
+	defer iterator.Close()
+
+	var entries []types.StoreEntry
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, types.StoreEntry{
+			Key:   common.CopyBytes(iterator.Key()),
+			Value: common.CopyBytes(iterator.Value()),
+		})
+	}
+
+	return entries
+}
+
+func importEntries(kvStore storetypes.KVStore, entries []types.StoreEntry) {
+	for _, entry := range entries {
+		// v0 store may save empty values, e.g. join point 0,
+		// which are decoded as nil from genesis json
+		value := entry.Value
+		if value == nil {
+			value = []byte{}
+		}
+		kvStore.Set(entry.Key, value)
+	}
+}
@@ -0,0 +1,369 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/aspect/store"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// AspectMeta implements the Query/AspectMeta gRPC method
+func (k Keeper) AspectMeta(c context.Context, req *types.QueryAspectMetaRequest) (*types.QueryAspectMetaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	metaStore, latestVersion, err := k.loadDeployedAspect(ctx, req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := metaStore.GetMeta()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAspectMetaResponse{
+		AspectId:      common.HexToAddress(req.AspectId).Hex(),
+		PayMaster:     meta.PayMaster.Hex(),
+		Proof:         meta.Proof,
+		LatestVersion: latestVersion,
+		StoreVersion:  uint32(metaStore.Version()),
+	}, nil
+}
+
+// AspectVersions implements the Query/AspectVersions gRPC method
+func (k Keeper) AspectVersions(c context.Context, req *types.QueryAspectVersionsRequest) (*types.QueryAspectVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	metaStore, latestVersion, err := k.loadDeployedAspect(ctx, req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]types.AspectVersionMeta, 0, latestVersion)
+	for version := uint64(1); version <= latestVersion; version++ {
+		meta, err := metaStore.GetVersionMeta(version)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		codeHash, err := versionCodeHash(metaStore, version, meta.CodeHash)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		versions = append(versions, types.AspectVersionMeta{
+			Version:   version,
+			JoinPoint: meta.JoinPoint,
+			CodeHash:  codeHash.Hex(),
+		})
+	}
+
+	return &types.QueryAspectVersionsResponse{
+		Versions: versions,
+	}, nil
+}
+
+// AspectCode implements the Query/AspectCode gRPC method
+func (k Keeper) AspectCode(c context.Context, req *types.QueryAspectCodeRequest) (*types.QueryAspectCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	metaStore, latestVersion, err := k.loadDeployedAspect(ctx, req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := resolveVersion(req.Version, latestVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := metaStore.GetCode(version)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAspectCodeResponse{
+		Version:  version,
+		Code:     code,
+		CodeHash: crypto.Keccak256Hash(code).Hex(),
+	}, nil
+}
+
+// AspectProperties implements the Query/AspectProperties gRPC method
+func (k Keeper) AspectProperties(c context.Context, req *types.QueryAspectPropertiesRequest) (*types.QueryAspectPropertiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	metaStore, latestVersion, err := k.loadDeployedAspect(ctx, req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := resolveVersion(req.Version, latestVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := metaStore.GetProperties(version)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	properties := make([]types.AspectProperty, 0, len(props))
+	for _, prop := range props {
+		properties = append(properties, types.AspectProperty{
+			Key:   prop.Key,
+			Value: prop.Value,
+		})
+	}
+
+	return &types.QueryAspectPropertiesResponse{
+		Version:    version,
+		Properties: properties,
+	}, nil
+}
+
+// BoundAccounts implements the Query/BoundAccounts gRPC method
+func (k Keeper) BoundAccounts(c context.Context, req *types.QueryBoundAccountsRequest) (*types.QueryBoundAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	metaStore, _, err := k.loadDeployedAspect(ctx, req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	bound, err := metaStore.LoadAspectBoundAccounts()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	aspectID := common.HexToAddress(req.AspectId)
+	bindings := make([]types.AspectBinding, 0, len(bound))
+	for _, binding := range bound {
+		bindings = append(bindings, newAspectBinding(aspectID, binding.Account, binding))
+	}
+
+	return &types.QueryBoundAccountsResponse{
+		Bindings: bindings,
+	}, nil
+}
+
+// AccountBindings implements the Query/AccountBindings gRPC method
+func (k Keeper) AccountBindings(c context.Context, req *types.QueryAccountBindingsRequest) (*types.QueryAccountBindingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	account := common.HexToAddress(req.Account)
+	accountStore, err := k.GetAccountStore(ctx, account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// load all the bindings regardless of the join points
+	bound, err := accountStore.LoadAccountBoundAspects(types.BindingFilter{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bindings := make([]types.AspectBinding, 0, len(bound))
+	for _, binding := range bound {
+		bindings = append(bindings, newAspectBinding(binding.Account, account, binding))
+	}
+
+	return &types.QueryAccountBindingsResponse{
+		Bindings: bindings,
+	}, nil
+}
+
+// AspectState implements the Query/AspectState gRPC method
+func (k Keeper) AspectState(c context.Context, req *types.QueryAspectStateRequest) (*types.QueryAspectStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	if _, _, err := k.loadDeployedAspect(ctx, req.AspectId); err != nil {
+		return nil, err
+	}
+
+	stateStore, err := k.GetAspectStateStore(ctx, common.HexToAddress(req.AspectId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAspectStateResponse{
+		Value: stateStore.GetState(req.Key),
+	}, nil
+}
+
+// AspectStates implements the Query/AspectStates gRPC method
+func (k Keeper) AspectStates(c context.Context, req *types.QueryAspectStatesRequest) (*types.QueryAspectStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	if _, _, err := k.loadDeployedAspect(ctx, req.AspectId); err != nil {
+		return nil, err
+	}
+
+	stateStore, err := k.GetAspectStateStore(ctx, common.HexToAddress(req.AspectId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	states := make([]types.AspectStateEntry, 0)
+	stateStore.ForEachState(func(key, value []byte) bool {
+		states = append(states, types.AspectStateEntry{
+			Key:   key,
+			Value: value,
+		})
+		return true
+	})
+
+	return &types.QueryAspectStatesResponse{
+		States: states,
+	}, nil
+}
+
+// Schedule implements the Query/Schedule gRPC method
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	schedule, err := k.GetSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if schedule == nil {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrScheduleNotFound, "id %d", req.Id).Error())
+	}
+
+	return &types.QueryScheduleResponse{
+		Schedule: *schedule,
+	}, nil
+}
+
+// AspectSchedules implements the Query/AspectSchedules gRPC method
+func (k Keeper) AspectSchedules(c context.Context, req *types.QueryAspectSchedulesRequest) (*types.QueryAspectSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := artela.ValidateNonZeroAddress(req.AspectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	schedules, err := k.GetAspectSchedules(ctx, common.HexToAddress(req.AspectId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]types.Schedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		if req.ActiveOnly && schedule.Status != types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+			continue
+		}
+		result = append(result, schedule)
+	}
+
+	return &types.QueryAspectSchedulesResponse{
+		Schedules: result,
+	}, nil
+}
+
+// loadDeployedAspect loads the meta store of the given aspect and checks whether it has been deployed
+func (k Keeper) loadDeployedAspect(ctx cosmos.Context, aspectID string) (store.AspectMetaStore, uint64, error) {
+	if err := artela.ValidateNonZeroAddress(aspectID); err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metaStore, err := k.GetAspectMetaStore(ctx, common.HexToAddress(aspectID))
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	latestVersion, err := metaStore.GetLatestVersion()
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	if latestVersion == 0 {
+		return nil, 0, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrAspectNotDeployed, aspectID).Error())
+	}
+
+	return metaStore, latestVersion, nil
+}
+
+// resolveVersion returns the latest version if the requested one is 0, or validates the requested version
+func resolveVersion(requested, latest uint64) (uint64, error) {
+	if requested == 0 {
+		return latest, nil
+	}
+
+	if requested > latest {
+		return 0, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrAspectVersionNotFound, "version %d", requested).Error())
+	}
+
+	return requested, nil
+}
+
+// versionCodeHash returns the stored code hash, v0 store does not save code hash, so we calculate it from the code
+func versionCodeHash(metaStore store.AspectMetaStore, version uint64, stored common.Hash) (common.Hash, error) {
+	if stored != (common.Hash{}) {
+		return stored, nil
+	}
+
+	code, err := metaStore.GetCode(version)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(code), nil
+}
+
+func newAspectBinding(aspectID, account common.Address, binding types.Binding) types.AspectBinding {
+	return types.AspectBinding{
+		AspectId:  aspectID.Hex(),
+		Account:   account.Hex(),
+		Version:   binding.Version,
+		Priority:  int32(binding.Priority),
+		JoinPoint: uint32(binding.JoinPoint),
+	}
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosstore "github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/artela-network/artela/x/aspect/keeper"
+	"github.com/artela-network/artela/x/aspect/store"
+	_ "github.com/artela-network/artela/x/aspect/store/v0"
+	_ "github.com/artela-network/artela/x/aspect/store/v1"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+var (
+	aspectID  = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	account   = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	payMaster = common.HexToAddress("0x0000000000000000000000000000000000000003")
+)
+
+// setupTestAspect deploys an aspect bound to an account the same way as the aspect system contract, and
+// returns the keeper reading the stores.
+func setupTestAspect(t *testing.T) (cosmos.Context, *keeper.Keeper) {
+	aspectKey := storetypes.NewKVStoreKey(types.StoreKey)
+	evmKey := storetypes.NewKVStoreKey("evm")
+
+	db := dbm.NewMemDB()
+	cms := cosmosstore.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(aspectKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := cosmos.NewContext(cms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+
+	storeCtx := types.NewStoreContext(ctx, aspectKey, evmKey, 100_000_000)
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx, AspectID: aspectID})
+	require.NoError(t, err)
+	require.NoError(t, metaStore.Init())
+	version, err := metaStore.BumpVersion()
+	require.NoError(t, err)
+	require.NoError(t, metaStore.StoreCode(version, []byte("code")))
+	require.NoError(t, metaStore.StoreVersionMeta(version, &types.VersionMeta{
+		JoinPoint: 2,
+		CodeHash:  crypto.Keccak256Hash([]byte("code")),
+	}))
+	require.NoError(t, metaStore.StoreMeta(&types.AspectMeta{PayMaster: payMaster, Proof: []byte("proof")}))
+	require.NoError(t, metaStore.StoreProperties(version, []types.Property{{Key: "key", Value: []byte("value")}}))
+	require.NoError(t, metaStore.StoreBinding(account, version, 2, 1))
+
+	accountStore, _, err := store.GetAccountStore(&types.AccountStoreContext{StoreContext: storeCtx, Account: account})
+	require.NoError(t, err)
+	require.NoError(t, accountStore.Init())
+	require.NoError(t, accountStore.StoreBinding(aspectID, version, 2, 1, true))
+
+	stateStore, err := store.GetAspectStateStore(&types.AspectStoreContext{StoreContext: storeCtx, AspectID: aspectID})
+	require.NoError(t, err)
+	stateStore.SetState([]byte("state"), []byte("value"))
+
+	require.NoError(t, store.RegisterSchedule(storeCtx, &types.Schedule{
+		AspectId:   aspectID.Hex(),
+		Creator:    aspectID.Hex(),
+		Payer:      aspectID.Hex(),
+		Target:     account.Hex(),
+		GasLimit:   100000,
+		NextHeight: 20,
+		Count:      1,
+		Status:     types.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	}))
+
+	return ctx, keeper.NewKeeper(aspectKey, evmKey)
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err))
+}
+
+func TestQueryAspect(t *testing.T) {
+	ctx, k := setupTestAspect(t)
+	c := cosmos.WrapSDKContext(ctx)
+
+	meta, err := k.AspectMeta(c, &types.QueryAspectMetaRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, payMaster.Hex(), meta.PayMaster)
+	require.Equal(t, []byte("proof"), meta.Proof)
+	require.Equal(t, uint64(1), meta.LatestVersion)
+
+	versions, err := k.AspectVersions(c, &types.QueryAspectVersionsRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectVersionMeta{{
+		Version:   1,
+		JoinPoint: 2,
+		CodeHash:  crypto.Keccak256Hash([]byte("code")).Hex(),
+	}}, versions.Versions)
+
+	// version 0 is the latest version
+	code, err := k.AspectCode(c, &types.QueryAspectCodeRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), code.Version)
+	require.Equal(t, []byte("code"), code.Code)
+	_, err = k.AspectCode(c, &types.QueryAspectCodeRequest{AspectId: aspectID.Hex(), Version: 2})
+	requireCode(t, codes.NotFound, err)
+
+	props, err := k.AspectProperties(c, &types.QueryAspectPropertiesRequest{AspectId: aspectID.Hex(), Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectProperty{{Key: "key", Value: []byte("value")}}, props.Properties)
+
+	// not deployed or invalid aspects
+	_, err = k.AspectMeta(c, &types.QueryAspectMetaRequest{AspectId: account.Hex()})
+	requireCode(t, codes.NotFound, err)
+	_, err = k.AspectMeta(c, &types.QueryAspectMetaRequest{AspectId: "0x1"})
+	requireCode(t, codes.InvalidArgument, err)
+	_, err = k.AspectMeta(c, nil)
+	requireCode(t, codes.InvalidArgument, err)
+}
+
+func TestQueryBindings(t *testing.T) {
+	ctx, k := setupTestAspect(t)
+	c := cosmos.WrapSDKContext(ctx)
+
+	binding := types.AspectBinding{
+		AspectId:  aspectID.Hex(),
+		Account:   account.Hex(),
+		Version:   1,
+		Priority:  1,
+		JoinPoint: 2,
+	}
+
+	bound, err := k.BoundAccounts(c, &types.QueryBoundAccountsRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectBinding{binding}, bound.Bindings)
+
+	bindings, err := k.AccountBindings(c, &types.QueryAccountBindingsRequest{Account: account.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectBinding{binding}, bindings.Bindings)
+
+	// accounts without bindings
+	bindings, err = k.AccountBindings(c, &types.QueryAccountBindingsRequest{Account: payMaster.Hex()})
+	require.NoError(t, err)
+	require.Empty(t, bindings.Bindings)
+}
+
+func TestQueryStatesAndSchedules(t *testing.T) {
+	ctx, k := setupTestAspect(t)
+	c := cosmos.WrapSDKContext(ctx)
+
+	state, err := k.AspectState(c, &types.QueryAspectStateRequest{AspectId: aspectID.Hex(), Key: []byte("state")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), state.Value)
+	_, err = k.AspectState(c, &types.QueryAspectStateRequest{AspectId: aspectID.Hex()})
+	requireCode(t, codes.InvalidArgument, err)
+
+	states, err := k.AspectStates(c, &types.QueryAspectStatesRequest{AspectId: aspectID.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectStateEntry{{Key: []byte("state"), Value: []byte("value")}}, states.States)
+
+	schedule, err := k.Schedule(c, &types.QueryScheduleRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, aspectID.Hex(), schedule.Schedule.AspectId)
+	_, err = k.Schedule(c, &types.QueryScheduleRequest{Id: 2})
+	requireCode(t, codes.NotFound, err)
+
+	schedules, err := k.AspectSchedules(c, &types.QueryAspectSchedulesRequest{AspectId: aspectID.Hex(), ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, schedules.Schedules, 1)
+	require.Equal(t, uint64(1), schedules.Schedules[0].Id)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/aspect/store"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+// Keeper grants read access to the aspect module states.
+// All the aspect write operations are still done through the aspect system contract in x/evm.
+type Keeper struct {
+	// store key of the aspect module, used by v1 and later versions of aspect store
+	storeKey storetypes.StoreKey
+	// store key of the evm module, aspect v0 store is saved in evm store
+	evmStoreKey storetypes.StoreKey
+}
+
+// NewKeeper generates new aspect module keeper
+func NewKeeper(storeKey, evmStoreKey storetypes.StoreKey) *Keeper {
+	return &Keeper{
+		storeKey:    storeKey,
+		evmStoreKey: evmStoreKey,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx cosmos.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetAspectMetaStore returns the current aspect meta store of the given aspect, no gas will be charged.
+func (k Keeper) GetAspectMetaStore(ctx cosmos.Context, aspectID common.Address) (store.AspectMetaStore, error) {
+	metaStore, _, err := store.GetAspectMetaStore(k.buildAspectStoreCtx(ctx, aspectID))
+	return metaStore, err
+}
+
+// GetAccountStore returns the current account store of the given account, no gas will be charged.
+func (k Keeper) GetAccountStore(ctx cosmos.Context, account common.Address) (store.AccountStore, error) {
+	accountStore, _, err := store.GetAccountStore(k.buildAccountStoreCtx(ctx, account))
+	return accountStore, err
+}
+
+// GetAspectStateStore returns the state store of the given aspect.
+func (k Keeper) GetAspectStateStore(ctx cosmos.Context, aspectID common.Address) (store.AspectStateStore, error) {
+	return store.GetAspectStateStore(k.buildAspectStoreCtx(ctx, aspectID))
+}
+
+// GetSchedule returns the schedule with the given id, nil if not found, no gas will be charged.
+func (k Keeper) GetSchedule(ctx cosmos.Context, id uint64) (*types.Schedule, error) {
+	return store.LoadSchedule(types.NewGasFreeStoreContext(ctx, k.storeKey, k.evmStoreKey), id)
+}
+
+// GetAspectSchedules returns all the schedules registered for the given aspect, no gas will be charged.
+func (k Keeper) GetAspectSchedules(ctx cosmos.Context, aspectID common.Address) ([]types.Schedule, error) {
+	return store.LoadAspectSchedules(types.NewGasFreeStoreContext(ctx, k.storeKey, k.evmStoreKey), aspectID)
+}
+
+func (k Keeper) buildAspectStoreCtx(ctx cosmos.Context, aspectID common.Address) *types.AspectStoreContext {
+	return &types.AspectStoreContext{
+		StoreContext: types.NewGasFreeStoreContext(ctx, k.storeKey, k.evmStoreKey),
+		AspectID:     aspectID,
+	}
+}
+
+func (k Keeper) buildAccountStoreCtx(ctx cosmos.Context, account common.Address) *types.AccountStoreContext {
+	return &types.AccountStoreContext{
+		StoreContext: types.NewGasFreeStoreContext(ctx, k.storeKey, k.evmStoreKey),
+		Account:      account,
+	}
+}
@@ -113,6 +113,10 @@ func (j *ArtelaProvider) getCodes(ctx context.Context, address common.Address, p
 		}
 	})
 
+	if logger := aspectCtx.HostLogger(); logger != nil {
+		logger.CaptureAspectBindings(point, codes)
+	}
+
 	return codes, nil
 }
 
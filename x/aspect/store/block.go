@@ -0,0 +1,68 @@
+package store
+
+import (
+	"encoding/binary"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+)
+
+// blockAspectStoreCost is the gas cost of every 32 bytes of the block aspect registry entry,
+// which is the same as the storage store cost of the aspect stores
+const blockAspectStoreCost = 1000
+
+// BlockAspect is an aspect registered for the block level join points
+type BlockAspect struct {
+	AspectID  common.Address
+	JoinPoint uint64
+}
+
+// StoreBlockAspect registers the aspect to the block level aspect registry if it declares any
+// block level join point, otherwise the aspect is removed from the registry.
+// The registry is version independent, so it is shared by all the aspect store versions.
+// Storing the entry is charged with the store context gas, removing it is free.
+func StoreBlockAspect(ctx aspectmoduletypes.StoreContext, aspectID common.Address, joinPoint uint64) error {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+
+	// key format {2B prefix}{20B aspectID}
+	key := NewKeyBuilder(BlockAspectKeyPrefix).AppendBytes(aspectID.Bytes()).Build()
+	if !aspectmoduletypes.CheckIsBlockLevel(int64(joinPoint)) {
+		kvStore.Delete(key)
+		return nil
+	}
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, joinPoint)
+	if ctx.ChargeGas() {
+		if err := ctx.ConsumeGas(((uint64(len(key)+len(value)) + 32) >> 5) * blockAspectStoreCost); err != nil {
+			return err
+		}
+	}
+	kvStore.Set(key, value)
+	return nil
+}
+
+// LoadBlockAspects returns all the registered block level aspects, sorted by aspect id
+func LoadBlockAspects(ctx aspectmoduletypes.StoreContext) []BlockAspect {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+	iterator := storetypes.KVStorePrefixIterator(kvStore, BlockAspectKeyPrefix)
+	defer iterator.Close()
+
+	var aspects []BlockAspect
+	for ; iterator.Valid(); iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if len(key) != len(BlockAspectKeyPrefix)+common.AddressLength || len(value) != 8 {
+			ctx.Logger().Error("invalid block aspect entry", "key", common.Bytes2Hex(key))
+			continue
+		}
+
+		aspects = append(aspects, BlockAspect{
+			AspectID:  common.BytesToAddress(key[len(BlockAspectKeyPrefix):]),
+			JoinPoint: binary.BigEndian.Uint64(value),
+		})
+	}
+
+	return aspects
+}
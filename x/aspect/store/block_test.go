@@ -0,0 +1,72 @@
+package store_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosstore "github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela/x/aspect/store"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+func newTestContext(t *testing.T) (cosmos.Context, storetypes.StoreKey, storetypes.StoreKey) {
+	aspectKey := storetypes.NewKVStoreKey(types.StoreKey)
+	evmKey := storetypes.NewKVStoreKey("evm")
+
+	db := dbm.NewMemDB()
+	cms := cosmosstore.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(aspectKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return cosmos.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()), aspectKey, evmKey
+}
+
+func TestStoreBlockAspect(t *testing.T) {
+	ctx, aspectKey, evmKey := newTestContext(t)
+	first := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	second := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	txLevel := common.HexToAddress("0x0000000000000000000000000000000000000003")
+	initialize := uint64(types.JoinPointRunType_OnBlockInitialize)
+	finalize := uint64(types.JoinPointRunType_OnBlockFinalize)
+
+	// storing the entry is charged
+	storeCtx := types.NewStoreContext(ctx, aspectKey, evmKey, 100000)
+	require.NoError(t, store.StoreBlockAspect(storeCtx, first, initialize|finalize))
+	require.Less(t, storeCtx.Gas(), uint64(100000))
+	require.NoError(t, store.StoreBlockAspect(storeCtx, second, initialize))
+	// aspect without block level join point is not registered
+	require.NoError(t, store.StoreBlockAspect(storeCtx, txLevel, 2))
+
+	aspects := store.LoadBlockAspects(types.NewGasFreeStoreContext(ctx, aspectKey, evmKey))
+	require.Equal(t, []store.BlockAspect{
+		{AspectID: second, JoinPoint: initialize},
+		{AspectID: first, JoinPoint: initialize | finalize},
+	}, aspects)
+
+	// out of gas, the entry is not stored
+	outOfGasCtx := types.NewStoreContext(ctx, aspectKey, evmKey, 10)
+	require.ErrorIs(t, store.StoreBlockAspect(outOfGasCtx, txLevel, finalize), vm.ErrOutOfGas)
+	require.Len(t, store.LoadBlockAspects(types.NewGasFreeStoreContext(ctx, aspectKey, evmKey)), 2)
+
+	// gas free context is not charged
+	require.NoError(t, store.StoreBlockAspect(types.NewGasFreeStoreContext(ctx, aspectKey, evmKey), txLevel, finalize))
+	require.Len(t, store.LoadBlockAspects(types.NewGasFreeStoreContext(ctx, aspectKey, evmKey)), 3)
+
+	// dropping the block level join points removes the entry for free
+	noGasCtx := types.NewStoreContext(ctx, aspectKey, evmKey, 0)
+	require.NoError(t, store.StoreBlockAspect(noGasCtx, first, 2))
+	aspects = store.LoadBlockAspects(types.NewGasFreeStoreContext(ctx, aspectKey, evmKey))
+	require.Equal(t, []store.BlockAspect{
+		{AspectID: second, JoinPoint: initialize},
+		{AspectID: txLevel, JoinPoint: finalize},
+	}, aspects)
+}
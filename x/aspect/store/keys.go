@@ -15,6 +15,11 @@ var (
 // global keys, shouldn't be changed in the future
 var (
 	AspectProtocolInfoKeyPrefix = []byte{GlobalScope, 0x01}
+	BlockAspectKeyPrefix        = []byte{GlobalScope, 0x02}
+	ScheduleKeyPrefix           = []byte{GlobalScope, 0x03}
+	ScheduleQueueKeyPrefix      = []byte{GlobalScope, 0x04}
+	AspectScheduleKeyPrefix     = []byte{GlobalScope, 0x05}
+	ScheduleSequenceKey         = []byte{GlobalScope, 0x06}
 )
 
 type KeyBuilder struct {
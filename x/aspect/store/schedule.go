@@ -0,0 +1,172 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+)
+
+// ScheduleEntry is an entry of the schedule execution queue
+type ScheduleEntry struct {
+	Height int64
+	ID     uint64
+}
+
+// NextScheduleID allocates a new schedule id, ids start from 1
+func NextScheduleID(ctx aspectmoduletypes.StoreContext) uint64 {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+
+	var id uint64
+	if raw := kvStore.Get(ScheduleSequenceKey); len(raw) == 8 {
+		id = binary.BigEndian.Uint64(raw)
+	}
+	id++
+
+	value := make([]byte, 8)
+	binary.BigEndian.PutUint64(value, id)
+	kvStore.Set(ScheduleSequenceKey, value)
+	return id
+}
+
+// StoreSchedule saves the schedule, and indexes it by the aspect id
+func StoreSchedule(ctx aspectmoduletypes.StoreContext, schedule *aspectmoduletypes.Schedule) error {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+
+	value, err := schedule.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// key format {2B prefix}{8B id}
+	kvStore.Set(NewKeyBuilder(ScheduleKeyPrefix).AppendUint64(schedule.Id).Build(), value)
+	// key format {2B prefix}{20B aspectID}{8B id}
+	kvStore.Set(NewKeyBuilder(AspectScheduleKeyPrefix).
+		AppendBytes(common.HexToAddress(schedule.AspectId).Bytes()).
+		AppendUint64(schedule.Id).
+		Build(), []byte{0x01})
+	return nil
+}
+
+// RegisterSchedule allocates the id of the new schedule, saves it and adds it to the execution
+// queue at its next height.
+func RegisterSchedule(ctx aspectmoduletypes.StoreContext, schedule *aspectmoduletypes.Schedule) error {
+	schedule.Id = NextScheduleID(ctx)
+	if err := StoreSchedule(ctx, schedule); err != nil {
+		return err
+	}
+	EnqueueSchedule(ctx, schedule.NextHeight, schedule.Id)
+	return nil
+}
+
+// CancelSchedule cancels the active schedule created by the given creator, and removes it from the
+// execution queue. The cancelled schedule is returned.
+func CancelSchedule(ctx aspectmoduletypes.StoreContext, creator common.Address, id uint64) (*aspectmoduletypes.Schedule, error) {
+	schedule, err := LoadSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if schedule == nil {
+		return nil, errors.New("schedule not found")
+	}
+
+	// only the creator can cancel the schedule
+	if common.HexToAddress(schedule.Creator) != creator {
+		return nil, errors.New("unauthorized operation")
+	}
+	if schedule.Status != aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+		return nil, errors.New("schedule is not active")
+	}
+
+	DequeueSchedule(ctx, schedule.NextHeight, schedule.Id)
+	schedule.Status = aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_CANCELLED
+	if err := StoreSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// LoadSchedule returns the schedule with the given id, nil if not found
+func LoadSchedule(ctx aspectmoduletypes.StoreContext, id uint64) (*aspectmoduletypes.Schedule, error) {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+
+	raw := kvStore.Get(NewKeyBuilder(ScheduleKeyPrefix).AppendUint64(id).Build())
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	schedule := &aspectmoduletypes.Schedule{}
+	if err := schedule.Unmarshal(raw); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// LoadAspectSchedules returns all the schedules registered for the given aspect, sorted by id
+func LoadAspectSchedules(ctx aspectmoduletypes.StoreContext, aspectID common.Address) ([]aspectmoduletypes.Schedule, error) {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+	prefix := NewKeyBuilder(AspectScheduleKeyPrefix).AppendBytes(aspectID.Bytes()).Build()
+	iterator := storetypes.KVStorePrefixIterator(kvStore, prefix)
+	defer iterator.Close()
+
+	var schedules []aspectmoduletypes.Schedule
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if len(key) != len(prefix)+8 {
+			continue
+		}
+
+		schedule, err := LoadSchedule(ctx, binary.BigEndian.Uint64(key[len(prefix):]))
+		if err != nil {
+			return nil, err
+		}
+		if schedule != nil {
+			schedules = append(schedules, *schedule)
+		}
+	}
+
+	return schedules, nil
+}
+
+// EnqueueSchedule adds the schedule to the execution queue at the given height
+func EnqueueSchedule(ctx aspectmoduletypes.StoreContext, height int64, id uint64) {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+	kvStore.Set(scheduleQueueKey(height, id), []byte{0x01})
+}
+
+// DequeueSchedule removes the schedule from the execution queue at the given height
+func DequeueSchedule(ctx aspectmoduletypes.StoreContext, height int64, id uint64) {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+	kvStore.Delete(scheduleQueueKey(height, id))
+}
+
+// LoadDueSchedules returns at most limit entries in the execution queue scheduled at
+// or before the given height, sorted by height and then id
+func LoadDueSchedules(ctx aspectmoduletypes.StoreContext, height int64, limit int) []ScheduleEntry {
+	kvStore := ctx.CosmosContext().KVStore(ctx.AspectStoreKey())
+	iterator := kvStore.Iterator(ScheduleQueueKeyPrefix, scheduleQueueKey(height+1, 0))
+	defer iterator.Close()
+
+	var entries []ScheduleEntry
+	for ; iterator.Valid() && len(entries) < limit; iterator.Next() {
+		key := iterator.Key()
+		if len(key) != len(ScheduleQueueKeyPrefix)+16 {
+			continue
+		}
+
+		key = key[len(ScheduleQueueKeyPrefix):]
+		entries = append(entries, ScheduleEntry{
+			Height: int64(binary.BigEndian.Uint64(key[:8])),
+			ID:     binary.BigEndian.Uint64(key[8:]),
+		})
+	}
+
+	return entries
+}
+
+// scheduleQueueKey builds the key of the execution queue, key format {2B prefix}{8B height}{8B id}
+func scheduleQueueKey(height int64, id uint64) []byte {
+	return NewKeyBuilder(ScheduleQueueKeyPrefix).AppendUint64(uint64(height)).AppendUint64(id).Build()
+}
@@ -138,6 +138,8 @@ type AspectStateStore interface {
 	GetState(key []byte) []byte
 	// SetState sets the value for the given key
 	SetState(key []byte, value []byte)
+	// ForEachState iterates all the states in key order, callback return false to break early
+	ForEachState(cb func(key, value []byte) bool)
 	// Version returns the version of the store
 	Version() ProtocolVersion
 }
@@ -154,6 +156,8 @@ type AspectMetaStore interface {
 	GetLatestVersion() (uint64, error)
 	// GetProperty returns the properties for the given version
 	GetProperty(version uint64, key string) ([]byte, error)
+	// GetProperties returns all the properties for the given version, sorted by key
+	GetProperties(version uint64) ([]aspectmoduletypes.Property, error)
 	// LoadAspectBoundAccounts returns the accounts bound to the aspect
 	LoadAspectBoundAccounts() ([]aspectmoduletypes.Binding, error)
 
@@ -211,6 +215,41 @@ func loadProtocolInfo(ctx aspectmoduletypes.StoreContext) (ProtocolVersion, []by
 	return protocolVersion, protoInfo[protocolVersion.Offset():], nil
 }
 
+// ValidateProtocolInfo checks whether the raw protocol info of an account or an aspect
+// is well-formed and the versions it refers to are supported
+func ValidateProtocolInfo(raw []byte) error {
+	if len(raw) < ProtocolVersionLen {
+		return ErrInvalidProtocolInfo
+	}
+
+	var protocolVersion ProtocolVersion
+	if err := protocolVersion.UnmarshalText(raw); err != nil {
+		return err
+	}
+	if _, ok := accountStoreRegistry[protocolVersion]; !ok {
+		return ErrUnknownProtocolVersion
+	}
+
+	// account protocol info only has the protocol version
+	rawAspectInfo := raw[protocolVersion.Offset():]
+	if len(rawAspectInfo) == 0 {
+		return nil
+	}
+
+	aspectInfo, err := parseAspectInfo(rawAspectInfo)
+	if err != nil {
+		return err
+	}
+	if _, ok := aspectMetaStoreRegistry[aspectInfo.MetaVersion]; !ok {
+		return ErrUnknownProtocolVersion
+	}
+	if _, ok := aspectStateStoreRegistry[aspectInfo.StateVersion]; !ok {
+		return ErrUnknownProtocolVersion
+	}
+
+	return nil
+}
+
 // parseAspectInfo parses
 func parseAspectInfo(raw []byte) (*AspectInfo, error) {
 	aspectInfo := &AspectInfo{}
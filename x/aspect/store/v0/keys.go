@@ -2,6 +2,9 @@ package v0
 
 // v0 keys
 const (
+	// V0AspectStoreKeyPrefix is the common prefix of all v0 aspect keys in evm store
+	V0AspectStoreKeyPrefix = "AspectStore/"
+
 	// AspectCodeKeyPrefix is the prefix to retrieve all AspectCodeStore
 	V0AspectCodeKeyPrefix        = "AspectStore/Code/"
 	V0AspectCodeVersionKeyPrefix = "AspectStore/Version/"
@@ -72,9 +72,11 @@ func (s *metaStore) GetMeta() (*types.AspectMeta, error) {
 		return nil, err
 	}
 
+	// v0 Store only records the account deployed the aspect, which is the owner
 	return &types.AspectMeta{
 		PayMaster: common.BytesToAddress(paymaster),
 		Proof:     proof,
+		Owner:     common.BytesToAddress(paymaster),
 	}, nil
 }
 
@@ -191,6 +193,44 @@ func (s *metaStore) GetProperty(_ uint64, key string) ([]byte, error) {
 	return s.Load(codeStore, aspectPropertyKey)
 }
 
+// GetProperties returns all the properties of the aspect with the given ID,
+// v0 Store does not keep properties by version, so version is ignored.
+func (s *metaStore) GetProperties(_ uint64) ([]types.Property, error) {
+	aspectID := s.ctx.AspectID
+	prefixStore := s.NewPrefixStore(V0AspectPropertyKeyPrefix)
+	allKeysKey := AspectPropertyKey(aspectID.Bytes(), []byte(V0AspectPropertyAllKeyPrefix))
+
+	propertyAllKey, err := s.Load(prefixStore, allKeysKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(propertyAllKey) == 0 {
+		return nil, nil
+	}
+
+	// keys are saved in a tree set, so they are already sorted
+	keys := strings.Split(string(propertyAllKey), V0AspectPropertyAllKeySplit)
+	properties := make([]types.Property, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := reservedPropertyKeys[key]; ok {
+			continue
+		}
+
+		value, err := s.Load(prefixStore, AspectPropertyKey(aspectID.Bytes(), []byte(key)))
+		if err != nil {
+			return nil, err
+		}
+
+		properties = append(properties, types.Property{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return properties, nil
+}
+
 // BumpVersion bumps the version of the aspect with the given ID.
 func (s *metaStore) BumpVersion() (v uint64, err error) {
 	aspectID := s.ctx.AspectID
@@ -1,6 +1,12 @@
 package v0
 
 import (
+	"bytes"
+	"sort"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/artela-network/artela/x/aspect/store"
 	"github.com/artela-network/artela/x/aspect/types"
 )
@@ -44,3 +50,42 @@ func (s *stateStore) GetState(key []byte) []byte {
 	storeKey := AspectArrayKey(aspectID.Bytes(), key)
 	return prefixStore.Get(storeKey)
 }
+
+// ForEachState iterates all the states of the aspect with the given ID.
+func (s *stateStore) ForEachState(cb func(key, value []byte) bool) {
+	aspectID := s.ctx.AspectID
+	prefixStore := s.NewPrefixStore(V0AspectStateKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(prefixStore, AspectArrayKey(aspectID.Bytes()))
+	defer iterator.Close()
+
+	prefixLen := len(aspectID.Bytes()) + PathSeparatorLen
+	var states []kvPair
+	for ; iterator.Valid(); iterator.Next() {
+		// v0 store may keep the deleted states with empty values
+		if len(iterator.Value()) == 0 {
+			continue
+		}
+
+		// v0 state key is aspectID/key/, so we need to trim the trailing separator
+		key := bytes.TrimSuffix(iterator.Key()[prefixLen:], PathSeparator)
+		states = append(states, kvPair{key: common.CopyBytes(key), value: common.CopyBytes(iterator.Value())})
+	}
+
+	// the trailing separator breaks the key order of the iterator, e.g. "a!/" comes before "a/",
+	// so the states are sorted again by the trimmed keys
+	sort.Slice(states, func(i, j int) bool {
+		return bytes.Compare(states[i].key, states[j].key) < 0
+	})
+
+	for _, state := range states {
+		if !cb(state.key, state.value) {
+			return
+		}
+	}
+}
+
+type kvPair struct {
+	key   []byte
+	value []byte
+}
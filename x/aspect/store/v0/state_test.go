@@ -0,0 +1,66 @@
+package v0_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	v0 "github.com/artela-network/artela/x/aspect/store/v0"
+	"github.com/artela-network/artela/x/aspect/types"
+)
+
+func TestForEachStateSorted(t *testing.T) {
+	aspectKey := storetypes.NewKVStoreKey(types.StoreKey)
+	evmKey := storetypes.NewKVStoreKey("evm")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(aspectKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := cosmos.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	newStore := func(aspectID common.Address) interface {
+		SetState(key []byte, value []byte)
+		ForEachState(cb func(key, value []byte) bool)
+	} {
+		return v0.NewStateStore(&types.AspectStoreContext{
+			StoreContext: types.NewGasFreeStoreContext(ctx, aspectKey, evmKey),
+			AspectID:     aspectID,
+		})
+	}
+
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	stateStore := newStore(aspectID)
+	// "a!/" is stored before "a/", and "a0/" is stored after "a/"
+	for _, key := range []string{"b", "a0", "a", "a!"} {
+		stateStore.SetState([]byte(key), []byte("value-"+key))
+	}
+	// deleted state kept with empty value is skipped
+	stateStore.SetState([]byte("c"), []byte{})
+	// states of other aspects are not included
+	newStore(common.HexToAddress("0x0000000000000000000000000000000000000002")).SetState([]byte("a"), []byte("other"))
+
+	var keys []string
+	stateStore.ForEachState(func(key, value []byte) bool {
+		require.Equal(t, "value-"+string(key), string(value))
+		keys = append(keys, string(key))
+		return true
+	})
+	require.Equal(t, []string{"a", "a!", "a0", "b"}, keys)
+
+	// break early
+	keys = nil
+	stateStore.ForEachState(func(key, _ []byte) bool {
+		keys = append(keys, string(key))
+		return len(keys) < 2
+	})
+	require.Equal(t, []string{"a", "a!"}, keys)
+}
@@ -91,6 +91,7 @@ func (m *metaStore) GetMeta() (*types.AspectMeta, error) {
 	return &types.AspectMeta{
 		PayMaster: m.ext.PayMaster,
 		Proof:     m.ext.Proof,
+		Owner:     m.ext.Owner,
 	}, nil
 }
 
@@ -150,6 +151,31 @@ func (m *metaStore) GetProperty(version uint64, propKey string) ([]byte, error)
 	return allProps[propKey], nil
 }
 
+func (m *metaStore) GetProperties(version uint64) ([]types.Property, error) {
+	if version == 0 {
+		return nil, nil
+	}
+
+	allProps, err := m.getProperties(version)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make([]types.Property, 0, len(allProps))
+	for key, value := range allProps {
+		properties = append(properties, types.Property{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].Key < properties[j].Key
+	})
+
+	return properties, nil
+}
+
 func (m *metaStore) BumpVersion() (ver uint64, err error) {
 	key := store.NewKeyBuilder(store.AspectProtocolInfoKeyPrefix).AppendBytes(m.ctx.AspectID.Bytes()).Build()
 	raw, err := m.Load(key)
@@ -196,15 +222,18 @@ func (m *metaStore) StoreVersionMeta(version uint64, meta *types.VersionMeta) er
 func (m *metaStore) StoreMeta(meta *types.AspectMeta) (err error) {
 	oldPayMaster := m.ext.PayMaster
 	oldProof := m.ext.Proof
+	oldOwner := m.ext.Owner
 
 	m.ext.PayMaster = meta.PayMaster
 	m.ext.Proof = meta.Proof
+	m.ext.Owner = meta.Owner
 
 	defer func() {
 		// rollback if failed
 		if err != nil {
 			m.ext.PayMaster = oldPayMaster
 			m.ext.Proof = oldProof
+			m.ext.Owner = oldOwner
 		}
 	}()
 
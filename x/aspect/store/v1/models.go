@@ -94,6 +94,8 @@ type Extension struct {
 	AspectVersion uint64
 	PayMaster     common.Address
 	Proof         []byte
+	// Owner is appended after the proof, the extensions stored before the owner is recorded end with the proof
+	Owner common.Address
 }
 
 func (e *Extension) UnmarshalText(text []byte) error {
@@ -107,11 +109,15 @@ func (e *Extension) UnmarshalText(text []byte) error {
 	e.AspectVersion = binary.BigEndian.Uint64(text[:8])
 	e.PayMaster.SetBytes(text[8:28])
 	proofLen := binary.BigEndian.Uint64(text[28:36])
-	if uint64(len(text)) != 36+proofLen {
+	switch uint64(len(text)) - 36 {
+	case proofLen:
+	case proofLen + common.AddressLength:
+		e.Owner.SetBytes(text[36+proofLen:])
+	default:
 		return store.ErrInvalidExtension
 	}
 	e.Proof = make([]byte, proofLen)
-	copy(e.Proof, text[36:])
+	copy(e.Proof, text[36:36+proofLen])
 	return nil
 }
 
@@ -121,6 +127,9 @@ func (e Extension) MarshalText() (text []byte, err error) {
 	copy(result[8:28], e.PayMaster.Bytes())
 	binary.BigEndian.PutUint64(result[28:36], uint64(len(e.Proof)))
 	copy(result[36:], e.Proof)
+	if e.Owner != (common.Address{}) {
+		result = append(result, e.Owner.Bytes()...)
+	}
 	return result, nil
 }
 
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/aspect/store"
+)
+
+func TestExtensionOwner(t *testing.T) {
+	paymaster := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	owner := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	// the extension stored before the owner is recorded keeps its layout
+	legacy := Extension{AspectVersion: 1, PayMaster: paymaster, Proof: []byte{1, 2, 3}}
+	raw, err := legacy.MarshalText()
+	require.NoError(t, err)
+	require.Len(t, raw, 36+3)
+
+	var decoded Extension
+	require.NoError(t, decoded.UnmarshalText(raw))
+	require.Equal(t, legacy, decoded)
+
+	withOwner := Extension{AspectVersion: 1, PayMaster: paymaster, Proof: []byte{1, 2, 3}, Owner: owner}
+	raw, err = withOwner.MarshalText()
+	require.NoError(t, err)
+
+	decoded = Extension{}
+	require.NoError(t, decoded.UnmarshalText(raw))
+	require.Equal(t, withOwner, decoded)
+
+	require.ErrorIs(t, decoded.UnmarshalText(raw[:len(raw)-1]), store.ErrInvalidExtension)
+}
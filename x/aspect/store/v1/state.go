@@ -1,6 +1,9 @@
 package v1
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/artela-network/artela/x/aspect/store"
 	v0 "github.com/artela-network/artela/x/aspect/store/v0"
 	"github.com/artela-network/artela/x/aspect/types"
@@ -44,3 +47,18 @@ func (s *stateStore) GetState(key []byte) []byte {
 	s.ctx.Logger().Info("========= get aspect state", "key", string(key), "value", abbreviateHex(data))
 	return data
 }
+
+// ForEachState iterates all the states of the aspect with the given ID.
+func (s *stateStore) ForEachState(cb func(key, value []byte) bool) {
+	aspectID := s.ctx.AspectID
+	prefix := store.NewKeyBuilder(V1AspectStateKeyPrefix).AppendBytes(aspectID.Bytes()).Build()
+
+	iterator := storetypes.KVStorePrefixIterator(s.ctx.CosmosContext().KVStore(s.ctx.AspectStoreKey()), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if !cb(common.CopyBytes(iterator.Key()[len(prefix):]), common.CopyBytes(iterator.Value())) {
+			return
+		}
+	}
+}
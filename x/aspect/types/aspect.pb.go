@@ -0,0 +1,1781 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: artela/aspect/v1/aspect.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AspectVersionMeta defines the metadata of a deployed version of an aspect.
+type AspectVersionMeta struct {
+	// version is the version number of the aspect, starting from 1
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// join_point is the bitmap of join points the aspect version is declared for
+	JoinPoint uint64 `protobuf:"varint,2,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+	// code_hash is the hex encoded keccak256 hash of the aspect code
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *AspectVersionMeta) Reset()         { *m = AspectVersionMeta{} }
+func (m *AspectVersionMeta) String() string { return proto.CompactTextString(m) }
+func (*AspectVersionMeta) ProtoMessage()    {}
+func (*AspectVersionMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fdd9d4f1e7bd39b, []int{0}
+}
+func (m *AspectVersionMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectVersionMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectVersionMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectVersionMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectVersionMeta.Merge(m, src)
+}
+func (m *AspectVersionMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectVersionMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectVersionMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectVersionMeta proto.InternalMessageInfo
+
+func (m *AspectVersionMeta) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AspectVersionMeta) GetJoinPoint() uint64 {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return 0
+}
+
+func (m *AspectVersionMeta) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// AspectProperty defines a single key-value property of an aspect.
+type AspectProperty struct {
+	// key is the property key
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the raw property value
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *AspectProperty) Reset()         { *m = AspectProperty{} }
+func (m *AspectProperty) String() string { return proto.CompactTextString(m) }
+func (*AspectProperty) ProtoMessage()    {}
+func (*AspectProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fdd9d4f1e7bd39b, []int{1}
+}
+func (m *AspectProperty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectProperty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectProperty.Merge(m, src)
+}
+func (m *AspectProperty) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectProperty proto.InternalMessageInfo
+
+func (m *AspectProperty) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AspectProperty) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// AspectBinding defines the binding relation between an aspect and an account.
+type AspectBinding struct {
+	// aspect_id is the hex address of the bound aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// account is the hex address of the bound account
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// version is the bound version of the aspect, 0 means not recorded
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// priority is the execution priority of the aspect on this account
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// join_point is the join point bitmap recorded with the binding
+	JoinPoint uint32 `protobuf:"varint,5,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+}
+
+func (m *AspectBinding) Reset()         { *m = AspectBinding{} }
+func (m *AspectBinding) String() string { return proto.CompactTextString(m) }
+func (*AspectBinding) ProtoMessage()    {}
+func (*AspectBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fdd9d4f1e7bd39b, []int{2}
+}
+func (m *AspectBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectBinding.Merge(m, src)
+}
+func (m *AspectBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectBinding proto.InternalMessageInfo
+
+func (m *AspectBinding) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *AspectBinding) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AspectBinding) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AspectBinding) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AspectBinding) GetJoinPoint() uint32 {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return 0
+}
+
+// AspectStateEntry defines a key-value pair saved in the state store of an aspect.
+type AspectStateEntry struct {
+	// key is the raw state key
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the raw state value
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *AspectStateEntry) Reset()         { *m = AspectStateEntry{} }
+func (m *AspectStateEntry) String() string { return proto.CompactTextString(m) }
+func (*AspectStateEntry) ProtoMessage()    {}
+func (*AspectStateEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fdd9d4f1e7bd39b, []int{3}
+}
+func (m *AspectStateEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectStateEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectStateEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectStateEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectStateEntry.Merge(m, src)
+}
+func (m *AspectStateEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectStateEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectStateEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectStateEntry proto.InternalMessageInfo
+
+func (m *AspectStateEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AspectStateEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// AspectStateChange defines the change of a state key between two heights.
+type AspectStateChange struct {
+	// key is the raw state key
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// from_value is the value at the start height, empty if the key did not exist
+	FromValue []byte `protobuf:"bytes,2,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	// to_value is the value at the end height, empty if the key has been deleted
+	ToValue []byte `protobuf:"bytes,3,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
+}
+
+func (m *AspectStateChange) Reset()         { *m = AspectStateChange{} }
+func (m *AspectStateChange) String() string { return proto.CompactTextString(m) }
+func (*AspectStateChange) ProtoMessage()    {}
+func (*AspectStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fdd9d4f1e7bd39b, []int{4}
+}
+func (m *AspectStateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectStateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectStateChange.Merge(m, src)
+}
+func (m *AspectStateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectStateChange proto.InternalMessageInfo
+
+func (m *AspectStateChange) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *AspectStateChange) GetFromValue() []byte {
+	if m != nil {
+		return m.FromValue
+	}
+	return nil
+}
+
+func (m *AspectStateChange) GetToValue() []byte {
+	if m != nil {
+		return m.ToValue
+	}
+	return nil
+}
+
+// AspectStateDiff defines all the state changes of an aspect between two heights.
+type AspectStateDiff struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// from_height is the start height of the diff
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the end height of the diff
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// changes is the list of changed states sorted by key
+	Changes []*AspectStateChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *AspectStateDiff) Reset()         { *m = AspectStateDiff{} }
+func (m *AspectStateDiff) String() string { return proto.CompactTextString(m) }
+func (*AspectStateDiff) ProtoMessage()    {}
+func (*AspectStateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6fdd9d4f1e7bd39b, []int{5}
+}
+func (m *AspectStateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectStateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectStateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectStateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectStateDiff.Merge(m, src)
+}
+func (m *AspectStateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectStateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectStateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectStateDiff proto.InternalMessageInfo
+
+func (m *AspectStateDiff) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *AspectStateDiff) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *AspectStateDiff) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *AspectStateDiff) GetChanges() []*AspectStateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AspectVersionMeta)(nil), "artela.aspect.v1.AspectVersionMeta")
+	proto.RegisterType((*AspectProperty)(nil), "artela.aspect.v1.AspectProperty")
+	proto.RegisterType((*AspectBinding)(nil), "artela.aspect.v1.AspectBinding")
+	proto.RegisterType((*AspectStateEntry)(nil), "artela.aspect.v1.AspectStateEntry")
+	proto.RegisterType((*AspectStateChange)(nil), "artela.aspect.v1.AspectStateChange")
+	proto.RegisterType((*AspectStateDiff)(nil), "artela.aspect.v1.AspectStateDiff")
+}
+
+func init() { proto.RegisterFile("artela/aspect/v1/aspect.proto", fileDescriptor_6fdd9d4f1e7bd39b) }
+
+var fileDescriptor_6fdd9d4f1e7bd39b = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xcc, 0xe2, 0x84, 0xd8, 0xaf, 0x2d, 0xa4, 0x16, 0x07, 0x03, 0x8a, 0xb1, 0xcc, 0xc5, 0x17,
+	0x1c, 0xb5, 0x5c, 0x10, 0x12, 0x07, 0x0a, 0x48, 0x45, 0x02, 0xa9, 0x32, 0x52, 0x0f, 0x5c, 0xac,
+	0xad, 0xb3, 0x89, 0x97, 0xb6, 0xbb, 0xd6, 0xfa, 0x25, 0x90, 0xbf, 0xe0, 0xce, 0x27, 0xf0, 0x23,
+	0x1c, 0x7b, 0xe4, 0x88, 0x92, 0x1f, 0x41, 0xbb, 0x6b, 0x23, 0xb7, 0x54, 0xb9, 0xed, 0xcc, 0x68,
+	0xde, 0xbc, 0x59, 0x3d, 0x18, 0x53, 0x85, 0xec, 0x82, 0x4e, 0x68, 0x5d, 0xb1, 0x02, 0x27, 0xcb,
+	0x83, 0xe6, 0x95, 0x56, 0x4a, 0xa2, 0xf4, 0x47, 0x56, 0x4e, 0x1b, 0x72, 0x79, 0x10, 0x73, 0xd8,
+	0x7f, 0x6d, 0xc0, 0x29, 0x53, 0x35, 0x97, 0xe2, 0x23, 0x43, 0xea, 0x07, 0x30, 0x5c, 0x5a, 0x18,
+	0x90, 0x88, 0x24, 0xfd, 0xac, 0x85, 0xfe, 0x18, 0xe0, 0x8b, 0xe4, 0x22, 0xaf, 0x24, 0x17, 0x18,
+	0xdc, 0x31, 0xa2, 0xa7, 0x99, 0x13, 0x4d, 0xf8, 0x8f, 0xc1, 0x2b, 0xe4, 0x94, 0xe5, 0x25, 0xad,
+	0xcb, 0xc0, 0x89, 0x48, 0xe2, 0x65, 0xae, 0x26, 0x8e, 0x69, 0x5d, 0xc6, 0x2f, 0xe0, 0x9e, 0x8d,
+	0x3a, 0x51, 0xb2, 0x62, 0x0a, 0x57, 0xfe, 0x08, 0x9c, 0x73, 0xb6, 0x32, 0x19, 0x5e, 0xa6, 0x9f,
+	0xfe, 0x03, 0x18, 0x2c, 0xe9, 0xc5, 0x82, 0x99, 0xd1, 0xbb, 0x99, 0x05, 0xf1, 0x0f, 0x02, 0x7b,
+	0xd6, 0x7a, 0xc4, 0xc5, 0x94, 0x8b, 0xb9, 0x0e, 0xb2, 0x1d, 0x72, 0x3e, 0x6d, 0xfc, 0xae, 0x25,
+	0xde, 0x4f, 0xf5, 0xfa, 0xb4, 0x28, 0xe4, 0xa2, 0xd9, 0xd0, 0xcb, 0x5a, 0xd8, 0x2d, 0xe6, 0x5c,
+	0x2f, 0xf6, 0x08, 0xdc, 0x4a, 0x71, 0xa9, 0x38, 0xae, 0x82, 0x7e, 0x44, 0x92, 0x41, 0xf6, 0x0f,
+	0xdf, 0x28, 0x3d, 0x88, 0x48, 0xb2, 0xd7, 0x29, 0x1d, 0xbf, 0x84, 0x91, 0x5d, 0xee, 0x13, 0x52,
+	0x64, 0xef, 0x04, 0xaa, 0x6b, 0xcd, 0x76, 0xb7, 0x35, 0xcb, 0x61, 0xbf, 0xe3, 0x7d, 0x53, 0x52,
+	0x31, 0x67, 0xb7, 0x98, 0xc7, 0x00, 0x33, 0x25, 0x2f, 0xf3, 0xee, 0x04, 0x4f, 0x33, 0xa7, 0x9a,
+	0xf0, 0x1f, 0x82, 0x8b, 0xb2, 0x11, 0x1d, 0x23, 0x0e, 0x51, 0x1a, 0x29, 0xfe, 0x49, 0xe0, 0x7e,
+	0x27, 0xe1, 0x2d, 0x9f, 0xcd, 0xb6, 0x7f, 0xde, 0x13, 0xd8, 0x31, 0x51, 0x25, 0xe3, 0xf3, 0xd2,
+	0x7e, 0xa0, 0x93, 0x99, 0xf4, 0x63, 0xc3, 0x68, 0x37, 0xca, 0x56, 0x76, 0x8c, 0xec, 0xa2, 0x6c,
+	0xc4, 0x57, 0x30, 0x2c, 0x4c, 0x89, 0x3a, 0xe8, 0x47, 0x4e, 0xb2, 0x73, 0xf8, 0x34, 0xbd, 0x79,
+	0x72, 0xe9, 0x7f, 0x85, 0xb3, 0xd6, 0x73, 0xf4, 0xe1, 0xd7, 0x3a, 0x24, 0x57, 0xeb, 0x90, 0xfc,
+	0x59, 0x87, 0xe4, 0xfb, 0x26, 0xec, 0x5d, 0x6d, 0xc2, 0xde, 0xef, 0x4d, 0xd8, 0xfb, 0x7c, 0x38,
+	0xe7, 0x58, 0x2e, 0xce, 0xd2, 0x42, 0x5e, 0x4e, 0xec, 0xc4, 0x67, 0x82, 0xe1, 0x57, 0xa9, 0xce,
+	0x1b, 0xa8, 0x6f, 0xfd, 0x5b, 0x7b, 0xf7, 0xb8, 0xaa, 0x58, 0x7d, 0x76, 0xd7, 0x1c, 0xfd, 0xf3,
+	0xbf, 0x03, 0x00, 0xc0, 0x71, 0x40, 0xf7, 0x15, 0x03, 0x00, 0x00,
+}
+
+func (m *AspectVersionMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectVersionMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectVersionMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JoinPoint != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.JoinPoint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectProperty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectProperty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinPoint != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.JoinPoint))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Priority != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectStateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectStateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectStateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectStateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectStateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectStateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToValue) > 0 {
+		i -= len(m.ToValue)
+		copy(dAtA[i:], m.ToValue)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.ToValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromValue) > 0 {
+		i -= len(m.FromValue)
+		copy(dAtA[i:], m.FromValue)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.FromValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectStateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectStateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectStateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAspect(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAspect(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAspect(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AspectVersionMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAspect(uint64(m.Version))
+	}
+	if m.JoinPoint != 0 {
+		n += 1 + sovAspect(uint64(m.JoinPoint))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	return n
+}
+
+func (m *AspectProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	return n
+}
+
+func (m *AspectBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAspect(uint64(m.Version))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovAspect(uint64(m.Priority))
+	}
+	if m.JoinPoint != 0 {
+		n += 1 + sovAspect(uint64(m.JoinPoint))
+	}
+	return n
+}
+
+func (m *AspectStateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	return n
+}
+
+func (m *AspectStateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.FromValue)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.ToValue)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	return n
+}
+
+func (m *AspectStateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovAspect(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovAspect(uint64(m.ToHeight))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAspect(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAspect(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAspect(x uint64) (n int) {
+	return sovAspect(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AspectVersionMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectVersionMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectVersionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			m.JoinPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinPoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			m.JoinPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinPoint |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectStateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectStateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectStateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectStateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectStateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromValue = append(m.FromValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromValue == nil {
+				m.FromValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToValue = append(m.ToValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToValue == nil {
+				m.ToValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectStateDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectStateDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectStateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &AspectStateChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAspect(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAspect
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAspect
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAspect
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAspect        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAspect          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAspect = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisterInterfaces registers the client interfaces to protobuf Any.
+// Aspect module does not have any messages yet, all the aspect operations
+// are done via the aspect system contract in x/evm.
+func RegisterInterfaces(_ codectypes.InterfaceRegistry) {}
+
+// RegisterLegacyAminoCodec performs a no-op as the aspect module doesn't support amino.
+func RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrAspectNotDeployed = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrAspectVersionNotFound
+	codeErrScheduleNotFound
+)
+
+var (
+	// ErrAspectNotDeployed returns an error if the queried aspect has not been deployed.
+	ErrAspectNotDeployed = errorsmod.Register(ModuleName, codeErrAspectNotDeployed, "aspect not deployed")
+
+	// ErrAspectVersionNotFound returns an error if the queried version of aspect does not exist.
+	ErrAspectVersionNotFound = errorsmod.Register(ModuleName, codeErrAspectVersionNotFound, "aspect version not found")
+
+	// ErrScheduleNotFound returns an error if the queried schedule does not exist.
+	ErrScheduleNotFound = errorsmod.Register(ModuleName, codeErrScheduleNotFound, "schedule not found")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState sets default aspect genesis states.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// NewGenesisState creates a new genesis states.
+func NewGenesisState(storeEntries, legacyStoreEntries []StoreEntry) *GenesisState {
+	return &GenesisState{
+		StoreEntries:       storeEntries,
+		LegacyStoreEntries: legacyStoreEntries,
+	}
+}
+
+// Validate performs basic genesis states validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := validateStoreEntries(gs.StoreEntries); err != nil {
+		return fmt.Errorf("invalid aspect store entries: %w", err)
+	}
+
+	if err := validateStoreEntries(gs.LegacyStoreEntries); err != nil {
+		return fmt.Errorf("invalid legacy aspect store entries: %w", err)
+	}
+
+	return nil
+}
+
+func validateStoreEntries(entries []StoreEntry) error {
+	seen := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if len(entry.Key) == 0 {
+			return fmt.Errorf("empty key")
+		}
+		if _, ok := seen[string(entry.Key)]; ok {
+			return fmt.Errorf("duplicated key %x", entry.Key)
+		}
+		seen[string(entry.Key)] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/artela-network/aspect-core/types"
+)
+
+// Block level join points, aspects declaring these join points are executed at the beginning
+// and the end of each block. Unlike the transaction level join points, block level aspects
+// are not bound to any account, they are triggered for every block once deployed.
+const (
+	ON_BLOCK_INITIALIZE_METHOD types.PointCut = "onBlockInitialize"
+	ON_BLOCK_FINALIZE_METHOD   types.PointCut = "onBlockFinalize"
+)
+
+const (
+	JoinPointRunType_OnBlockInitialize types.JoinPointRunType = 32
+	JoinPointRunType_OnBlockFinalize   types.JoinPointRunType = 64
+
+	BlockLevelJP = int64(JoinPointRunType_OnBlockInitialize) + int64(JoinPointRunType_OnBlockFinalize)
+)
+
+// BlockJoinPoints maps the block level join points to their run types
+var BlockJoinPoints = map[types.PointCut]types.JoinPointRunType{
+	ON_BLOCK_INITIALIZE_METHOD: JoinPointRunType_OnBlockInitialize,
+	ON_BLOCK_FINALIZE_METHOD:   JoinPointRunType_OnBlockFinalize,
+}
+
+// CheckIsBlockLevel returns true if any of the block level join points is declared
+func CheckIsBlockLevel(runJPs int64) bool {
+	return runJPs&BlockLevelJP > 0
+}
+
+// CanExecBlockPoint returns true if the given block level join point is declared
+func CanExecBlockPoint(runJPs int64, cut types.PointCut) bool {
+	value, ok := BlockJoinPoints[cut]
+	return ok && runJPs&int64(value) == int64(value)
+}
@@ -49,6 +49,8 @@ type VersionMeta struct {
 type AspectMeta struct {
 	PayMaster common.Address
 	Proof     []byte
+	// Owner is the account deployed the aspect, zero if not recorded
+	Owner common.Address
 }
 
 // Property is the data model for holding the properties of an aspect
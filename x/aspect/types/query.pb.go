@@ -0,0 +1,4597 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: artela/aspect/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryAspectMetaRequest is the request type for the Query/AspectMeta RPC method.
+type QueryAspectMetaRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+}
+
+func (m *QueryAspectMetaRequest) Reset()         { *m = QueryAspectMetaRequest{} }
+func (m *QueryAspectMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectMetaRequest) ProtoMessage()    {}
+func (*QueryAspectMetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{0}
+}
+func (m *QueryAspectMetaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectMetaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectMetaRequest.Merge(m, src)
+}
+func (m *QueryAspectMetaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectMetaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectMetaRequest proto.InternalMessageInfo
+
+func (m *QueryAspectMetaRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+// QueryAspectMetaResponse is the response type for the Query/AspectMeta RPC method.
+type QueryAspectMetaResponse struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// pay_master is the hex address of the account paying for the aspect
+	PayMaster string `protobuf:"bytes,2,opt,name=pay_master,json=payMaster,proto3" json:"pay_master,omitempty"`
+	// proof is the raw proof submitted with the deployment
+	Proof []byte `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// latest_version is the latest deployed version of the aspect
+	LatestVersion uint64 `protobuf:"varint,4,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// store_version is the meta store protocol version used by the aspect
+	StoreVersion uint32 `protobuf:"varint,5,opt,name=store_version,json=storeVersion,proto3" json:"store_version,omitempty"`
+}
+
+func (m *QueryAspectMetaResponse) Reset()         { *m = QueryAspectMetaResponse{} }
+func (m *QueryAspectMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectMetaResponse) ProtoMessage()    {}
+func (*QueryAspectMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{1}
+}
+func (m *QueryAspectMetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectMetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectMetaResponse.Merge(m, src)
+}
+func (m *QueryAspectMetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectMetaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectMetaResponse proto.InternalMessageInfo
+
+func (m *QueryAspectMetaResponse) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectMetaResponse) GetPayMaster() string {
+	if m != nil {
+		return m.PayMaster
+	}
+	return ""
+}
+
+func (m *QueryAspectMetaResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryAspectMetaResponse) GetLatestVersion() uint64 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *QueryAspectMetaResponse) GetStoreVersion() uint32 {
+	if m != nil {
+		return m.StoreVersion
+	}
+	return 0
+}
+
+// QueryAspectVersionsRequest is the request type for the Query/AspectVersions RPC method.
+type QueryAspectVersionsRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+}
+
+func (m *QueryAspectVersionsRequest) Reset()         { *m = QueryAspectVersionsRequest{} }
+func (m *QueryAspectVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectVersionsRequest) ProtoMessage()    {}
+func (*QueryAspectVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{2}
+}
+func (m *QueryAspectVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectVersionsRequest.Merge(m, src)
+}
+func (m *QueryAspectVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryAspectVersionsRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+// QueryAspectVersionsResponse is the response type for the Query/AspectVersions RPC method.
+type QueryAspectVersionsResponse struct {
+	// versions is the list of deployed versions, in ascending order
+	Versions []AspectVersionMeta `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+}
+
+func (m *QueryAspectVersionsResponse) Reset()         { *m = QueryAspectVersionsResponse{} }
+func (m *QueryAspectVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectVersionsResponse) ProtoMessage()    {}
+func (*QueryAspectVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{3}
+}
+func (m *QueryAspectVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectVersionsResponse.Merge(m, src)
+}
+func (m *QueryAspectVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryAspectVersionsResponse) GetVersions() []AspectVersionMeta {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// QueryAspectCodeRequest is the request type for the Query/AspectCode RPC method.
+type QueryAspectCodeRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// version is the version of the aspect, 0 for the latest
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryAspectCodeRequest) Reset()         { *m = QueryAspectCodeRequest{} }
+func (m *QueryAspectCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectCodeRequest) ProtoMessage()    {}
+func (*QueryAspectCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{4}
+}
+func (m *QueryAspectCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectCodeRequest.Merge(m, src)
+}
+func (m *QueryAspectCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectCodeRequest proto.InternalMessageInfo
+
+func (m *QueryAspectCodeRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectCodeRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryAspectCodeResponse is the response type for the Query/AspectCode RPC method.
+type QueryAspectCodeResponse struct {
+	// version is the version of the returned code
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// code is the aspect bytecode
+	Code []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// code_hash is the hex encoded keccak256 hash of the code
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *QueryAspectCodeResponse) Reset()         { *m = QueryAspectCodeResponse{} }
+func (m *QueryAspectCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectCodeResponse) ProtoMessage()    {}
+func (*QueryAspectCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{5}
+}
+func (m *QueryAspectCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectCodeResponse.Merge(m, src)
+}
+func (m *QueryAspectCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectCodeResponse proto.InternalMessageInfo
+
+func (m *QueryAspectCodeResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryAspectCodeResponse) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *QueryAspectCodeResponse) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// QueryAspectPropertiesRequest is the request type for the Query/AspectProperties RPC method.
+type QueryAspectPropertiesRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// version is the version of the aspect, 0 for the latest
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryAspectPropertiesRequest) Reset()         { *m = QueryAspectPropertiesRequest{} }
+func (m *QueryAspectPropertiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectPropertiesRequest) ProtoMessage()    {}
+func (*QueryAspectPropertiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{6}
+}
+func (m *QueryAspectPropertiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectPropertiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectPropertiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectPropertiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectPropertiesRequest.Merge(m, src)
+}
+func (m *QueryAspectPropertiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectPropertiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectPropertiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectPropertiesRequest proto.InternalMessageInfo
+
+func (m *QueryAspectPropertiesRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectPropertiesRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// QueryAspectPropertiesResponse is the response type for the Query/AspectProperties RPC method.
+type QueryAspectPropertiesResponse struct {
+	// version is the version of the returned properties
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// properties is the list of aspect properties sorted by key
+	Properties []AspectProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties"`
+}
+
+func (m *QueryAspectPropertiesResponse) Reset()         { *m = QueryAspectPropertiesResponse{} }
+func (m *QueryAspectPropertiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectPropertiesResponse) ProtoMessage()    {}
+func (*QueryAspectPropertiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{7}
+}
+func (m *QueryAspectPropertiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectPropertiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectPropertiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectPropertiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectPropertiesResponse.Merge(m, src)
+}
+func (m *QueryAspectPropertiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectPropertiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectPropertiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectPropertiesResponse proto.InternalMessageInfo
+
+func (m *QueryAspectPropertiesResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryAspectPropertiesResponse) GetProperties() []AspectProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// QueryBoundAccountsRequest is the request type for the Query/BoundAccounts RPC method.
+type QueryBoundAccountsRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+}
+
+func (m *QueryBoundAccountsRequest) Reset()         { *m = QueryBoundAccountsRequest{} }
+func (m *QueryBoundAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBoundAccountsRequest) ProtoMessage()    {}
+func (*QueryBoundAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{8}
+}
+func (m *QueryBoundAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoundAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoundAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoundAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoundAccountsRequest.Merge(m, src)
+}
+func (m *QueryBoundAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoundAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoundAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoundAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryBoundAccountsRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+// QueryBoundAccountsResponse is the response type for the Query/BoundAccounts RPC method.
+type QueryBoundAccountsResponse struct {
+	// bindings is the list of accounts bound to the aspect
+	Bindings []AspectBinding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings"`
+}
+
+func (m *QueryBoundAccountsResponse) Reset()         { *m = QueryBoundAccountsResponse{} }
+func (m *QueryBoundAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBoundAccountsResponse) ProtoMessage()    {}
+func (*QueryBoundAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{9}
+}
+func (m *QueryBoundAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoundAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoundAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoundAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoundAccountsResponse.Merge(m, src)
+}
+func (m *QueryBoundAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoundAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoundAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoundAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryBoundAccountsResponse) GetBindings() []AspectBinding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+// QueryAccountBindingsRequest is the request type for the Query/AccountBindings RPC method.
+type QueryAccountBindingsRequest struct {
+	// account is the hex address of the account
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryAccountBindingsRequest) Reset()         { *m = QueryAccountBindingsRequest{} }
+func (m *QueryAccountBindingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBindingsRequest) ProtoMessage()    {}
+func (*QueryAccountBindingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{10}
+}
+func (m *QueryAccountBindingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBindingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBindingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBindingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBindingsRequest.Merge(m, src)
+}
+func (m *QueryAccountBindingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBindingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBindingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountBindingsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountBindingsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// QueryAccountBindingsResponse is the response type for the Query/AccountBindings RPC method.
+type QueryAccountBindingsResponse struct {
+	// bindings is the list of aspects bound to the account
+	Bindings []AspectBinding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings"`
+}
+
+func (m *QueryAccountBindingsResponse) Reset()         { *m = QueryAccountBindingsResponse{} }
+func (m *QueryAccountBindingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBindingsResponse) ProtoMessage()    {}
+func (*QueryAccountBindingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{11}
+}
+func (m *QueryAccountBindingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBindingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBindingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBindingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBindingsResponse.Merge(m, src)
+}
+func (m *QueryAccountBindingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBindingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBindingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountBindingsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountBindingsResponse) GetBindings() []AspectBinding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+// QueryAspectStateRequest is the request type for the Query/AspectState RPC method.
+type QueryAspectStateRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// key is the raw key in the aspect state store
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *QueryAspectStateRequest) Reset()         { *m = QueryAspectStateRequest{} }
+func (m *QueryAspectStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectStateRequest) ProtoMessage()    {}
+func (*QueryAspectStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{12}
+}
+func (m *QueryAspectStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectStateRequest.Merge(m, src)
+}
+func (m *QueryAspectStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectStateRequest proto.InternalMessageInfo
+
+func (m *QueryAspectStateRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectStateRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+// QueryAspectStateResponse is the response type for the Query/AspectState RPC method.
+type QueryAspectStateResponse struct {
+	// value is the raw value of the key, empty if the key does not exist
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryAspectStateResponse) Reset()         { *m = QueryAspectStateResponse{} }
+func (m *QueryAspectStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectStateResponse) ProtoMessage()    {}
+func (*QueryAspectStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{13}
+}
+func (m *QueryAspectStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectStateResponse.Merge(m, src)
+}
+func (m *QueryAspectStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectStateResponse proto.InternalMessageInfo
+
+func (m *QueryAspectStateResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// QueryAspectStatesRequest is the request type for the Query/AspectStates RPC method.
+type QueryAspectStatesRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+}
+
+func (m *QueryAspectStatesRequest) Reset()         { *m = QueryAspectStatesRequest{} }
+func (m *QueryAspectStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectStatesRequest) ProtoMessage()    {}
+func (*QueryAspectStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{14}
+}
+func (m *QueryAspectStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectStatesRequest.Merge(m, src)
+}
+func (m *QueryAspectStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectStatesRequest proto.InternalMessageInfo
+
+func (m *QueryAspectStatesRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+// QueryAspectStatesResponse is the response type for the Query/AspectStates RPC method.
+type QueryAspectStatesResponse struct {
+	// states is the list of all the aspect states sorted by key
+	States []AspectStateEntry `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+}
+
+func (m *QueryAspectStatesResponse) Reset()         { *m = QueryAspectStatesResponse{} }
+func (m *QueryAspectStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectStatesResponse) ProtoMessage()    {}
+func (*QueryAspectStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{15}
+}
+func (m *QueryAspectStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectStatesResponse.Merge(m, src)
+}
+func (m *QueryAspectStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectStatesResponse proto.InternalMessageInfo
+
+func (m *QueryAspectStatesResponse) GetStates() []AspectStateEntry {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+// QueryScheduleRequest is the request type for the Query/Schedule RPC method.
+type QueryScheduleRequest struct {
+	// id is the id of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryScheduleRequest) Reset()         { *m = QueryScheduleRequest{} }
+func (m *QueryScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleRequest) ProtoMessage()    {}
+func (*QueryScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{16}
+}
+func (m *QueryScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleRequest.Merge(m, src)
+}
+func (m *QueryScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryScheduleResponse is the response type for the Query/Schedule RPC method.
+type QueryScheduleResponse struct {
+	// schedule is the queried schedule
+	Schedule Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryScheduleResponse) Reset()         { *m = QueryScheduleResponse{} }
+func (m *QueryScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleResponse) ProtoMessage()    {}
+func (*QueryScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{17}
+}
+func (m *QueryScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleResponse.Merge(m, src)
+}
+func (m *QueryScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleResponse) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+// QueryAspectSchedulesRequest is the request type for the Query/AspectSchedules RPC method.
+type QueryAspectSchedulesRequest struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// active_only filters out the finished and cancelled schedules
+	ActiveOnly bool `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (m *QueryAspectSchedulesRequest) Reset()         { *m = QueryAspectSchedulesRequest{} }
+func (m *QueryAspectSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectSchedulesRequest) ProtoMessage()    {}
+func (*QueryAspectSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{18}
+}
+func (m *QueryAspectSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectSchedulesRequest.Merge(m, src)
+}
+func (m *QueryAspectSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryAspectSchedulesRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectSchedulesRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+// QueryAspectSchedulesResponse is the response type for the Query/AspectSchedules RPC method.
+type QueryAspectSchedulesResponse struct {
+	// schedules is the list of schedules sorted by id
+	Schedules []Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *QueryAspectSchedulesResponse) Reset()         { *m = QueryAspectSchedulesResponse{} }
+func (m *QueryAspectSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectSchedulesResponse) ProtoMessage()    {}
+func (*QueryAspectSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033d90ed73d709c7, []int{19}
+}
+func (m *QueryAspectSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectSchedulesResponse.Merge(m, src)
+}
+func (m *QueryAspectSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryAspectSchedulesResponse) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAspectMetaRequest)(nil), "artela.aspect.v1.QueryAspectMetaRequest")
+	proto.RegisterType((*QueryAspectMetaResponse)(nil), "artela.aspect.v1.QueryAspectMetaResponse")
+	proto.RegisterType((*QueryAspectVersionsRequest)(nil), "artela.aspect.v1.QueryAspectVersionsRequest")
+	proto.RegisterType((*QueryAspectVersionsResponse)(nil), "artela.aspect.v1.QueryAspectVersionsResponse")
+	proto.RegisterType((*QueryAspectCodeRequest)(nil), "artela.aspect.v1.QueryAspectCodeRequest")
+	proto.RegisterType((*QueryAspectCodeResponse)(nil), "artela.aspect.v1.QueryAspectCodeResponse")
+	proto.RegisterType((*QueryAspectPropertiesRequest)(nil), "artela.aspect.v1.QueryAspectPropertiesRequest")
+	proto.RegisterType((*QueryAspectPropertiesResponse)(nil), "artela.aspect.v1.QueryAspectPropertiesResponse")
+	proto.RegisterType((*QueryBoundAccountsRequest)(nil), "artela.aspect.v1.QueryBoundAccountsRequest")
+	proto.RegisterType((*QueryBoundAccountsResponse)(nil), "artela.aspect.v1.QueryBoundAccountsResponse")
+	proto.RegisterType((*QueryAccountBindingsRequest)(nil), "artela.aspect.v1.QueryAccountBindingsRequest")
+	proto.RegisterType((*QueryAccountBindingsResponse)(nil), "artela.aspect.v1.QueryAccountBindingsResponse")
+	proto.RegisterType((*QueryAspectStateRequest)(nil), "artela.aspect.v1.QueryAspectStateRequest")
+	proto.RegisterType((*QueryAspectStateResponse)(nil), "artela.aspect.v1.QueryAspectStateResponse")
+	proto.RegisterType((*QueryAspectStatesRequest)(nil), "artela.aspect.v1.QueryAspectStatesRequest")
+	proto.RegisterType((*QueryAspectStatesResponse)(nil), "artela.aspect.v1.QueryAspectStatesResponse")
+	proto.RegisterType((*QueryScheduleRequest)(nil), "artela.aspect.v1.QueryScheduleRequest")
+	proto.RegisterType((*QueryScheduleResponse)(nil), "artela.aspect.v1.QueryScheduleResponse")
+	proto.RegisterType((*QueryAspectSchedulesRequest)(nil), "artela.aspect.v1.QueryAspectSchedulesRequest")
+	proto.RegisterType((*QueryAspectSchedulesResponse)(nil), "artela.aspect.v1.QueryAspectSchedulesResponse")
+}
+
+func init() { proto.RegisterFile("artela/aspect/v1/query.proto", fileDescriptor_033d90ed73d709c7) }
+
+var fileDescriptor_033d90ed73d709c7 = []byte{
+	// 1053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc7, 0x33, 0x4e, 0xd2, 0x3a, 0x2f, 0x4e, 0x7e, 0xd1, 0x28, 0x3f, 0xea, 0x6e, 0x13, 0xc7,
+	0xda, 0xd2, 0xd4, 0x6d, 0x9c, 0xdd, 0xc4, 0x2d, 0x4d, 0x91, 0x2a, 0x44, 0x82, 0x8a, 0x8a, 0x44,
+	0x55, 0xd8, 0xaa, 0x1c, 0x40, 0x60, 0x4d, 0xbc, 0x83, 0xbd, 0xaa, 0xbb, 0xb3, 0xdd, 0x19, 0x1b,
+	0xac, 0xa8, 0x07, 0x90, 0xb8, 0x57, 0x42, 0x1c, 0x38, 0xc0, 0x0d, 0x09, 0x0e, 0x9c, 0xf9, 0x0f,
+	0x50, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x0f, 0x41, 0x3b, 0x3b, 0xe3, 0xd8, 0x5e, 0xdb,
+	0x19, 0x04, 0xa7, 0xec, 0xce, 0x7c, 0xdf, 0x7b, 0x9f, 0x7d, 0xef, 0xe5, 0x3d, 0x19, 0xd6, 0x48,
+	0x2c, 0x68, 0x9b, 0xb8, 0x84, 0x47, 0xb4, 0x21, 0xdc, 0xee, 0xae, 0xfb, 0xb4, 0x43, 0xe3, 0x9e,
+	0x13, 0xc5, 0x4c, 0x30, 0xbc, 0x92, 0xde, 0x3a, 0xe9, 0xad, 0xd3, 0xdd, 0xb5, 0xd6, 0x33, 0x7a,
+	0x75, 0x27, 0x0d, 0xac, 0x8d, 0xcc, 0x35, 0x6f, 0xb4, 0xa8, 0xdf, 0x69, 0x53, 0x25, 0x58, 0x6d,
+	0xb2, 0x26, 0x93, 0x8f, 0x6e, 0xf2, 0xa4, 0x4e, 0xd7, 0x9a, 0x8c, 0x35, 0xdb, 0xd4, 0x25, 0x51,
+	0xe0, 0x92, 0x30, 0x64, 0x82, 0x88, 0x80, 0x85, 0x3c, 0xbd, 0xb5, 0x5f, 0x83, 0x57, 0xde, 0x4f,
+	0xa0, 0xf6, 0xa5, 0xd3, 0xfb, 0x54, 0x10, 0x8f, 0x3e, 0xed, 0x50, 0x2e, 0xf0, 0x25, 0x58, 0x48,
+	0x23, 0xd5, 0x03, 0xbf, 0x88, 0xca, 0xa8, 0xb2, 0xe0, 0xe5, 0xd3, 0x83, 0x77, 0x7c, 0xfb, 0x17,
+	0x04, 0x17, 0x32, 0x76, 0x3c, 0x62, 0x21, 0xa7, 0x53, 0x0d, 0xf1, 0x3a, 0x40, 0x44, 0x7a, 0xf5,
+	0x27, 0x84, 0x0b, 0x1a, 0x17, 0x73, 0xf2, 0x76, 0x21, 0x22, 0xbd, 0xfb, 0xf2, 0x00, 0xaf, 0xc2,
+	0x7c, 0x14, 0x33, 0xf6, 0x69, 0x71, 0xb6, 0x8c, 0x2a, 0x05, 0x2f, 0x7d, 0xc1, 0x57, 0x60, 0xb9,
+	0x4d, 0x04, 0xe5, 0xa2, 0xde, 0xa5, 0x31, 0x0f, 0x58, 0x58, 0x9c, 0x2b, 0xa3, 0xca, 0x9c, 0xb7,
+	0x94, 0x9e, 0x7e, 0x90, 0x1e, 0xe2, 0xcb, 0xb0, 0xc4, 0x05, 0x8b, 0x69, 0x5f, 0x35, 0x5f, 0x46,
+	0x95, 0x25, 0xaf, 0x20, 0x0f, 0x95, 0xc8, 0x7e, 0x1d, 0xac, 0x01, 0x70, 0x75, 0xca, 0x8d, 0x3e,
+	0xda, 0x87, 0x4b, 0x63, 0x4d, 0xd5, 0x77, 0xdf, 0x85, 0xbc, 0x0a, 0xcc, 0x8b, 0xa8, 0x3c, 0x5b,
+	0x59, 0xac, 0x5d, 0x76, 0x46, 0x6b, 0xec, 0x0c, 0xd9, 0x26, 0x69, 0x3b, 0x98, 0x7b, 0xf1, 0xc7,
+	0xc6, 0x8c, 0xd7, 0x37, 0xb5, 0x1f, 0x0c, 0x55, 0xe4, 0x2d, 0xe6, 0x53, 0x13, 0x38, 0x5c, 0x84,
+	0xf3, 0xfa, 0xb3, 0x73, 0x32, 0x39, 0xfa, 0xd5, 0xf6, 0xe1, 0x42, 0xc6, 0xa1, 0x42, 0x1e, 0x30,
+	0x42, 0x43, 0x46, 0x18, 0xc3, 0x5c, 0x83, 0xf9, 0x54, 0xfa, 0x2a, 0x78, 0xf2, 0x39, 0x89, 0x9f,
+	0xfc, 0xad, 0xb7, 0x08, 0x6f, 0xc9, 0x02, 0x2d, 0x78, 0xf9, 0xe4, 0xe0, 0x1e, 0xe1, 0x2d, 0xfb,
+	0x11, 0xac, 0x0d, 0x44, 0x79, 0x2f, 0x66, 0x11, 0x8d, 0x45, 0x40, 0xf9, 0xbf, 0x84, 0xff, 0x02,
+	0xc1, 0xfa, 0x04, 0xbf, 0x67, 0x7e, 0xc3, 0xdb, 0x00, 0x51, 0x5f, 0x5f, 0xcc, 0xc9, 0x92, 0x94,
+	0x27, 0x95, 0x44, 0x79, 0xee, 0xa9, 0x7a, 0x0c, 0x58, 0xda, 0xb7, 0xe1, 0xa2, 0x44, 0x38, 0x60,
+	0x9d, 0xd0, 0xdf, 0x6f, 0x34, 0x58, 0x27, 0x14, 0x66, 0x1d, 0x53, 0x07, 0x6b, 0x9c, 0xa5, 0x22,
+	0xdf, 0x87, 0xfc, 0x61, 0x10, 0xfa, 0x41, 0xd8, 0xd4, 0x0d, 0xb3, 0x31, 0x89, 0xee, 0x20, 0xd5,
+	0xe9, 0x66, 0xd1, 0x66, 0xf6, 0x9e, 0x6e, 0xc9, 0xd4, 0xb7, 0x92, 0xf5, 0xe1, 0x8a, 0x70, 0x9e,
+	0xa4, 0x37, 0x0a, 0x4d, 0xbf, 0xda, 0x44, 0x97, 0x6b, 0xd4, 0xf0, 0xbf, 0x63, 0xbb, 0x37, 0xd4,
+	0x77, 0x0f, 0x05, 0x11, 0x66, 0x9d, 0xbc, 0x02, 0xb3, 0x8f, 0x69, 0x4f, 0x75, 0x5e, 0xf2, 0x68,
+	0xef, 0x40, 0x31, 0xeb, 0x49, 0x81, 0xae, 0xc2, 0x7c, 0x97, 0xb4, 0x3b, 0x54, 0xba, 0x29, 0x78,
+	0xe9, 0x8b, 0xbd, 0x97, 0xb5, 0x30, 0xab, 0xd8, 0xc7, 0x70, 0x71, 0x8c, 0xa1, 0x8a, 0xf5, 0x26,
+	0x9c, 0xe3, 0xf2, 0x44, 0xa5, 0xc4, 0x9e, 0x94, 0x12, 0x69, 0x77, 0x37, 0x14, 0xb1, 0x6e, 0x27,
+	0x65, 0x67, 0x6f, 0xc2, 0xaa, 0x74, 0xff, 0x50, 0x4d, 0x6e, 0xcd, 0xb4, 0x0c, 0x39, 0x05, 0x33,
+	0xe7, 0xe5, 0x02, 0xdf, 0x7e, 0x04, 0xff, 0x1f, 0xd1, 0x29, 0x84, 0x3b, 0x90, 0xd7, 0x53, 0x5f,
+	0xca, 0x17, 0x6b, 0x56, 0x16, 0x42, 0x5b, 0xe9, 0x92, 0x68, 0x0b, 0xfb, 0xa3, 0xa1, 0x09, 0xa6,
+	0x65, 0x66, 0xff, 0xa3, 0x1b, 0xb0, 0x48, 0x1a, 0x22, 0xe8, 0xd2, 0x3a, 0x0b, 0xdb, 0x69, 0x79,
+	0xf2, 0x1e, 0xa4, 0x47, 0x0f, 0xc2, 0x76, 0xcf, 0xfe, 0x04, 0xd6, 0xc6, 0x3b, 0x57, 0xe8, 0x6f,
+	0xc0, 0x82, 0x06, 0xd1, 0x09, 0x3c, 0x9b, 0xfd, 0xd4, 0xa4, 0xf6, 0x6b, 0x01, 0xe6, 0x65, 0x00,
+	0xfc, 0x1c, 0x01, 0x9c, 0x2e, 0x1e, 0x5c, 0xc9, 0x7a, 0x19, 0xbf, 0xd3, 0xac, 0x6b, 0x06, 0xca,
+	0x94, 0xd6, 0xde, 0xfe, 0xf2, 0xb7, 0xbf, 0xbe, 0xce, 0x5d, 0xc5, 0x57, 0xdc, 0x09, 0x5b, 0x99,
+	0xbb, 0x47, 0xfd, 0x64, 0x3d, 0xc3, 0x3f, 0x20, 0x58, 0x1e, 0xde, 0x0b, 0xb8, 0x3a, 0x35, 0xd8,
+	0xc8, 0xe6, 0xb1, 0xb6, 0x0d, 0xd5, 0x0a, 0xef, 0x96, 0xc4, 0xdb, 0xc1, 0x8e, 0x11, 0x9e, 0xab,
+	0xb7, 0x0b, 0xfe, 0xa6, 0x9f, 0xba, 0x64, 0x11, 0x9c, 0x91, 0xba, 0x81, 0xe5, 0x63, 0x5d, 0x33,
+	0x50, 0x2a, 0xb6, 0x9a, 0x64, 0xab, 0xe2, 0xeb, 0x66, 0x6c, 0x72, 0xb7, 0xfc, 0x8c, 0x60, 0x65,
+	0x74, 0xc4, 0x63, 0x67, 0x6a, 0xcc, 0xcc, 0x8e, 0xb1, 0x5c, 0x63, 0xbd, 0x22, 0xbd, 0x2d, 0x49,
+	0x6b, 0x78, 0xc7, 0x8c, 0xf4, 0x74, 0x27, 0xe0, 0x1f, 0x11, 0x2c, 0x0d, 0x4d, 0x75, 0xbc, 0x35,
+	0x21, 0xf8, 0xb8, 0xad, 0x61, 0x55, 0xcd, 0xc4, 0x0a, 0xf3, 0x8e, 0xc4, 0xbc, 0x85, 0x6f, 0x9a,
+	0x61, 0x1e, 0x26, 0x4e, 0xea, 0x44, 0x83, 0x7d, 0x8f, 0xe0, 0x7f, 0x23, 0x63, 0x1e, 0x4f, 0xec,
+	0xb6, 0xb1, 0x7b, 0xc4, 0x72, 0x4c, 0xe5, 0x0a, 0xb8, 0x2a, 0x81, 0x37, 0xf1, 0xab, 0x59, 0x60,
+	0xbd, 0x1e, 0xdc, 0x23, 0x05, 0xf8, 0x0c, 0x7f, 0x8b, 0x60, 0x71, 0x60, 0x6e, 0xe2, 0xe9, 0xad,
+	0x36, 0xb8, 0x48, 0xac, 0xeb, 0x26, 0x52, 0x05, 0x75, 0x43, 0x42, 0x6d, 0xe3, 0x2d, 0xb3, 0x2c,
+	0xca, 0x89, 0x8d, 0xbf, 0x43, 0x50, 0x18, 0x70, 0xc6, 0xb1, 0x41, 0xc4, 0x7e, 0xda, 0xb6, 0x8c,
+	0xb4, 0x0a, 0xef, 0xa6, 0xc4, 0x73, 0x70, 0xf5, 0x1f, 0xe0, 0x71, 0xfc, 0x15, 0x82, 0xbc, 0x1e,
+	0x99, 0x78, 0x73, 0x42, 0xbc, 0x91, 0x6d, 0x63, 0x5d, 0x3d, 0x53, 0xa7, 0x98, 0x2a, 0x92, 0xc9,
+	0xc6, 0x65, 0x77, 0xe2, 0x6f, 0x0f, 0xee, 0x1e, 0x25, 0xf3, 0xef, 0xa7, 0xa4, 0xc9, 0x86, 0x07,
+	0x3f, 0x9e, 0x3e, 0xd2, 0x46, 0xb7, 0x8f, 0xe5, 0x98, 0xca, 0x15, 0xdc, 0x9e, 0x84, 0xdb, 0xc5,
+	0xae, 0x61, 0xc2, 0xb4, 0x83, 0x83, 0x77, 0x5f, 0x1c, 0x97, 0xd0, 0xcb, 0xe3, 0x12, 0xfa, 0xf3,
+	0xb8, 0x84, 0x9e, 0x9f, 0x94, 0x66, 0x5e, 0x9e, 0x94, 0x66, 0x7e, 0x3f, 0x29, 0xcd, 0x7c, 0x58,
+	0x6b, 0x06, 0xa2, 0xd5, 0x39, 0x74, 0x1a, 0xec, 0x89, 0x72, 0xba, 0x1d, 0x52, 0xf1, 0x19, 0x8b,
+	0x1f, 0xeb, 0x18, 0xdd, 0x5d, 0xf7, 0x73, 0x1d, 0x48, 0xf4, 0x22, 0xca, 0x0f, 0xcf, 0xc9, 0x1f,
+	0x52, 0x37, 0xfe, 0x1e, 0x00, 0x57, 0x19, 0xfb, 0xec, 0xee, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AspectMeta queries the metadata of an aspect.
+	AspectMeta(ctx context.Context, in *QueryAspectMetaRequest, opts ...grpc.CallOption) (*QueryAspectMetaResponse, error)
+	// AspectVersions queries all the deployed versions of an aspect.
+	AspectVersions(ctx context.Context, in *QueryAspectVersionsRequest, opts ...grpc.CallOption) (*QueryAspectVersionsResponse, error)
+	// AspectCode queries the code and code hash of a given version of an aspect.
+	AspectCode(ctx context.Context, in *QueryAspectCodeRequest, opts ...grpc.CallOption) (*QueryAspectCodeResponse, error)
+	// AspectProperties queries the properties of a given version of an aspect.
+	AspectProperties(ctx context.Context, in *QueryAspectPropertiesRequest, opts ...grpc.CallOption) (*QueryAspectPropertiesResponse, error)
+	// BoundAccounts queries the accounts bound to an aspect.
+	BoundAccounts(ctx context.Context, in *QueryBoundAccountsRequest, opts ...grpc.CallOption) (*QueryBoundAccountsResponse, error)
+	// AccountBindings queries the aspects bound to an account.
+	AccountBindings(ctx context.Context, in *QueryAccountBindingsRequest, opts ...grpc.CallOption) (*QueryAccountBindingsResponse, error)
+	// AspectState queries the value of a key in the state store of an aspect.
+	AspectState(ctx context.Context, in *QueryAspectStateRequest, opts ...grpc.CallOption) (*QueryAspectStateResponse, error)
+	// AspectStates queries all the states saved in the state store of an aspect.
+	AspectStates(ctx context.Context, in *QueryAspectStatesRequest, opts ...grpc.CallOption) (*QueryAspectStatesResponse, error)
+	// Schedule queries a schedule by id.
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
+	// AspectSchedules queries all the schedules registered for an aspect.
+	AspectSchedules(ctx context.Context, in *QueryAspectSchedulesRequest, opts ...grpc.CallOption) (*QueryAspectSchedulesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AspectMeta(ctx context.Context, in *QueryAspectMetaRequest, opts ...grpc.CallOption) (*QueryAspectMetaResponse, error) {
+	out := new(QueryAspectMetaResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectVersions(ctx context.Context, in *QueryAspectVersionsRequest, opts ...grpc.CallOption) (*QueryAspectVersionsResponse, error) {
+	out := new(QueryAspectVersionsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectCode(ctx context.Context, in *QueryAspectCodeRequest, opts ...grpc.CallOption) (*QueryAspectCodeResponse, error) {
+	out := new(QueryAspectCodeResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectProperties(ctx context.Context, in *QueryAspectPropertiesRequest, opts ...grpc.CallOption) (*QueryAspectPropertiesResponse, error) {
+	out := new(QueryAspectPropertiesResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectProperties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BoundAccounts(ctx context.Context, in *QueryBoundAccountsRequest, opts ...grpc.CallOption) (*QueryBoundAccountsResponse, error) {
+	out := new(QueryBoundAccountsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/BoundAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountBindings(ctx context.Context, in *QueryAccountBindingsRequest, opts ...grpc.CallOption) (*QueryAccountBindingsResponse, error) {
+	out := new(QueryAccountBindingsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AccountBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectState(ctx context.Context, in *QueryAspectStateRequest, opts ...grpc.CallOption) (*QueryAspectStateResponse, error) {
+	out := new(QueryAspectStateResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectStates(ctx context.Context, in *QueryAspectStatesRequest, opts ...grpc.CallOption) (*QueryAspectStatesResponse, error) {
+	out := new(QueryAspectStatesResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/Schedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectSchedules(ctx context.Context, in *QueryAspectSchedulesRequest, opts ...grpc.CallOption) (*QueryAspectSchedulesResponse, error) {
+	out := new(QueryAspectSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.v1.Query/AspectSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AspectMeta queries the metadata of an aspect.
+	AspectMeta(context.Context, *QueryAspectMetaRequest) (*QueryAspectMetaResponse, error)
+	// AspectVersions queries all the deployed versions of an aspect.
+	AspectVersions(context.Context, *QueryAspectVersionsRequest) (*QueryAspectVersionsResponse, error)
+	// AspectCode queries the code and code hash of a given version of an aspect.
+	AspectCode(context.Context, *QueryAspectCodeRequest) (*QueryAspectCodeResponse, error)
+	// AspectProperties queries the properties of a given version of an aspect.
+	AspectProperties(context.Context, *QueryAspectPropertiesRequest) (*QueryAspectPropertiesResponse, error)
+	// BoundAccounts queries the accounts bound to an aspect.
+	BoundAccounts(context.Context, *QueryBoundAccountsRequest) (*QueryBoundAccountsResponse, error)
+	// AccountBindings queries the aspects bound to an account.
+	AccountBindings(context.Context, *QueryAccountBindingsRequest) (*QueryAccountBindingsResponse, error)
+	// AspectState queries the value of a key in the state store of an aspect.
+	AspectState(context.Context, *QueryAspectStateRequest) (*QueryAspectStateResponse, error)
+	// AspectStates queries all the states saved in the state store of an aspect.
+	AspectStates(context.Context, *QueryAspectStatesRequest) (*QueryAspectStatesResponse, error)
+	// Schedule queries a schedule by id.
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
+	// AspectSchedules queries all the schedules registered for an aspect.
+	AspectSchedules(context.Context, *QueryAspectSchedulesRequest) (*QueryAspectSchedulesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AspectMeta(ctx context.Context, req *QueryAspectMetaRequest) (*QueryAspectMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectMeta not implemented")
+}
+func (*UnimplementedQueryServer) AspectVersions(ctx context.Context, req *QueryAspectVersionsRequest) (*QueryAspectVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectVersions not implemented")
+}
+func (*UnimplementedQueryServer) AspectCode(ctx context.Context, req *QueryAspectCodeRequest) (*QueryAspectCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectCode not implemented")
+}
+func (*UnimplementedQueryServer) AspectProperties(ctx context.Context, req *QueryAspectPropertiesRequest) (*QueryAspectPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectProperties not implemented")
+}
+func (*UnimplementedQueryServer) BoundAccounts(ctx context.Context, req *QueryBoundAccountsRequest) (*QueryBoundAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoundAccounts not implemented")
+}
+func (*UnimplementedQueryServer) AccountBindings(ctx context.Context, req *QueryAccountBindingsRequest) (*QueryAccountBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBindings not implemented")
+}
+func (*UnimplementedQueryServer) AspectState(ctx context.Context, req *QueryAspectStateRequest) (*QueryAspectStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectState not implemented")
+}
+func (*UnimplementedQueryServer) AspectStates(ctx context.Context, req *QueryAspectStatesRequest) (*QueryAspectStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectStates not implemented")
+}
+func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (*UnimplementedQueryServer) AspectSchedules(ctx context.Context, req *QueryAspectSchedulesRequest) (*QueryAspectSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectSchedules not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AspectMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectMeta(ctx, req.(*QueryAspectMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectVersions(ctx, req.(*QueryAspectVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectCode(ctx, req.(*QueryAspectCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectProperties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectProperties(ctx, req.(*QueryAspectPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BoundAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBoundAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BoundAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/BoundAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BoundAccounts(ctx, req.(*QueryBoundAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AccountBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountBindings(ctx, req.(*QueryAccountBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectState(ctx, req.(*QueryAspectStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectStates(ctx, req.(*QueryAspectStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/Schedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.v1.Query/AspectSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectSchedules(ctx, req.(*QueryAspectSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "artela.aspect.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AspectMeta",
+			Handler:    _Query_AspectMeta_Handler,
+		},
+		{
+			MethodName: "AspectVersions",
+			Handler:    _Query_AspectVersions_Handler,
+		},
+		{
+			MethodName: "AspectCode",
+			Handler:    _Query_AspectCode_Handler,
+		},
+		{
+			MethodName: "AspectProperties",
+			Handler:    _Query_AspectProperties_Handler,
+		},
+		{
+			MethodName: "BoundAccounts",
+			Handler:    _Query_BoundAccounts_Handler,
+		},
+		{
+			MethodName: "AccountBindings",
+			Handler:    _Query_AccountBindings_Handler,
+		},
+		{
+			MethodName: "AspectState",
+			Handler:    _Query_AspectState_Handler,
+		},
+		{
+			MethodName: "AspectStates",
+			Handler:    _Query_AspectStates_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "AspectSchedules",
+			Handler:    _Query_AspectSchedules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "artela/aspect/v1/query.proto",
+}
+
+func (m *QueryAspectMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StoreVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StoreVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatestVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PayMaster) > 0 {
+		i -= len(m.PayMaster)
+		copy(dAtA[i:], m.PayMaster)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PayMaster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectPropertiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectPropertiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectPropertiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectPropertiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectPropertiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectPropertiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBoundAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoundAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoundAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBoundAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoundAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoundAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAspectMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PayMaster)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovQuery(uint64(m.LatestVersion))
+	}
+	if m.StoreVersion != 0 {
+		n += 1 + sovQuery(uint64(m.StoreVersion))
+	}
+	return n
+}
+
+func (m *QueryAspectVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAspectCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryAspectCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectPropertiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryAspectPropertiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBoundAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBoundAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountBindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAspectStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAspectSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAspectSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAspectMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayMaster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayMaster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreVersion", wireType)
+			}
+			m.StoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, AspectVersionMeta{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectPropertiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectPropertiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectPropertiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectPropertiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectPropertiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectPropertiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, AspectProperty{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBoundAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoundAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoundAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBoundAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoundAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoundAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, AspectBinding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, AspectBinding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, AspectStateEntry{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
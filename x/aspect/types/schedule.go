@@ -0,0 +1,42 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// MaxScheduleGasLimit is the max gas limit of a scheduled call, it's no more than the gas the
+// chain spends on the scheduled calls of a block so that every schedule fits in a block.
+const MaxScheduleGasLimit uint64 = 10_000_000
+
+// Validate checks the schedule registered at the given height, with the block max gas of
+// the chain, a non-positive block max gas means no limit.
+func (s *Schedule) Validate(height int64, blockMaxGas int64) error {
+	if common.HexToAddress(s.AspectId) == (common.Address{}) {
+		return errors.New("aspect id not specified")
+	}
+	if common.HexToAddress(s.Target) == (common.Address{}) {
+		return errors.New("target not specified")
+	}
+
+	if s.GasLimit < params.TxGas {
+		return fmt.Errorf("gas limit too low, minimum %d", params.TxGas)
+	}
+	if s.GasLimit > MaxScheduleGasLimit {
+		return fmt.Errorf("gas limit too high, maximum %d", MaxScheduleGasLimit)
+	}
+	if blockMaxGas > 0 && s.GasLimit > uint64(blockMaxGas) {
+		return fmt.Errorf("gas limit exceeds block gas limit %d", blockMaxGas)
+	}
+
+	if s.NextHeight <= height {
+		return errors.New("start block must be in the future")
+	}
+	if s.Interval == 0 && s.Count > 1 {
+		return errors.New("interval not specified for repeated schedule")
+	}
+	return nil
+}
@@ -0,0 +1,1195 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: artela/aspect/v1/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleStatus defines the lifecycle status of a schedule.
+type ScheduleStatus int32
+
+const (
+	// SCHEDULE_STATUS_ACTIVE means the schedule is waiting for its next execution
+	ScheduleStatus_SCHEDULE_STATUS_ACTIVE ScheduleStatus = 0
+	// SCHEDULE_STATUS_FINISHED means all the executions of the schedule are done
+	ScheduleStatus_SCHEDULE_STATUS_FINISHED ScheduleStatus = 1
+	// SCHEDULE_STATUS_CANCELLED means the schedule has been cancelled by its creator
+	ScheduleStatus_SCHEDULE_STATUS_CANCELLED ScheduleStatus = 2
+)
+
+var ScheduleStatus_name = map[int32]string{
+	0: "SCHEDULE_STATUS_ACTIVE",
+	1: "SCHEDULE_STATUS_FINISHED",
+	2: "SCHEDULE_STATUS_CANCELLED",
+}
+
+var ScheduleStatus_value = map[string]int32{
+	"SCHEDULE_STATUS_ACTIVE":    0,
+	"SCHEDULE_STATUS_FINISHED":  1,
+	"SCHEDULE_STATUS_CANCELLED": 2,
+}
+
+func (x ScheduleStatus) String() string {
+	return proto.EnumName(ScheduleStatus_name, int32(x))
+}
+
+func (ScheduleStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_68a08c706938feab, []int{0}
+}
+
+// Schedule defines a call registered for an aspect, which is executed by the chain at
+// the scheduled blocks instead of being submitted as a transaction.
+type Schedule struct {
+	// id is the unique id of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// aspect_id is the hex address of the aspect the schedule is registered for
+	AspectId string `protobuf:"bytes,2,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// creator is the hex address of the account registered the schedule,
+	// only the creator can cancel the schedule
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// payer is the hex address of the account paying the gas fee of each execution,
+	// which is either the creator as the sponsor, or the aspect itself. The scheduled
+	// call is also sent from the payer.
+	Payer string `protobuf:"bytes,4,opt,name=payer,proto3" json:"payer,omitempty"`
+	// target is the hex address of the contract to call
+	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// calldata is the input of the call
+	Calldata []byte `protobuf:"bytes,6,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	// gas_limit is the gas limit of each execution
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// next_height is the height of the next execution
+	NextHeight int64 `protobuf:"varint,8,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// interval is the number of blocks between two executions, 0 for a one-off schedule
+	Interval uint64 `protobuf:"varint,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	// count is the max number of executions, 0 for unlimited if interval is set
+	Count uint64 `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+	// executed is the number of the executions done so far
+	Executed uint64 `protobuf:"varint,11,opt,name=executed,proto3" json:"executed,omitempty"`
+	// status is the lifecycle status of the schedule
+	Status ScheduleStatus `protobuf:"varint,12,opt,name=status,proto3,enum=artela.aspect.v1.ScheduleStatus" json:"status,omitempty"`
+	// last_result is the result of the last execution, nil if never executed
+	LastResult *ScheduleResult `protobuf:"bytes,13,opt,name=last_result,json=lastResult,proto3" json:"last_result,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a08c706938feab, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Schedule) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *Schedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Schedule) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *Schedule) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Schedule) GetCalldata() []byte {
+	if m != nil {
+		return m.Calldata
+	}
+	return nil
+}
+
+func (m *Schedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Schedule) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *Schedule) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Schedule) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Schedule) GetExecuted() uint64 {
+	if m != nil {
+		return m.Executed
+	}
+	return 0
+}
+
+func (m *Schedule) GetStatus() ScheduleStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ScheduleStatus_SCHEDULE_STATUS_ACTIVE
+}
+
+func (m *Schedule) GetLastResult() *ScheduleResult {
+	if m != nil {
+		return m.LastResult
+	}
+	return nil
+}
+
+// ScheduleResult defines the result of a schedule execution.
+type ScheduleResult struct {
+	// height is the height the execution happened at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the gas used by the execution
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// ret is the return data of the call
+	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
+	// error is the error message if the execution failed, empty if succeeded
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduleResult) Reset()         { *m = ScheduleResult{} }
+func (m *ScheduleResult) String() string { return proto.CompactTextString(m) }
+func (*ScheduleResult) ProtoMessage()    {}
+func (*ScheduleResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68a08c706938feab, []int{1}
+}
+func (m *ScheduleResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleResult.Merge(m, src)
+}
+func (m *ScheduleResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleResult proto.InternalMessageInfo
+
+func (m *ScheduleResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduleResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ScheduleResult) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *ScheduleResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("artela.aspect.v1.ScheduleStatus", ScheduleStatus_name, ScheduleStatus_value)
+	proto.RegisterType((*Schedule)(nil), "artela.aspect.v1.Schedule")
+	proto.RegisterType((*ScheduleResult)(nil), "artela.aspect.v1.ScheduleResult")
+}
+
+func init() { proto.RegisterFile("artela/aspect/v1/schedule.proto", fileDescriptor_68a08c706938feab) }
+
+var fileDescriptor_68a08c706938feab = []byte{
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6e, 0xda, 0x4e,
+	0x10, 0xc7, 0x59, 0x20, 0xfc, 0x59, 0xf8, 0x21, 0xb4, 0xfa, 0x29, 0xda, 0xa4, 0xad, 0x63, 0xe5,
+	0x64, 0x55, 0xaa, 0x51, 0xd2, 0x4b, 0xaf, 0x14, 0x5c, 0x81, 0x84, 0x72, 0xb0, 0xa1, 0x87, 0x5e,
+	0xac, 0x8d, 0xbd, 0x32, 0x56, 0x1c, 0x8c, 0x76, 0xc7, 0x94, 0xbc, 0x45, 0x1f, 0xa2, 0x0f, 0xd3,
+	0x63, 0x8e, 0x3d, 0x56, 0xf0, 0x22, 0xd5, 0xee, 0xda, 0x91, 0xc2, 0xa1, 0xb7, 0xfd, 0xcc, 0x7c,
+	0x67, 0x35, 0xf3, 0x9d, 0xc1, 0x57, 0x4c, 0x00, 0xcf, 0xd8, 0x88, 0xc9, 0x2d, 0x8f, 0x60, 0xb4,
+	0xbb, 0x19, 0xc9, 0x68, 0xcd, 0xe3, 0x22, 0xe3, 0xee, 0x56, 0xe4, 0x90, 0x93, 0xa1, 0x11, 0xb8,
+	0x46, 0xe0, 0xee, 0x6e, 0xae, 0x7f, 0x36, 0x70, 0x27, 0x28, 0x45, 0x64, 0x80, 0xeb, 0x69, 0x4c,
+	0x91, 0x8d, 0x9c, 0xa6, 0x5f, 0x4f, 0x63, 0xf2, 0x06, 0x77, 0x8d, 0x32, 0x4c, 0x63, 0x5a, 0xb7,
+	0x91, 0xd3, 0xf5, 0x3b, 0x26, 0x30, 0x8f, 0x09, 0xc5, 0xed, 0x48, 0x70, 0x06, 0xb9, 0xa0, 0x0d,
+	0x9d, 0xaa, 0x90, 0xfc, 0x8f, 0xcf, 0xb6, 0xec, 0x89, 0x0b, 0xda, 0xd4, 0x71, 0x03, 0xe4, 0x1c,
+	0xb7, 0x80, 0x89, 0x84, 0x03, 0x3d, 0xd3, 0xe1, 0x92, 0xc8, 0x25, 0xee, 0x44, 0x2c, 0xcb, 0x62,
+	0x06, 0x8c, 0xb6, 0x6c, 0xe4, 0xf4, 0xfd, 0x17, 0x56, 0x0d, 0x24, 0x4c, 0x86, 0x59, 0xfa, 0x98,
+	0x02, 0x6d, 0xeb, 0xbe, 0x3a, 0x09, 0x93, 0x0b, 0xc5, 0xe4, 0x0a, 0xf7, 0x36, 0x7c, 0x0f, 0xe1,
+	0x9a, 0xa7, 0xc9, 0x1a, 0x68, 0xc7, 0x46, 0x4e, 0xc3, 0xc7, 0x2a, 0x34, 0xd3, 0x11, 0xf5, 0x73,
+	0xba, 0x01, 0x2e, 0x76, 0x2c, 0xa3, 0x5d, 0x53, 0x5c, 0xb1, 0xea, 0x31, 0xca, 0x8b, 0x0d, 0x50,
+	0xac, 0x13, 0x06, 0x54, 0x05, 0xdf, 0xf3, 0xa8, 0x00, 0x1e, 0xd3, 0x9e, 0xa9, 0xa8, 0x98, 0x7c,
+	0xc2, 0x2d, 0x09, 0x0c, 0x0a, 0x49, 0xfb, 0x36, 0x72, 0x06, 0xb7, 0xb6, 0x7b, 0x6a, 0xa6, 0x5b,
+	0x19, 0x19, 0x68, 0x9d, 0x5f, 0xea, 0xc9, 0x18, 0xf7, 0x32, 0x26, 0x21, 0x14, 0x5c, 0x16, 0x19,
+	0xd0, 0xff, 0x6c, 0xe4, 0xf4, 0xfe, 0x55, 0xee, 0x6b, 0x9d, 0x8f, 0x55, 0x91, 0x79, 0x5f, 0x3f,
+	0xe0, 0xc1, 0xeb, 0xac, 0xb2, 0xb3, 0x1c, 0x1c, 0xe9, 0xc1, 0x4b, 0x22, 0x17, 0x58, 0x39, 0x14,
+	0x16, 0x92, 0x9b, 0x95, 0x35, 0xfd, 0x76, 0xc2, 0xe4, 0x4a, 0xf2, 0x98, 0x0c, 0x71, 0x43, 0x70,
+	0xd0, 0xdb, 0xea, 0xfb, 0xea, 0xa9, 0x5c, 0xe0, 0x42, 0xe4, 0x2f, 0x9b, 0xd2, 0xf0, 0x3e, 0xc5,
+	0x83, 0xd7, 0x93, 0x90, 0x4b, 0x7c, 0x1e, 0x4c, 0x66, 0xde, 0x74, 0xb5, 0xf0, 0xc2, 0x60, 0x39,
+	0x5e, 0xae, 0x82, 0x70, 0x3c, 0x59, 0xce, 0xbf, 0x7a, 0xc3, 0x1a, 0x79, 0x8b, 0xe9, 0x69, 0xee,
+	0xcb, 0xfc, 0x6e, 0x1e, 0xcc, 0xbc, 0xe9, 0x10, 0x91, 0x77, 0xf8, 0xe2, 0x34, 0x3b, 0x19, 0xdf,
+	0x4d, 0xbc, 0xc5, 0xc2, 0x9b, 0x0e, 0xeb, 0x9f, 0x17, 0xbf, 0x0e, 0x16, 0x7a, 0x3e, 0x58, 0xe8,
+	0xcf, 0xc1, 0x42, 0x3f, 0x8e, 0x56, 0xed, 0xf9, 0x68, 0xd5, 0x7e, 0x1f, 0xad, 0xda, 0xb7, 0xdb,
+	0x24, 0x85, 0x75, 0x71, 0xef, 0x46, 0xf9, 0xe3, 0xc8, 0x38, 0xf5, 0x61, 0xc3, 0xe1, 0x7b, 0x2e,
+	0x1e, 0x4a, 0x54, 0xe7, 0xbd, 0xaf, 0x4e, 0x1d, 0x9e, 0xb6, 0x5c, 0xde, 0xb7, 0xf4, 0x95, 0x7f,
+	0xfc, 0x3b, 0x00, 0x69, 0xc0, 0xf1, 0x83, 0x08, 0x03, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastResult != nil {
+		{
+			size, err := m.LastResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedule(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Executed != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Executed))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Count != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Interval != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Calldata) > 0 {
+		i -= len(m.Calldata)
+		copy(dAtA[i:], m.Calldata)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Calldata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Calldata)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.GasLimit))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.NextHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovSchedule(uint64(m.Interval))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSchedule(uint64(m.Count))
+	}
+	if m.Executed != 0 {
+		n += 1 + sovSchedule(uint64(m.Executed))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSchedule(uint64(m.Status))
+	}
+	if m.LastResult != nil {
+		l = m.LastResult.Size()
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSchedule(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovSchedule(uint64(m.GasUsed))
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calldata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calldata = append(m.Calldata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Calldata == nil {
+				m.Calldata = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			m.Executed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ScheduleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastResult == nil {
+				m.LastResult = &ScheduleResult{}
+			}
+			if err := m.LastResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	valid := func() *Schedule {
+		return &Schedule{
+			AspectId:   "0x0000000000000000000000000000000000000001",
+			Target:     "0x0000000000000000000000000000000000000002",
+			GasLimit:   100000,
+			NextHeight: 11,
+			Interval:   5,
+			Count:      3,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(s *Schedule)
+		blockMaxGas int64
+		expErr      string
+	}{
+		{"valid", func(s *Schedule) {}, 0, ""},
+		{"valid one-off", func(s *Schedule) { s.Interval, s.Count = 0, 1 }, 0, ""},
+		{"no aspect", func(s *Schedule) { s.AspectId = "" }, 0, "aspect id not specified"},
+		{"no target", func(s *Schedule) { s.Target = "" }, 0, "target not specified"},
+		{"gas too low", func(s *Schedule) { s.GasLimit = 20999 }, 0, "gas limit too low"},
+		{"gas too high", func(s *Schedule) { s.GasLimit = MaxScheduleGasLimit + 1 }, 0, "gas limit too high"},
+		{"gas exceeds block", func(s *Schedule) {}, 50000, "exceeds block gas limit"},
+		{"start in the past", func(s *Schedule) { s.NextHeight = 10 }, 0, "start block must be in the future"},
+		{"repeat without interval", func(s *Schedule) { s.Interval = 0 }, 0, "interval not specified"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := valid()
+			tc.malleate(schedule)
+			err := schedule.Validate(10, tc.blockMaxGas)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+)
+
+// DiffAspectStates returns the changes from one state dump to another, ordered by key.
+func DiffAspectStates(from, to []AspectStateEntry) []*AspectStateChange {
+	// the dumps are usually sorted by the state store already,
+	// sort the copies anyway as the diff walks both of them in key order
+	from, to = sortedAspectStates(from), sortedAspectStates(to)
+
+	changes := make([]*AspectStateChange, 0)
+
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case j == len(to) || (i < len(from) && bytes.Compare(from[i].Key, to[j].Key) < 0):
+			// the key has been deleted
+			changes = append(changes, &AspectStateChange{Key: from[i].Key, FromValue: from[i].Value})
+			i++
+		case i == len(from) || bytes.Compare(from[i].Key, to[j].Key) > 0:
+			// the key has been created
+			changes = append(changes, &AspectStateChange{Key: to[j].Key, ToValue: to[j].Value})
+			j++
+		default:
+			if !bytes.Equal(from[i].Value, to[j].Value) {
+				changes = append(changes, &AspectStateChange{Key: from[i].Key, FromValue: from[i].Value, ToValue: to[j].Value})
+			}
+			i++
+			j++
+		}
+	}
+
+	return changes
+}
+
+// sortedAspectStates returns a copy of the states sorted by key.
+func sortedAspectStates(states []AspectStateEntry) []AspectStateEntry {
+	sorted := make([]AspectStateEntry, len(states))
+	copy(sorted, states)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Key, sorted[j].Key) < 0
+	})
+	return sorted
+}
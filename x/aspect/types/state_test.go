@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffAspectStates(t *testing.T) {
+	from := []AspectStateEntry{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("c"), Value: []byte("3")},
+	}
+	to := []AspectStateEntry{
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("c"), Value: []byte("4")},
+		{Key: []byte("d"), Value: []byte("5")},
+	}
+	expected := []*AspectStateChange{
+		{Key: []byte("a"), FromValue: []byte("1")},
+		{Key: []byte("c"), FromValue: []byte("3"), ToValue: []byte("4")},
+		{Key: []byte("d"), ToValue: []byte("5")},
+	}
+
+	require.Equal(t, expected, DiffAspectStates(from, to))
+	require.Empty(t, DiffAspectStates(from, from))
+	require.Empty(t, DiffAspectStates(nil, nil))
+
+	// unsorted dumps give the same result
+	unsortedFrom := []AspectStateEntry{from[2], from[0], from[1]}
+	unsortedTo := []AspectStateEntry{to[1], to[2], to[0]}
+	require.Equal(t, expected, DiffAspectStates(unsortedFrom, unsortedTo))
+	// and the inputs are not modified
+	require.Equal(t, []byte("c"), unsortedFrom[0].Key)
+	require.Equal(t, []byte("c"), unsortedTo[0].Key)
+
+	reverse := DiffAspectStates(to, from)
+	require.Len(t, reverse, 3)
+	require.Equal(t, &AspectStateChange{Key: []byte("a"), ToValue: []byte("1")}, reverse[0])
+	require.Equal(t, &AspectStateChange{Key: []byte("d"), FromValue: []byte("5")}, reverse[2])
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/emirpasic/gods/sets/hashset"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// maxAspectEventTopics is the max number of topics of an aspect event, same as LOG4
+const maxAspectEventTopics = 4
+
+var eventJoinPointConstraints = hashset.New(
+	asptypes.PRE_CONTRACT_CALL_METHOD,
+	asptypes.POST_CONTRACT_CALL_METHOD,
+	asptypes.PRE_TX_EXECUTE_METHOD,
+	asptypes.POST_TX_EXECUTE_METHOD,
+)
+
+// emit adds a log with the aspect id as the address to the state db of the current transaction, which
+// ends up in the receipt of the transaction like the logs emitted by contracts. Gas is charged the same
+// as the LOG opcodes.
+func (a *aspectHostAPI) emit(ctx *asptypes.RunnerContext, args []interface{}) ([]interface{}, error) {
+	rawTopics, data := args[0].([][32]byte), args[1].([]byte)
+	if len(rawTopics) > maxAspectEventTopics {
+		return nil, fmt.Errorf("too many aspect event topics, max %d", maxAspectEventTopics)
+	}
+
+	gas := params.LogGas + params.LogTopicGas*uint64(len(rawTopics)) + params.LogDataGas*uint64(len(data))
+	if ctx.Gas < gas {
+		ctx.Gas = 0
+		return nil, vm.ErrOutOfGas
+	}
+	ctx.Gas -= gas
+
+	txContext := a.aspectRuntimeContext.EthTxContext()
+	if txContext == nil || txContext.VmStateDB() == nil {
+		return nil, errors.New("cannot emit aspect event without transaction")
+	}
+
+	topics := make([]common.Hash, len(rawTopics))
+	for i, topic := range rawTopics {
+		topics[i] = topic
+	}
+	txContext.VmStateDB().AddLog(&ethereum.Log{
+		Address:     ctx.AspectId,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockNumber),
+	})
+	return nil, nil
+}
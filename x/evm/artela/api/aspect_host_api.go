@@ -0,0 +1,207 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/emirpasic/gods/sets/hashset"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela/x/aspect/store"
+	v0 "github.com/artela-network/artela/x/aspect/store/v0"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+	"github.com/artela-network/artela/x/evm/artela/types"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// AspectHostAddress is the reserved address of the aspect host. The static calls made by aspects to
+// this address are not executed by the evm, but dispatched to the native host functions with the
+// calling aspect as the caller. The input is abi encoded like a contract call, the supported methods are:
+//
+//	schedule(address target, bytes data, uint64 gasLimit, uint64 startBlock, uint64 interval, uint64 count) returns (uint64 scheduleId)
+//	cancelSchedule(uint64 scheduleId)
+//	emit(bytes32[] topics, bytes data)
+var AspectHostAddress = common.HexToAddress("0x0000000000000000000000000000000000A27E15")
+
+type aspectHostMethod struct {
+	method     abi.Method
+	joinPoints *hashset.Set
+	call       func(a *aspectHostAPI, ctx *asptypes.RunnerContext, args []interface{}) ([]interface{}, error)
+}
+
+var (
+	scheduleJoinPointConstraints = hashset.New(
+		asptypes.PRE_CONTRACT_CALL_METHOD,
+		asptypes.POST_CONTRACT_CALL_METHOD,
+		asptypes.PRE_TX_EXECUTE_METHOD,
+		asptypes.POST_TX_EXECUTE_METHOD,
+		asptypes.OPERATION_METHOD,
+		asptypes.INIT_METHOD,
+		aspectmoduletypes.ON_BLOCK_INITIALIZE_METHOD,
+		aspectmoduletypes.ON_BLOCK_FINALIZE_METHOD,
+	)
+
+	aspectHostMethods = newAspectHostMethods(
+		aspectHostMethod{
+			method: newAspectHostABIMethod("schedule", abi.Arguments{
+				{Name: "target", Type: abiType("address")},
+				{Name: "data", Type: abiType("bytes")},
+				{Name: "gasLimit", Type: abiType("uint64")},
+				{Name: "startBlock", Type: abiType("uint64")},
+				{Name: "interval", Type: abiType("uint64")},
+				{Name: "count", Type: abiType("uint64")},
+			}, abi.Arguments{
+				{Name: "scheduleId", Type: abiType("uint64")},
+			}),
+			joinPoints: scheduleJoinPointConstraints,
+			call:       (*aspectHostAPI).schedule,
+		},
+		aspectHostMethod{
+			method: newAspectHostABIMethod("cancelSchedule", abi.Arguments{
+				{Name: "scheduleId", Type: abiType("uint64")},
+			}, nil),
+			joinPoints: scheduleJoinPointConstraints,
+			call:       (*aspectHostAPI).cancelSchedule,
+		},
+		aspectHostMethod{
+			method: newAspectHostABIMethod("emit", abi.Arguments{
+				{Name: "topics", Type: abiType("bytes32[]")},
+				{Name: "data", Type: abiType("bytes")},
+			}, nil),
+			joinPoints: eventJoinPointConstraints,
+			call:       (*aspectHostAPI).emit,
+		},
+	)
+)
+
+type aspectHostAPI struct {
+	aspectRuntimeContext *types.AspectRuntimeContext
+}
+
+// Call dispatches the abi encoded call to the host function. Failures of the host function are
+// reported as a reverted call except running out of gas, the gas of the host function is charged
+// from the aspect gas before any change is made.
+func (a *aspectHostAPI) Call(ctx *asptypes.RunnerContext, data []byte) (*asptypes.StaticCallResult, error) {
+	if len(data) < 4 {
+		return nil, errors.New("invalid aspect host call")
+	}
+	hostMethod, ok := aspectHostMethods[string(data[:4])]
+	if !ok {
+		return nil, errors.New("unknown aspect host method")
+	}
+
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "aspectHost."+hostMethod.method.Name)
+	if !hostMethod.joinPoints.Contains(asptypes.PointCut(ctx.Point)) {
+		return nil, fmt.Errorf("cannot call %s in current join point", hostMethod.method.Name)
+	}
+
+	var (
+		ret    []byte
+		errStr string
+	)
+	args, err := hostMethod.method.Inputs.Unpack(data[4:])
+	if err == nil {
+		var outputs []interface{}
+		if outputs, err = hostMethod.call(a, ctx, args); err == nil {
+			ret, err = hostMethod.method.Outputs.Pack(outputs...)
+		}
+	}
+	if errors.Is(err, vm.ErrOutOfGas) {
+		return nil, err
+	} else if err != nil {
+		errStr = err.Error()
+		ret = nil
+	}
+
+	return &asptypes.StaticCallResult{
+		Ret:     ret,
+		GasLeft: &ctx.Gas,
+		VmError: &errStr,
+	}, nil
+}
+
+// schedule registers a schedule for the calling aspect, the scheduled call is paid by and sent from
+// the aspect itself. Only the aspect can cancel its schedules registered through the host.
+func (a *aspectHostAPI) schedule(ctx *asptypes.RunnerContext, args []interface{}) ([]interface{}, error) {
+	cosmosCtx := a.aspectRuntimeContext.CosmosContext()
+	schedule := &aspectmoduletypes.Schedule{
+		AspectId:   ctx.AspectId.Hex(),
+		Creator:    ctx.AspectId.Hex(),
+		Payer:      ctx.AspectId.Hex(),
+		Target:     args[0].(common.Address).Hex(),
+		Calldata:   args[1].([]byte),
+		GasLimit:   args[2].(uint64),
+		NextHeight: int64(args[3].(uint64)),
+		Interval:   args[4].(uint64),
+		Count:      args[5].(uint64),
+		Status:     aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	}
+	if err := schedule.Validate(cosmosCtx.BlockHeight(), cosmosCtx.ConsensusParams().GetBlock().GetMaxGas()); err != nil {
+		return nil, err
+	}
+
+	storeCtx := a.storeContext(ctx)
+	if err := v0.NewGasMeter(storeCtx).MeasureStorageStore(schedule.Size()); err != nil {
+		ctx.Gas = 0
+		return nil, err
+	}
+	ctx.Gas = storeCtx.Gas()
+
+	if err := store.RegisterSchedule(storeCtx, schedule); err != nil {
+		return nil, err
+	}
+	return []interface{}{schedule.Id}, nil
+}
+
+// cancelSchedule cancels the schedule registered by the calling aspect through the host.
+func (a *aspectHostAPI) cancelSchedule(ctx *asptypes.RunnerContext, args []interface{}) ([]interface{}, error) {
+	storeCtx := a.storeContext(ctx)
+	schedule, err := store.LoadSchedule(storeCtx, args[0].(uint64))
+	if err != nil {
+		return nil, err
+	} else if schedule == nil {
+		return nil, errors.New("schedule not found")
+	}
+
+	if err := v0.NewGasMeter(storeCtx).MeasureStorageUpdate(schedule.Size()); err != nil {
+		ctx.Gas = 0
+		return nil, err
+	}
+	ctx.Gas = storeCtx.Gas()
+
+	if _, err := store.CancelSchedule(storeCtx, ctx.AspectId, schedule.Id); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (a *aspectHostAPI) storeContext(ctx *asptypes.RunnerContext) aspectmoduletypes.StoreContext {
+	return aspectmoduletypes.NewStoreContext(a.aspectRuntimeContext.CosmosContext(),
+		a.aspectRuntimeContext.AspectStoreKey(), a.aspectRuntimeContext.EVMStoreKey(), ctx.Gas)
+}
+
+// aspectHostMethodSet indexes the host methods by the method id.
+type aspectHostMethodSet map[string]aspectHostMethod
+
+func newAspectHostMethods(methods ...aspectHostMethod) aspectHostMethodSet {
+	set := make(aspectHostMethodSet, len(methods))
+	for _, method := range methods {
+		set[string(method.method.ID)] = method
+	}
+	return set
+}
+
+func newAspectHostABIMethod(name string, inputs, outputs abi.Arguments) abi.Method {
+	return abi.NewMethod(name, name, abi.Function, "view", false, false, inputs, outputs)
+}
+
+func abiType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosstore "github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+	"github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+func newTestHostAPI(t *testing.T) (*evmHostApi, aspectmoduletypes.StoreContext) {
+	aspectKey := storetypes.NewKVStoreKey(aspectmoduletypes.StoreKey)
+	evmKey := storetypes.NewKVStoreKey("evm")
+
+	db := dbm.NewMemDB()
+	cms := cosmosstore.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(aspectKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	types.InitStoreKeys(evmKey, aspectKey)
+
+	ctx := cosmos.NewContext(cms, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	aspectCtx := types.NewAspectRuntimeContext()
+	aspectCtx.WithCosmosContext(ctx)
+
+	return &evmHostApi{aspectCtx}, aspectmoduletypes.NewGasFreeStoreContext(ctx, aspectKey, evmKey)
+}
+
+func hostCall(t *testing.T, method string, args ...interface{}) *asptypes.StaticCallRequest {
+	var hostMethod abi.Method
+	for _, m := range aspectHostMethods {
+		if m.method.Name == method {
+			hostMethod = m.method
+		}
+	}
+	input, err := hostMethod.Inputs.Pack(args...)
+	require.NoError(t, err)
+
+	gas := uint64(0)
+	return &asptypes.StaticCallRequest{
+		To:   AspectHostAddress.Bytes(),
+		Data: append(common.CopyBytes(hostMethod.ID), input...),
+		Gas:  &gas,
+	}
+}
+
+func TestAspectHostSchedule(t *testing.T) {
+	hostAPI, storeCtx := newTestHostAPI(t)
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	target := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	runnerCtx := &asptypes.RunnerContext{
+		AspectId: aspectID,
+		Point:    string(asptypes.POST_TX_EXECUTE_METHOD),
+		Gas:      1_000_000,
+	}
+	res, err := hostAPI.StaticCall(runnerCtx, hostCall(t, "schedule", target, []byte{0x01}, uint64(100000),
+		uint64(20), uint64(5), uint64(3)))
+	require.NoError(t, err)
+	require.Empty(t, *res.VmError)
+	require.Less(t, runnerCtx.Gas, uint64(1_000_000))
+	require.Equal(t, runnerCtx.Gas, *res.GasLeft)
+
+	// the schedule is created and paid by the aspect
+	schedule, err := store.LoadSchedule(storeCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, aspectID.Hex(), schedule.AspectId)
+	require.Equal(t, aspectID.Hex(), schedule.Creator)
+	require.Equal(t, aspectID.Hex(), schedule.Payer)
+	require.Equal(t, target.Hex(), schedule.Target)
+	require.Equal(t, int64(20), schedule.NextHeight)
+	require.Equal(t, []store.ScheduleEntry{{Height: 20, ID: 1}}, store.LoadDueSchedules(storeCtx, 20, 10))
+
+	// invalid schedule reverts
+	res, err = hostAPI.StaticCall(runnerCtx, hostCall(t, "schedule", target, []byte{}, uint64(100000),
+		uint64(5), uint64(0), uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, "start block must be in the future", *res.VmError)
+
+	// other aspects cannot cancel the schedule
+	otherCtx := &asptypes.RunnerContext{
+		AspectId: target,
+		Point:    string(asptypes.POST_TX_EXECUTE_METHOD),
+		Gas:      1_000_000,
+	}
+	res, err = hostAPI.StaticCall(otherCtx, hostCall(t, "cancelSchedule", uint64(1)))
+	require.NoError(t, err)
+	require.Equal(t, "unauthorized operation", *res.VmError)
+
+	res, err = hostAPI.StaticCall(runnerCtx, hostCall(t, "cancelSchedule", uint64(1)))
+	require.NoError(t, err)
+	require.Empty(t, *res.VmError)
+	schedule, err = store.LoadSchedule(storeCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_CANCELLED, schedule.Status)
+	require.Empty(t, store.LoadDueSchedules(storeCtx, 20, 10))
+}
+
+func TestAspectHostScheduleConstraints(t *testing.T) {
+	hostAPI, storeCtx := newTestHostAPI(t)
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	target := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	request := hostCall(t, "schedule", target, []byte{}, uint64(100000), uint64(20), uint64(0), uint64(1))
+
+	// not allowed in the verification
+	_, err := hostAPI.StaticCall(&asptypes.RunnerContext{
+		AspectId: aspectID,
+		Point:    string(asptypes.VERIFY_TX),
+		Gas:      1_000_000,
+	}, request)
+	require.Error(t, err)
+
+	// out of gas aborts the aspect, nothing is stored
+	_, err = hostAPI.StaticCall(&asptypes.RunnerContext{
+		AspectId: aspectID,
+		Point:    string(asptypes.PRE_TX_EXECUTE_METHOD),
+		Gas:      100,
+	}, request)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+	require.Empty(t, store.LoadDueSchedules(storeCtx, 20, 10))
+
+	// unknown method
+	_, err = hostAPI.StaticCall(&asptypes.RunnerContext{
+		AspectId: aspectID,
+		Point:    string(asptypes.PRE_TX_EXECUTE_METHOD),
+		Gas:      1_000_000,
+	}, &asptypes.StaticCallRequest{To: AspectHostAddress.Bytes(), Data: []byte{1, 2, 3, 4}})
+	require.Error(t, err)
+}
+
+func TestAspectHostEmit(t *testing.T) {
+	hostAPI, _ := newTestHostAPI(t)
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	topic := common.HexToHash("0x01")
+	request := hostCall(t, "emit", [][32]byte{topic}, []byte("data"))
+
+	// no transaction to emit to
+	runnerCtx := &asptypes.RunnerContext{
+		AspectId: aspectID,
+		Point:    string(asptypes.POST_TX_EXECUTE_METHOD),
+		Gas:      1_000_000,
+	}
+	res, err := hostAPI.StaticCall(runnerCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, "cannot emit aspect event without transaction", *res.VmError)
+
+	stateDB := states.New(hostAPI.aspectCtx.CosmosContext(), nil, states.TxConfig{})
+	hostAPI.aspectCtx.SetEthTxContext(types.NewEthTxContext(nil).WithStateDB(stateDB), nil)
+
+	runnerCtx.Gas = 1_000_000
+	res, err = hostAPI.StaticCall(runnerCtx, request)
+	require.NoError(t, err)
+	require.Empty(t, *res.VmError)
+	require.Equal(t, uint64(1_000_000-375-375-8*4), runnerCtx.Gas)
+
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, aspectID, logs[0].Address)
+	require.Equal(t, []common.Hash{topic}, logs[0].Topics)
+	require.Equal(t, []byte("data"), logs[0].Data)
+
+	// at most 4 topics
+	res, err = hostAPI.StaticCall(runnerCtx, hostCall(t, "emit", make([][32]byte, 5), []byte{}))
+	require.NoError(t, err)
+	require.Contains(t, *res.VmError, "too many aspect event topics")
+
+	// not allowed in block level join points
+	_, err = hostAPI.StaticCall(&asptypes.RunnerContext{
+		AspectId: aspectID,
+		Point:    string(aspectmoduletypes.ON_BLOCK_INITIALIZE_METHOD),
+		Gas:      1_000_000,
+	}, request)
+	require.Error(t, err)
+	require.Len(t, stateDB.Logs(), 1)
+}
@@ -14,6 +14,7 @@ type aspectPropertyHostAPI struct {
 }
 
 func (a *aspectPropertyHostAPI) Get(ctx *asptypes.RunnerContext, key string) (ret []byte, err error) {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "aspectProperty.get")
 	ret = a.aspectRuntimeContext.GetAspectProperty(ctx, ctx.AspectVersion, key)
 	return
 }
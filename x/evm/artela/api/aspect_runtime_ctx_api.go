@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
 	"github.com/artela-network/artela/x/evm/artela/api/datactx"
 	"github.com/artela-network/artela/x/evm/artela/types"
 	aspctx "github.com/artela-network/aspect-core/context"
@@ -25,6 +26,9 @@ var (
 		asptypes.POST_TX_EXECUTE_METHOD:    hashset.New(aspctx.PostTxCtxKeys...),
 		asptypes.PRE_CONTRACT_CALL_METHOD:  hashset.New(aspctx.PreCallCtxKeys...),
 		asptypes.POST_CONTRACT_CALL_METHOD: hashset.New(aspctx.PostCallCtxKeys...),
+
+		aspectmoduletypes.ON_BLOCK_INITIALIZE_METHOD: hashset.New(datactx.BlockInitializeKeys...),
+		aspectmoduletypes.ON_BLOCK_FINALIZE_METHOD:   hashset.New(datactx.BlockFinalizeKeys...),
 	}
 )
 
@@ -93,6 +97,8 @@ func (a *aspectRuntimeContextHostAPI) Register() {
 	a.execMap[aspctx.BlockHeaderMiner] = blockCtx.ValueLoader(aspctx.BlockHeaderMiner)
 	a.execMap[aspctx.BlockHeaderNumber] = blockCtx.ValueLoader(aspctx.BlockHeaderNumber)
 	a.execMap[aspctx.BlockHeaderTimestamp] = blockCtx.ValueLoader(aspctx.BlockHeaderTimestamp)
+	a.execMap[datactx.BlockHeaderTxHash] = blockCtx.ValueLoader(datactx.BlockHeaderTxHash)
+	a.execMap[datactx.BlockGasUsed] = blockCtx.ValueLoader(datactx.BlockGasUsed)
 
 	// env contexts
 	envCtx := datactx.NewEnvContext(a.aspectRuntimeContext, evmKeeper)
@@ -145,6 +151,7 @@ func (a *aspectRuntimeContextHostAPI) Register() {
 }
 
 func (a *aspectRuntimeContextHostAPI) Get(ctx *asptypes.RunnerContext, key string) ([]byte, error) {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "runtimeContext.get:"+key)
 	joinPointCtxKeyConstraints, ok := ctxKeyConstraints[asptypes.PointCut(ctx.Point)]
 	if !ok || !joinPointCtxKeyConstraints.Contains(key) {
 		return nil, fmt.Errorf("key %s is not available at join point %s", key, ctx.Point)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/emirpasic/gods/sets/hashset"
 
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
 	"github.com/artela-network/artela/x/evm/artela/types"
 	asptypes "github.com/artela-network/aspect-core/types"
 )
@@ -19,6 +20,8 @@ var (
 		asptypes.POST_TX_EXECUTE_METHOD,
 		asptypes.OPERATION_METHOD,
 		asptypes.INIT_METHOD,
+		aspectmoduletypes.ON_BLOCK_INITIALIZE_METHOD,
+		aspectmoduletypes.ON_BLOCK_FINALIZE_METHOD,
 	)
 )
 
@@ -27,10 +30,12 @@ type aspectStateHostAPI struct {
 }
 
 func (a *aspectStateHostAPI) Get(ctx *asptypes.RunnerContext, key string) []byte {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "aspectState.get")
 	return a.aspectRuntimeContext.GetAspectState(ctx, key)
 }
 
 func (a *aspectStateHostAPI) Set(ctx *asptypes.RunnerContext, key string, value []byte) error {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "aspectState.set")
 	if !stateJoinPointConstraints.Contains(asptypes.PointCut(ctx.Point)) {
 		return errors.New("cannot set aspect state in current join point")
 	}
@@ -29,6 +29,7 @@ type aspectTransientStorageHostAPI struct {
 }
 
 func (a *aspectTransientStorageHostAPI) Get(ctx *asptypes.RunnerContext, aspectId []byte, key string) ([]byte, error) {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "aspectTransientStorage.get")
 	if !transientStorageConstrainedJoinPoints.Contains(asptypes.PointCut(ctx.Point)) {
 		return nil, errors.New("cannot get aspect transient storage in current join point")
 	}
@@ -44,6 +45,7 @@ func (a *aspectTransientStorageHostAPI) Get(ctx *asptypes.RunnerContext, aspectI
 }
 
 func (a *aspectTransientStorageHostAPI) Set(ctx *asptypes.RunnerContext, key string, value []byte) error {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "aspectTransientStorage.set")
 	if !transientStorageConstrainedJoinPoints.Contains(asptypes.PointCut(ctx.Point)) {
 		return errors.New("cannot set aspect transient storage in current join point")
 	}
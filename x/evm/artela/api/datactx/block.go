@@ -2,6 +2,7 @@ package datactx
 
 import (
 	"errors"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 
@@ -10,6 +11,36 @@ import (
 	artelatypes "github.com/artela-network/aspect-core/types"
 )
 
+// Block level runtime context keys, these keys are only available at the block level join points
+const (
+	BlockHeaderTxHash = "block.header.txHash"
+	BlockGasUsed      = "block.gasUsed"
+)
+
+// BlockInitializeKeys are the runtime context keys available at the onBlockInitialize join point
+var BlockInitializeKeys = append(blockLevelKeys(),
+	BlockHeaderTxHash,
+)
+
+// BlockFinalizeKeys are the runtime context keys available at the onBlockFinalize join point
+var BlockFinalizeKeys = append(blockLevelKeys(),
+	BlockHeaderTxHash,
+	BlockGasUsed,
+)
+
+// blockLevelKeys returns the keys shared by all the block level join points, which are the
+// keys of the operation join point excluding all the tx and msg related ones.
+func blockLevelKeys() []interface{} {
+	keys := make([]interface{}, 0, len(context.OperationKeys))
+	for _, key := range context.OperationKeys {
+		if name := key.(string); strings.HasPrefix(name, "tx.") || strings.HasPrefix(name, "msg.") {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
 type BlockContextFieldLoader func(blockCtx *types.EthBlockContext) proto.Message
 
 type BlockContext struct {
@@ -42,6 +73,13 @@ func (c *BlockContext) registerLoaders() {
 		time := blockCtx.BlockHeader().Time
 		return &artelatypes.UintData{Data: &time}
 	}
+	loaders[BlockHeaderTxHash] = func(blockCtx *types.EthBlockContext) proto.Message {
+		return &artelatypes.BytesData{Data: blockCtx.BlockHeader().TxHash.Bytes()}
+	}
+	loaders[BlockGasUsed] = func(blockCtx *types.EthBlockContext) proto.Message {
+		gasUsed := blockCtx.BlockHeader().GasUsed
+		return &artelatypes.UintData{Data: &gasUsed}
+	}
 }
 
 func (c *BlockContext) ValueLoader(key string) ContextLoader {
@@ -35,6 +35,12 @@ type evmHostApi struct {
 }
 
 func (e *evmHostApi) StaticCall(ctx *asptypes.RunnerContext, request *asptypes.StaticCallRequest) (*asptypes.StaticCallResult, error) {
+	// calls to the aspect host are handled natively instead of the evm
+	if common.BytesToAddress(request.To) == AspectHostAddress {
+		return (&aspectHostAPI{e.aspectCtx}).Call(ctx, request.Data)
+	}
+
+	e.aspectCtx.CaptureHostAPICall(ctx, "evm.staticCall")
 	if !evmStaticCallConstrainedJoinPoints.Contains(asptypes.PointCut(ctx.Point)) {
 		return nil, errors.New("cannot execute static call in current join point")
 	}
@@ -99,6 +105,7 @@ func (e *evmHostApi) StaticCall(ctx *asptypes.RunnerContext, request *asptypes.S
 }
 
 func (e *evmHostApi) JITCall(ctx *asptypes.RunnerContext, request *asptypes.JitInherentRequest) (*asptypes.JitInherentResponse, error) {
+	e.aspectCtx.CaptureHostAPICall(ctx, "evm.jitCall")
 	// determine jit call stage
 	defBool := false
 	switch asptypes.PointCut(ctx.Point) {
@@ -32,6 +32,7 @@ type aspectTraceHostAPI struct {
 }
 
 func (a *aspectTraceHostAPI) QueryStateChange(ctx *artelatypes.RunnerContext, query *artelatypes.StateChangeQuery) ([]byte, error) {
+	a.aspectRuntimeContext.CaptureHostAPICall(ctx, "trace.queryStateChange")
 	if !traceJoinPointConstraints.Contains(artelatypes.PointCut(ctx.Point)) {
 		return []byte{}, errors.New("cannot query state change in current join point")
 	}
@@ -55,6 +55,8 @@ func (c *AspectNativeContract) Init() {
 	c.register(GetBindingHandler{})
 	c.register(GetBoundAddressHandler{})
 	c.register(OperationHandler{})
+	c.register(ScheduleHandler{})
+	c.register(CancelScheduleHandler{})
 }
 
 func (c *AspectNativeContract) register(handler Handler) {
@@ -11,6 +11,7 @@ import (
 
 	asptool "github.com/artela-network/artela/x/aspect/common"
 	"github.com/artela-network/artela/x/aspect/store"
+	v0 "github.com/artela-network/artela/x/aspect/store/v0"
 	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -73,7 +74,8 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 	}
 
 	// we can ignore the new store here, since new deployed aspect should not have that
-	metaStore, _, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, gas))
+	storeCtx := buildAspectStoreCtx(ctx, aspectID, gas)
+	metaStore, _, err := store.GetAspectMetaStore(storeCtx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -111,9 +113,16 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		return nil, 0, err
 	}
 
+	// block level aspects are not bound to any account, so register it to be triggered at each block
+	if err = store.StoreBlockAspect(storeCtx, aspectID, joinPoint.Uint64()); err != nil {
+		ctx.logger.Error("store block aspect failed", "error", err)
+		return nil, 0, err
+	}
+
 	if err = metaStore.StoreMeta(&aspectmoduletypes.AspectMeta{
 		Proof:     proof,
 		PayMaster: paymaster,
+		Owner:     ctx.from,
 	}); err != nil {
 		ctx.logger.Error("store aspect meta failed", "error", err)
 		return nil, 0, err
@@ -275,6 +284,12 @@ func (h UpgradeHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		return nil, 0, err
 	}
 
+	// the new version may add or drop the block level join points
+	if err = store.StoreBlockAspect(storeCtx, aspectID, jpU64); err != nil {
+		ctx.logger.Error("store block aspect failed", "error", err)
+		return nil, 0, err
+	}
+
 	// save properties if any
 	if err = currentStore.StoreProperties(newVersion, properties); err != nil {
 		ctx.logger.Error("store aspect property failed", "error", err)
@@ -902,6 +917,116 @@ func (o OperationHandler) decodeAndValidate(ctx *HandlerContext) (aspectId commo
 	return
 }
 
+type ScheduleHandler struct{}
+
+func (s ScheduleHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	schedule, err := s.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	aspectID := common.HexToAddress(schedule.AspectId)
+	storeCtx := buildAspectStoreCtx(ctx, aspectID, gas)
+	metaStore, _, err := store.GetAspectMetaStore(storeCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// check deployment
+	latestVersion, err := metaStore.GetLatestVersion()
+	if err != nil {
+		return nil, 0, err
+	} else if latestVersion == 0 {
+		return nil, 0, errors.New("aspect not deployed")
+	}
+
+	// only the aspect owner can register schedules paid by the aspect balance, a sponsored schedule is
+	// authorized by the sponsor itself, which signs the registration and pays for and sends the calls
+	if common.HexToAddress(schedule.Payer) == aspectID {
+		code, err := metaStore.GetCode(latestVersion)
+		if err != nil {
+			return nil, 0, err
+		}
+		ok, gas, err := checkAspectOwner(ctx.cosmosCtx, aspectID, ctx.from, storeCtx.Gas(), code, latestVersion, ctx.commit)
+		if err != nil || !ok {
+			return nil, 0, errors.New("aspect ownership validation failed")
+		}
+		storeCtx.UpdateGas(gas)
+	}
+
+	if err = store.RegisterSchedule(storeCtx, schedule); err != nil {
+		ctx.logger.Error("store schedule failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err = v0.NewGasMeter(storeCtx).MeasureStorageStore(schedule.Size()); err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = ctx.abi.Outputs.Pack(schedule.Id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ret, storeCtx.Gas(), nil
+}
+
+func (s ScheduleHandler) Method() string {
+	return "schedule"
+}
+
+func (s ScheduleHandler) decodeAndValidate(ctx *HandlerContext) (schedule *aspectmoduletypes.Schedule, err error) {
+	aspectID := ctx.parameters["aspectId"].(common.Address)
+	target := ctx.parameters["target"].(common.Address)
+
+	// the scheduled call is paid by and sent from the creator if sponsored, otherwise by the aspect itself.
+	// Any account can sponsor the schedules of a deployed aspect, but only with its own balance.
+	payer := aspectID
+	if ctx.parameters["sponsored"].(bool) {
+		payer = ctx.from
+	}
+
+	schedule = &aspectmoduletypes.Schedule{
+		AspectId:   aspectID.Hex(),
+		Creator:    ctx.from.Hex(),
+		Payer:      payer.Hex(),
+		Target:     target.Hex(),
+		Calldata:   ctx.parameters["data"].([]byte),
+		GasLimit:   ctx.parameters["gasLimit"].(uint64),
+		NextHeight: int64(ctx.parameters["startBlock"].(uint64)),
+		Interval:   ctx.parameters["interval"].(uint64),
+		Count:      ctx.parameters["count"].(uint64),
+		Status:     aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	}
+	if err = schedule.Validate(ctx.cosmosCtx.BlockHeight(), ctx.cosmosCtx.ConsensusParams().GetBlock().GetMaxGas()); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+type CancelScheduleHandler struct{}
+
+func (c CancelScheduleHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	id := ctx.parameters["scheduleId"].(uint64)
+
+	storeCtx := aspectmoduletypes.NewStoreContext(ctx.cosmosCtx, ctx.aspectStoreKey, ctx.evmStoreKey, gas)
+	schedule, err := store.CancelSchedule(storeCtx, ctx.from, id)
+	if err != nil {
+		ctx.logger.Error("cancel schedule failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err = v0.NewGasMeter(storeCtx).MeasureStorageUpdate(schedule.Size()); err != nil {
+		return nil, 0, err
+	}
+
+	return nil, storeCtx.Gas(), nil
+}
+
+func (c CancelScheduleHandler) Method() string {
+	return "cancelschedule"
+}
+
 func validateCode(ctx sdk.Context, aspectCode []byte) ([]byte, error) {
 	startTime := time.Now()
 	validator, err := runtime.NewValidator(ctx, arttool.WrapLogger(ctx.Logger()), runtime.WASM)
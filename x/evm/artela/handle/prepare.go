@@ -6,6 +6,9 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/txs"
 )
 
 type (
@@ -17,11 +20,23 @@ type (
 		ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error)
 	}
 
+	// AspectPreExecutor defines the interface that is implemented by the EVM keeper,
+	// to run the VERIFY_TX and PRE_TX_EXECUTE aspects of a transaction in a cached
+	// context, and get the gas consumed by the aspects.
+	AspectPreExecutor interface {
+		PreExecuteAspects(ctx sdk.Context, tx *ethereum.Transaction) (uint64, error)
+	}
+
 	// DefaultProposalHandler defines the default ABCI PrepareProposal and
 	// ProcessProposal handlers.
 	ArtelaProposalHandler struct {
 		mempool    mempool.Mempool
 		txVerifier ProposalTxVerifier
+
+		// aspectExecutor pre-executes the aspects of the ethereum txs, nil if disabled
+		aspectExecutor AspectPreExecutor
+		// maxBlockAspectGas is the max gas consumed by the pre-executed aspects in a block, 0 means unlimited
+		maxBlockAspectGas uint64
 	}
 )
 
@@ -32,6 +47,16 @@ func NewArtelaProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier)
 	}
 }
 
+// WithAspectPrecheck returns a copy of the handler which pre-executes the aspects of
+// the ethereum transactions with the given executor, and caps the total aspect gas
+// of the proposal with maxBlockAspectGas, 0 means unlimited. The cap is a local policy
+// of the proposer, the received proposals are not checked against it.
+func (h ArtelaProposalHandler) WithAspectPrecheck(executor AspectPreExecutor, maxBlockAspectGas uint64) ArtelaProposalHandler {
+	h.aspectExecutor = executor
+	h.maxBlockAspectGas = maxBlockAspectGas
+	return h
+}
+
 // PrepareProposalHandler returns the default implementation for processing an
 // ABCI proposal. The application's mempool is enumerated and all valid
 // transactions are added to the proposal. Transactions are valid if they:
@@ -40,7 +65,18 @@ func NewArtelaProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier)
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
 //
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// reached or the mempool is exhausted. Transactions exceeding the block max gas
+// are skipped.
+//
+// If the aspect precheck is enabled, the VERIFY_TX and PRE_TX_EXECUTE aspects of
+// an ethereum transaction are executed before the validation, the transaction is
+// skipped if it's rejected by the aspects or the aspect gas exceeds the remaining
+// aspect gas of the block. A rejected transaction is kept in the mempool, since the
+// aspects may accept it with the states of a later block.
+//
+// Once an ethereum transaction fails the validation, the following transactions
+// of the same sender are skipped but kept in the mempool, since their nonces are
+// not executable in this block.
 //
 // Note:
 //
@@ -64,13 +100,54 @@ func (h ArtelaProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandl
 		var (
 			selectedTxs  [][]byte
 			totalTxBytes int64
+			totalTxGas   uint64
+			maxBlockGas  int64 = -1
+			// gas consumed by the pre-executed aspects of the selected txs
+			totalAspectGas uint64
+			// senders of the ethereum txs failed the validation
+			failedSenders = make(map[string]struct{})
 		)
 
+		if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil {
+			maxBlockGas = consParams.Block.MaxGas
+		}
+
 		iterator := h.mempool.Select(ctx, req.Txs)
 
 		for iterator != nil {
 			memTx := iterator.Tx()
 
+			ethMsg, isEthTx := ethereumTxMsg(memTx)
+			var sender string
+			if isEthTx {
+				sender = ethMsg.From
+			}
+			if _, failed := failedSenders[sender]; isEthTx && failed {
+				iterator = iterator.Next()
+				continue
+			}
+
+			// NOTE: The aspects are executed before the validation, since the states
+			// changed by the ante handler of a rejected tx are not reverted in this
+			// proposal.
+			var aspectGas uint64
+			if isEthTx && h.aspectExecutor != nil {
+				var err error
+				aspectGas, err = h.aspectExecutor.PreExecuteAspects(ctx, ethMsg.AsTransaction())
+				if err != nil {
+					ctx.Logger().Debug("tx rejected by aspects", "hash", ethMsg.Hash, "error", err.Error())
+					failedSenders[sender] = struct{}{}
+					iterator = iterator.Next()
+					continue
+				}
+				if h.maxBlockAspectGas > 0 && totalAspectGas+aspectGas > h.maxBlockAspectGas {
+					// the aspects don't fit in the remaining aspect gas of the block
+					failedSenders[sender] = struct{}{}
+					iterator = iterator.Next()
+					continue
+				}
+			}
+
 			// NOTE: Since transaction verification was already executed in CheckTx,
 			// which calls mempool.Insert, in theory everything in the pool should be
 			// valid. But some mempool implementations may insert invalid txs, so we
@@ -81,15 +158,33 @@ func (h ArtelaProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandl
 				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 					panic(err)
 				}
+				if isEthTx {
+					failedSenders[sender] = struct{}{}
+				}
 			} else {
 				txSize := int64(len(bz))
-				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
-					selectedTxs = append(selectedTxs, bz)
-				} else {
+				if totalTxBytes += txSize; totalTxBytes > req.MaxTxBytes {
 					// We've reached capacity per req.MaxTxBytes so we cannot select any
 					// more transactions.
 					break
 				}
+
+				var txGas uint64
+				if feeTx, ok := memTx.(sdk.FeeTx); ok {
+					txGas = feeTx.GetGas()
+				}
+				if maxBlockGas > 0 && totalTxGas+txGas > uint64(maxBlockGas) {
+					// the tx doesn't fit in the remaining block gas, the following
+					// txs of an ethereum sender are not executable in this block
+					totalTxBytes -= txSize
+					if isEthTx {
+						failedSenders[sender] = struct{}{}
+					}
+				} else {
+					totalTxGas += txGas
+					totalAspectGas += aspectGas
+					selectedTxs = append(selectedTxs, bz)
+				}
 			}
 
 			iterator = iterator.Next()
@@ -110,6 +205,9 @@ func (h ArtelaProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandl
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
+//
+// The block aspect gas cap is not enforced here, since it's configured per node and
+// rejecting on it would let the validators diverge on the same proposal.
 func (h ArtelaProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	// If the mempool is nil or NoOp we simply return ACCEPT,
 	// because PrepareProposal may have included txs that could fail verification.
@@ -130,6 +228,17 @@ func (h ArtelaProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandl
 	}
 }
 
+// ethereumTxMsg returns the MsgEthereumTx of the ethereum transaction, false if the
+// transaction is not a single MsgEthereumTx.
+func ethereumTxMsg(tx sdk.Tx) (*txs.MsgEthereumTx, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	msg, ok := msgs[0].(*txs.MsgEthereumTx)
+	return msg, ok
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -0,0 +1,155 @@
+package handle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// mockMempool selects the txs in the insertion order.
+type mockMempool struct {
+	txs     []sdk.Tx
+	removed []sdk.Tx
+}
+
+func (m *mockMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func (m *mockMempool) Select(_ context.Context, _ [][]byte) mempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+	return &mockIterator{txs: m.txs}
+}
+
+func (m *mockMempool) CountTx() int { return len(m.txs) }
+
+func (m *mockMempool) Remove(tx sdk.Tx) error {
+	m.removed = append(m.removed, tx)
+	return nil
+}
+
+type mockIterator struct {
+	txs []sdk.Tx
+}
+
+func (i *mockIterator) Next() mempool.Iterator {
+	if len(i.txs) <= 1 {
+		return nil
+	}
+	return &mockIterator{txs: i.txs[1:]}
+}
+
+func (i *mockIterator) Tx() sdk.Tx { return i.txs[0] }
+
+// mockTxVerifier encodes the txs by the hash, all the txs are valid.
+type mockTxVerifier struct {
+	txs map[string]sdk.Tx
+}
+
+func (m *mockTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	bz := tx.(*txs.MsgEthereumTx).AsTransaction().Hash().Bytes()
+	m.txs[string(bz)] = tx
+	return bz, nil
+}
+
+func (m *mockTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	tx, ok := m.txs[string(txBz)]
+	if !ok {
+		return nil, errors.New("unknown tx")
+	}
+	return tx, nil
+}
+
+// mockAspectExecutor returns the aspect gas of the txs by nonce, the txs with a negative gas are rejected.
+type mockAspectExecutor map[uint64]int64
+
+func (m mockAspectExecutor) PreExecuteAspects(_ sdk.Context, tx *ethereum.Transaction) (uint64, error) {
+	gas := m[tx.Nonce()]
+	if gas < 0 {
+		return uint64(-gas), errors.New("rejected")
+	}
+	return uint64(gas), nil
+}
+
+func newTestTx(t *testing.T, sender common.Address, nonce uint64) *txs.MsgEthereumTx {
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethereum.NewTx(&ethereum.LegacyTx{
+		Nonce:    nonce,
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+		// the txs are not signed, the data makes the hash unique per sender
+		Data: sender.Bytes(),
+	})))
+	msg.From = sender.Hex()
+	return msg
+}
+
+func TestAspectPrecheck(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0x0000000000000000000000000000000000000001")
+		bob   = common.HexToAddress("0x0000000000000000000000000000000000000002")
+		carol = common.HexToAddress("0x0000000000000000000000000000000000000003")
+	)
+
+	// the nonce 1 of alice is rejected by the aspects, the aspects of carol exceed the block aspect gas
+	executor := mockAspectExecutor{0: 40, 1: -30, 2: 10, 5: 50, 9: 20}
+	aliceTx, rejectedTx, nextTx := newTestTx(t, alice, 0), newTestTx(t, alice, 1), newTestTx(t, alice, 2)
+	bobTx, carolTx := newTestTx(t, bob, 5), newTestTx(t, carol, 9)
+
+	mp := &mockMempool{}
+	for _, tx := range []sdk.Tx{aliceTx, rejectedTx, nextTx, bobTx, carolTx} {
+		require.NoError(t, mp.Insert(sdk.Context{}, tx))
+	}
+	verifier := &mockTxVerifier{txs: make(map[string]sdk.Tx)}
+	handler := NewArtelaProposalHandler(mp, verifier).WithAspectPrecheck(executor, 100)
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger()).WithConsensusParams(&tmproto.ConsensusParams{})
+	res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+
+	// the following txs of alice are skipped, the rejected tx is kept in the mempool
+	encode := func(msgs ...*txs.MsgEthereumTx) (bz [][]byte) {
+		for _, msg := range msgs {
+			bz = append(bz, msg.AsTransaction().Hash().Bytes())
+		}
+		return bz
+	}
+	require.Equal(t, encode(aliceTx, bobTx), res.Txs)
+	require.Empty(t, mp.removed)
+
+	// the block aspect gas is a local cap of the proposer, the received proposals exceeding it are accepted
+	for _, tx := range []*txs.MsgEthereumTx{rejectedTx, carolTx} {
+		_, err := verifier.PrepareProposalVerifyTx(tx)
+		require.NoError(t, err)
+	}
+	process := handler.ProcessProposalHandler()
+	testCases := []struct {
+		name      string
+		txs       [][]byte
+		expStatus abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{"prepared proposal", res.Txs, abci.ResponseProcessProposal_ACCEPT},
+		{"rejected tx", encode(aliceTx, rejectedTx, bobTx), abci.ResponseProcessProposal_ACCEPT},
+		{"aspect gas exceeded", encode(aliceTx, bobTx, carolTx), abci.ResponseProcessProposal_ACCEPT},
+		{"undecodable tx", [][]byte{{0x01}}, abci.ResponseProcessProposal_REJECT},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expStatus, process(ctx, abci.RequestProcessProposal{Txs: tc.txs}).Status)
+		})
+	}
+}
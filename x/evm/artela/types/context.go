@@ -42,6 +42,11 @@ func InitStoreKeys(evmStoreKey storetypes.StoreKey, aspectStoreKey storetypes.St
 	cachedAspectStoreKey = aspectStoreKey
 }
 
+// StoreKeys returns the evm and aspect store keys initialized by InitStoreKeys
+func StoreKeys() (evmStoreKey storetypes.StoreKey, aspectStoreKey storetypes.StoreKey) {
+	return cachedEVMStoreKey, cachedAspectStoreKey
+}
+
 type (
 	HistoryStoreBuilder func(height int64, keyPrefix string) (prefix.Store, error)
 	ContextBuilder      func(height int64, prove bool) (cosmos.Context, error)
@@ -79,6 +84,9 @@ type AspectRuntimeContext struct {
 
 	logger     log.Logger
 	jitManager *inherent.Manager
+	// verifyLogger captures the aspect activities in the transaction verification,
+	// which runs before any evm is created
+	verifyLogger AspectHostLogger
 }
 
 func NewAspectRuntimeContext() *AspectRuntimeContext {
@@ -183,10 +191,16 @@ func (c *AspectRuntimeContext) GetAspectProperty(ctx *artelatypes.RunnerContext,
 }
 
 func (c *AspectRuntimeContext) GetAspectState(ctx *artelatypes.RunnerContext, key string) []byte {
+	if logger := c.HostLogger(); logger != nil {
+		logger.CaptureAspectStateAccess(ctx, key, false)
+	}
 	return c.aspectState.Get(ctx.AspectId, key)
 }
 
 func (c *AspectRuntimeContext) SetAspectState(ctx *artelatypes.RunnerContext, key string, value []byte) {
+	if logger := c.HostLogger(); logger != nil {
+		logger.CaptureAspectStateAccess(ctx, key, true)
+	}
 	c.aspectState.Set(ctx.AspectId, key, value)
 }
 
@@ -344,6 +358,12 @@ func NewEthBlockContextFromABCIBeginBlockReq(req abci.RequestBeginBlock) *EthBlo
 	}
 }
 
+// WithGasUsed sets the gas used by the transactions executed in the block so far
+func (c *EthBlockContext) WithGasUsed(gasUsed uint64) *EthBlockContext {
+	c.blockHeader.GasUsed = gasUsed
+	return c
+}
+
 func NewEthBlockContextFromQuery(sdkCtx cosmos.Context, queryCtx client.Context) *EthBlockContext {
 	blockHeight := sdkCtx.BlockHeight()
 	resBlock, err := queryCtx.Client.Block(sdkCtx, &blockHeight)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	artelatypes "github.com/artela-network/aspect-core/types"
+)
+
+// AspectHostLogger is an optional extension of the EVM tracer, it captures the aspect activities
+// which are not covered by artelatypes.AspectLogger, like the aspects resolved for a join point,
+// the host apis invoked and the aspect states accessed by the running aspect.
+type AspectHostLogger interface {
+	// CaptureAspectBindings is called when the aspects bound to a join point are resolved
+	CaptureAspectBindings(point artelatypes.PointCut, aspects []*artelatypes.AspectCode)
+	// CaptureHostAPICall is called when a host api is invoked by the running aspect
+	CaptureHostAPICall(ctx *artelatypes.RunnerContext, api string)
+	// CaptureAspectStateAccess is called when the running aspect reads or writes its state
+	CaptureAspectStateAccess(ctx *artelatypes.RunnerContext, key string, write bool)
+}
+
+// HostLogger returns the EVM tracer of current transaction if it implements AspectHostLogger,
+// or the verify logger if no EVM is created yet, otherwise nil is returned.
+func (c *AspectRuntimeContext) HostLogger() AspectHostLogger {
+	if c.ethTxContext == nil || c.ethTxContext.lastEvm == nil {
+		return c.verifyLogger
+	}
+
+	logger, _ := c.ethTxContext.lastEvm.Config.Tracer.(AspectHostLogger)
+	return logger
+}
+
+// CaptureHostAPICall records the host api call with the tracer, if there is any.
+func (c *AspectRuntimeContext) CaptureHostAPICall(ctx *artelatypes.RunnerContext, api string) {
+	if logger := c.HostLogger(); logger != nil {
+		logger.CaptureHostAPICall(ctx, api)
+	}
+}
+
+// WithVerifyLogger sets the logger capturing the aspect activities in the transaction verification,
+// pass nil to remove it once the verification is done.
+func (c *AspectRuntimeContext) WithVerifyLogger(logger AspectHostLogger) {
+	c.verifyLogger = logger
+}
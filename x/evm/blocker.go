@@ -7,12 +7,14 @@ import (
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
 
+	aspecttypes "github.com/artela-network/artela/x/aspect/types"
 	"github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/keeper"
 )
 
-// BeginBlock sets the cosmos Context and EIP155 chain id to the Keeper.
-func BeginBlock(_ cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBeginBlock) {
+// BeginBlock sets the cosmos Context and EIP155 chain id to the Keeper, triggers the block
+// level aspects at the onBlockInitialize join point, and executes the due scheduled calls.
+func BeginBlock(ctx cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBeginBlock) {
 	// Aspect Runtime Context Lifecycle: create and store ExtBlockContext
 	// due to the design of the block context in Cosmos SDK,
 	// the extBlockCtx cannot be saved directly to the context of the deliver state
@@ -22,12 +24,20 @@ func BeginBlock(_ cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBegin
 
 	// clear the verifyTxCache when BeginBlock
 	clearSyncMap(k.VerifySigCache)
+
+	k.ExecuteBlockAspects(ctx, aspecttypes.ON_BLOCK_INITIALIZE_METHOD)
+	k.ExecuteSchedules(ctx)
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock triggers the block level aspects at the onBlockFinalize join point, then retrieves the
+// bloom filter value from the transient store and commits it to the KVStore. The EVM end block logic
+// doesn't update the validator set, thus it returns an empty slice.
 func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if k.BlockContext != nil && ctx.BlockGasMeter() != nil {
+		k.BlockContext.WithGasUsed(ctx.BlockGasMeter().GasConsumedToLimit())
+	}
+	k.ExecuteBlockAspects(ctx, aspecttypes.ON_BLOCK_FINALIZE_METHOD)
+
 	// Aspect Runtime Context Lifecycle: destroy ExtBlockContext
 	k.BlockContext = nil
 
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"sort"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/artela-network/artela/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	feetypes "github.com/artela-network/artela/x/fee/types"
+)
+
+// shareAspectFees shares the fees paid for the gas consumed by the aspects with the owners of the aspects,
+// the total share is no more than the fee paid for the gas used by the transaction. Aspects without an owner
+// or failed to load are skipped, their share stays in the fee collector.
+func (k *Keeper) shareAspectFees(ctx cosmos.Context, msg *core.Message, gasUsed uint64, denom string, usages []txs.AspectGasUsage) error {
+	if len(usages) == 0 || !k.feeKeeper.GetParams(ctx).IsAspectFeeShareEnabled() {
+		return nil
+	}
+
+	evmStoreKey, aspectStoreKey := artelatypes.StoreKeys()
+	gasByBeneficiary := make(map[string]uint64)
+	for _, usage := range usages {
+		if usage.GasUsed == 0 {
+			continue
+		}
+
+		metaStore, _, err := store.GetAspectMetaStore(&aspectmoduletypes.AspectStoreContext{
+			StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx, aspectStoreKey, evmStoreKey),
+			AspectID:     common.HexToAddress(usage.AspectId),
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to load aspect for fee sharing", "aspect", usage.AspectId, "error", err)
+			continue
+		}
+		meta, err := metaStore.GetMeta()
+		if err != nil {
+			k.Logger(ctx).Error("failed to load aspect meta for fee sharing", "aspect", usage.AspectId, "error", err)
+			continue
+		}
+		owner := aspectOwner(meta)
+		if owner == (common.Address{}) {
+			continue
+		}
+
+		beneficiary := cosmos.AccAddress(owner.Bytes()).String()
+		gasByBeneficiary[beneficiary] += usage.GasUsed
+	}
+
+	// iterate in a deterministic order
+	beneficiaries := make([]string, 0, len(gasByBeneficiary))
+	for beneficiary := range gasByBeneficiary {
+		beneficiaries = append(beneficiaries, beneficiary)
+	}
+	sort.Strings(beneficiaries)
+
+	aspectGas := make([]feetypes.AspectGas, 0, len(beneficiaries))
+	for _, beneficiary := range beneficiaries {
+		aspectGas = append(aspectGas, feetypes.AspectGas{
+			Beneficiary: cosmos.MustAccAddressFromBech32(beneficiary),
+			GasUsed:     gasByBeneficiary[beneficiary],
+		})
+	}
+
+	return k.feeKeeper.ShareAspectFees(ctx, msg.GasPrice, gasUsed, denom, aspectGas)
+}
+
+// aspectOwner returns the owner of the aspect. The aspects deployed before the owner is recorded
+// fall back to the paymaster, which is required to be the deployer at the deployment.
+func aspectOwner(meta *aspectmoduletypes.AspectMeta) common.Address {
+	if meta == nil {
+		return common.Address{}
+	}
+	if meta.Owner != (common.Address{}) {
+		return meta.Owner
+	}
+	return meta.PayMaster
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmosstore "github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/aspect/store"
+	_ "github.com/artela-network/artela/x/aspect/store/v0"
+	_ "github.com/artela-network/artela/x/aspect/store/v1"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/types"
+	feetypes "github.com/artela-network/artela/x/fee/types"
+)
+
+// mockFeeKeeper records the aspect gas shared by the evm keeper
+type mockFeeKeeper struct {
+	types.FeeKeeper
+
+	params    feetypes.Params
+	aspectGas []feetypes.AspectGas
+}
+
+func (f *mockFeeKeeper) GetParams(cosmos.Context) feetypes.Params {
+	return f.params
+}
+
+func (f *mockFeeKeeper) ShareAspectFees(_ cosmos.Context, _ *big.Int, _ uint64, _ string, aspectGas []feetypes.AspectGas) error {
+	f.aspectGas = aspectGas
+	return nil
+}
+
+func TestShareAspectFeesBeneficiaries(t *testing.T) {
+	aspectKey := storetypes.NewKVStoreKey(aspectmoduletypes.StoreKey)
+	evmKey := storetypes.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	cms := cosmosstore.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(aspectKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := cosmos.NewContext(cms, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	artelatypes.InitStoreKeys(evmKey, aspectKey)
+
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	payMaster := common.HexToAddress("0x00000000000000000000000000000000000000a2")
+	owned := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	legacy := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	unknown := common.HexToAddress("0x0000000000000000000000000000000000000003")
+
+	deploy := func(aspectID common.Address, meta *aspectmoduletypes.AspectMeta) {
+		metaStore, _, err := store.GetAspectMetaStore(&aspectmoduletypes.AspectStoreContext{
+			StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx, aspectKey, evmKey),
+			AspectID:     aspectID,
+		})
+		require.NoError(t, err)
+		require.NoError(t, metaStore.Init())
+		require.NoError(t, metaStore.StoreMeta(meta))
+	}
+	deploy(owned, &aspectmoduletypes.AspectMeta{PayMaster: payMaster, Owner: owner})
+	// the aspects deployed before the owner is recorded
+	deploy(legacy, &aspectmoduletypes.AspectMeta{PayMaster: payMaster})
+
+	params := feetypes.DefaultParams()
+	params.AspectFeeShare = cosmos.MustNewDecFromStr("0.5")
+	feeKeeper := &mockFeeKeeper{params: params}
+	k := &Keeper{feeKeeper: feeKeeper}
+
+	msg := &core.Message{GasPrice: big.NewInt(10)}
+	require.NoError(t, k.shareAspectFees(ctx, msg, 1000, "aart", []txs.AspectGasUsage{
+		{AspectId: owned.Hex(), GasUsed: 100},
+		{AspectId: legacy.Hex(), GasUsed: 200},
+		{AspectId: owned.Hex(), GasUsed: 50},
+		// aspects without an owner are skipped
+		{AspectId: unknown.Hex(), GasUsed: 300},
+		{AspectId: legacy.Hex()},
+	}))
+
+	// the gas is grouped by beneficiary, sorted by the bech32 address
+	expected := []feetypes.AspectGas{
+		{Beneficiary: cosmos.AccAddress(owner.Bytes()), GasUsed: 150},
+		{Beneficiary: cosmos.AccAddress(payMaster.Bytes()), GasUsed: 200},
+	}
+	if expected[0].Beneficiary.String() > expected[1].Beneficiary.String() {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	require.Equal(t, expected, feeKeeper.aspectGas)
+
+	// nothing is shared if disabled
+	feeKeeper.aspectGas = nil
+	feeKeeper.params = feetypes.DefaultParams()
+	require.NoError(t, k.shareAspectFees(ctx, msg, 1000, "aart", []txs.AspectGasUsage{{AspectId: owned.Hex(), GasUsed: 100}}))
+	require.Nil(t, feeKeeper.aspectGas)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/aspect-core/djpm"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// PreExecuteAspects runs the VERIFY_TX and PRE_TX_EXECUTE aspects of the transaction in a cached context,
+// without running the evm call, and returns the gas consumed by the aspects of both join points. An error
+// is returned if the transaction is rejected by any of the aspects. The verifier aspect is executed without
+// the verification cache shared by the block execution, so the result of the delivery is not affected.
+//
+// It's used to filter out the transactions that would fail the aspect checks at delivery when preparing a
+// block proposal, so the aspects are executed against the states of the block being proposed.
+func (k *Keeper) PreExecuteAspects(ctx cosmos.Context, tx *ethereum.Transaction) (uint64, error) {
+	// contract creations and aspect operations don't join the txs level aspects
+	if tx.To() == nil || asptypes.IsAspectContractAddr(tx.To()) {
+		return 0, nil
+	}
+
+	evmConfig, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	// Aspect Runtime Context Lifecycle: create aspect context.
+	// The block is not begun yet at proposal stage, so the block context can only be initialized with the height.
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx, aspectCtx := k.WithAspectContext(cacheCtx, tx, evmConfig,
+		artelatypes.NewEthBlockContextFromHeight(ctx.BlockHeight()))
+	defer aspectCtx.Destroy()
+
+	aspects, err := k.aspect.GetTxBondAspects(aspectCtx, *tx.To(), asptypes.PRE_TX_EXECUTE_METHOD)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to load the aspects bound to the contract")
+	}
+
+	isCustomVerification := k.isCustomizedVerification(tx)
+	if len(aspects) == 0 && !isCustomVerification {
+		return 0, nil
+	}
+
+	// the sender of customized verification txs is resolved by the VERIFY_TX aspects
+	verifyGas := txs.NewAspectGasRecorder(nil)
+	sender, _, err := k.VerifySigNoCache(cacheCtx, tx, verifyGas)
+	if err != nil {
+		return aspectGasUsed(verifyGas), errorsmod.Wrap(err, "failed to verify the transaction")
+	}
+	if len(aspects) == 0 {
+		return aspectGasUsed(verifyGas), nil
+	}
+
+	// the sender has been resolved above, so the sender recovery error can be ignored here
+	msg, _ := txs.ToMessage(tx, ethereum.LatestSignerForChainID(k.ChainID()), evmConfig.BaseFee)
+	msg.From = sender
+
+	if msg.Data, err = k.processMsgData(tx); err != nil {
+		return 0, errorsmod.Wrap(err, "unable to process msg data")
+	}
+
+	txConfig := k.TxConfig(cacheCtx, tx.Hash(), tx.Type())
+	stateDB := states.New(cacheCtx, k, txConfig)
+	aspectGas := txs.NewAspectGasRecorder(k.Tracer(cacheCtx, msg, evmConfig.ChainConfig))
+	evm := k.NewEVM(cacheCtx, msg, evmConfig, aspectGas, stateDB)
+
+	// Aspect Runtime Context Lifecycle: set EVM params, same as ApplyMessageWithConfig, the states are not committed.
+	aspectCtx.EthTxContext().WithEVM(msg.From, msg, evm, evm.Tracer(), stateDB)
+	aspectCtx.EthTxContext().WithTxIndex(uint64(txConfig.TxIndex))
+	aspectCtx.EthTxContext().WithCommit(false)
+	aspectCtx.CreateStateObject()
+
+	intrinsicGas, err := k.GetEthIntrinsicGas(cacheCtx, msg, evmConfig.ChainConfig, false, isCustomVerification)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "intrinsic gas failed")
+	}
+	if msg.GasLimit < intrinsicGas {
+		return 0, errorsmod.Wrap(core.ErrIntrinsicGas, "pre execute aspects")
+	}
+
+	lastHeight := uint64(ctx.BlockHeight())
+	result := djpm.AspectInstance().PreTxExecute(aspectCtx, msg.From, *msg.To, msg.Data, ctx.BlockHeight(), msg.GasLimit-intrinsicGas, msg.Value, &asptypes.PreTxExecuteInput{
+		Tx: &asptypes.WithFromTxInput{
+			Hash: tx.Hash().Bytes(),
+			To:   msg.To.Bytes(),
+			From: msg.From.Bytes(),
+		},
+		Block: &asptypes.BlockInput{Number: &lastHeight},
+	}, aspectGas)
+
+	gasUsed := aspectGasUsed(verifyGas) + aspectGasUsed(aspectGas)
+	if result.Err != nil {
+		return gasUsed, errorsmod.Wrap(result.Err, "rejected by pre tx execute aspects")
+	}
+
+	return gasUsed, nil
+}
+
+// aspectGasUsed returns the total gas consumed by the aspects recorded.
+func aspectGasUsed(recorder *txs.AspectGasRecorder) (gasUsed uint64) {
+	for _, usage := range recorder.Usages() {
+		gasUsed += usage.GasUsed
+	}
+	return gasUsed
+}
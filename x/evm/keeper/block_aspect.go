@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	arttool "github.com/artela-network/artela/common"
+	"github.com/artela-network/artela/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/aspect-core/djpm/run"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// MaxGasPerBlockAspect is the gas cap of a single block level aspect execution,
+// so one aspect cannot exhaust the gas budget shared by all the block level aspects.
+const MaxGasPerBlockAspect uint64 = 1_000_000
+
+// ExecuteBlockAspects runs all the registered block level aspects declaring the given block level join point.
+// The aspects share the gas budget defined by the BlockAspectGasLimit param, each execution is capped by
+// MaxGasPerBlockAspect and the budget left. The execution order rotates with the block height, so the same
+// aspects are not always the ones left out once the budget is exhausted. The gas used is paid by the paymaster
+// of the aspect at the base fee, the aspect is skipped if the paymaster cannot afford the gas cap. Each aspect
+// is executed in its own cache context, the state changes are committed only if the execution succeeds.
+// Execution failures are only logged, a failing aspect must not halt the chain.
+func (k *Keeper) ExecuteBlockAspects(ctx cosmos.Context, point asptypes.PointCut) {
+	if k.BlockContext == nil {
+		return
+	}
+
+	budget := k.GetParams(ctx).BlockAspectGasLimit
+	if budget == 0 {
+		return
+	}
+
+	// gas costs of the block level aspects are limited by the aspect gas budget
+	ctx = ctx.WithGasMeter(cosmos.NewInfiniteGasMeter())
+
+	evmStoreKey, aspectStoreKey := artelatypes.StoreKeys()
+	aspects := store.LoadBlockAspects(aspectmoduletypes.NewGasFreeStoreContext(ctx, aspectStoreKey, evmStoreKey))
+	aspects = BlockAspectsInOrder(aspects, point, ctx.BlockHeight())
+	if len(aspects) == 0 {
+		return
+	}
+
+	evmConfig, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.ChainID())
+	if err != nil {
+		k.Logger(ctx).Error("failed to load evm config for block aspects", "joinpoint", point, "err", err)
+		return
+	}
+
+	for _, aspect := range aspects {
+		if budget == 0 {
+			k.Logger(ctx).Info("block aspect gas budget exhausted", "joinpoint", point, "aspect", aspect.AspectID.Hex())
+			return
+		}
+
+		gas := MaxGasPerBlockAspect
+		if gas > budget {
+			gas = budget
+		}
+		gasUsed, err := k.executeBlockAspect(ctx, evmConfig, aspect, point, gas)
+		if err != nil {
+			k.Logger(ctx).Error("block aspect execution failed", "joinpoint", point,
+				"aspect", aspect.AspectID.Hex(), "err", err)
+		}
+		budget -= gasUsed
+	}
+}
+
+// BlockAspectsInOrder returns the aspects declaring the given block level join point in execution order,
+// which starts from a different aspect at each block height.
+func BlockAspectsInOrder(aspects []store.BlockAspect, point asptypes.PointCut, height int64) []store.BlockAspect {
+	matched := make([]store.BlockAspect, 0, len(aspects))
+	for _, aspect := range aspects {
+		if aspectmoduletypes.CanExecBlockPoint(int64(aspect.JoinPoint), point) {
+			matched = append(matched, aspect)
+		}
+	}
+	if len(matched) == 0 || height <= 0 {
+		return matched
+	}
+
+	start := int(height % int64(len(matched)))
+	ordered := make([]store.BlockAspect, 0, len(matched))
+	return append(append(ordered, matched[start:]...), matched[:start]...)
+}
+
+// executeBlockAspect runs the aspect with the given gas and charges its paymaster for the gas used,
+// the gas used is returned even if the execution fails.
+func (k *Keeper) executeBlockAspect(ctx cosmos.Context, evmConfig *states.EVMConfig, aspect store.BlockAspect,
+	point asptypes.PointCut, gas uint64,
+) (uint64, error) {
+	evmStoreKey, aspectStoreKey := artelatypes.StoreKeys()
+	metaStore, _, err := store.GetAspectMetaStore(&aspectmoduletypes.AspectStoreContext{
+		StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx, aspectStoreKey, evmStoreKey),
+		AspectID:     aspect.AspectID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	meta, err := metaStore.GetMeta()
+	if err != nil {
+		return 0, err
+	}
+
+	// make sure the paymaster can afford the gas cap before running the aspect
+	denom := evmConfig.Params.EvmDenom
+	if fee := blockAspectFee(gas, evmConfig.BaseFee); fee.Sign() > 0 {
+		if meta.PayMaster == (common.Address{}) {
+			return 0, errors.New("no paymaster to pay for the block aspect")
+		}
+		balance := k.bankKeeper.GetBalance(ctx, meta.PayMaster.Bytes(), denom)
+		if balance.Amount.BigInt().Cmp(fee) < 0 {
+			return 0, fmt.Errorf("insufficient paymaster balance, have %s, want %s", balance.Amount, fee)
+		}
+	}
+
+	leftover, execErr := k.runBlockAspect(ctx, evmConfig, aspect, point, gas, metaStore)
+	var gasUsed uint64
+	if leftover < gas {
+		gasUsed = gas - leftover
+	}
+
+	// the gas used is charged whether the execution succeeds or not
+	if fee := blockAspectFee(gasUsed, evmConfig.BaseFee); fee.Sign() > 0 {
+		coins := cosmos.Coins{cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(fee))}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, meta.PayMaster.Bytes(), authtypes.FeeCollectorName, coins); err != nil {
+			return gasUsed, errors.Join(execErr, fmt.Errorf("failed to charge the paymaster: %w", err))
+		}
+	}
+	return gasUsed, execErr
+}
+
+// runBlockAspect runs the latest version of the aspect at the block level join point in a cache context,
+// the state changes are committed only if the execution succeeds. The gas left is returned.
+func (k *Keeper) runBlockAspect(ctx cosmos.Context, evmConfig *states.EVMConfig, aspect store.BlockAspect,
+	point asptypes.PointCut, gas uint64, metaStore store.AspectMetaStore,
+) (leftover uint64, err error) {
+	// host apis may panic on unexpected errors, which must not halt the chain in begin or end block
+	defer func() {
+		if r := recover(); r != nil {
+			leftover, err = 0, fmt.Errorf("block aspect panic: %v", r)
+		}
+	}()
+
+	version, err := metaStore.GetLatestVersion()
+	if err != nil {
+		return gas, err
+	}
+	code, err := metaStore.GetCode(version)
+	if err != nil {
+		return gas, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx, aspectCtx := k.WithAspectContext(cacheCtx, nil, evmConfig, k.BlockContext)
+	defer aspectCtx.Destroy()
+	// block level aspects are only triggered in deliver state
+	aspectCtx.EthTxContext().WithCommit(true)
+
+	runner, err := run.NewRunner(aspectCtx, arttool.WrapLogger(k.Logger(ctx)), aspect.AspectID.String(), version, code, true)
+	if err != nil {
+		return gas, err
+	}
+	defer runner.Return()
+
+	height := cacheCtx.BlockHeight()
+	heightU64 := uint64(height)
+	_, leftover, err = runner.JoinPoint(point, gas, height, aspect.AspectID, &asptypes.BlockInput{Number: &heightU64})
+	if err != nil {
+		return leftover, err
+	}
+
+	writeCache()
+	return leftover, nil
+}
+
+// blockAspectFee returns the fee of the gas at the base fee, zero if there is no base fee.
+func blockAspectFee(gas uint64, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gas), baseFee)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+)
+
+func TestBlockAspectsInOrder(t *testing.T) {
+	initialize := uint64(aspectmoduletypes.JoinPointRunType_OnBlockInitialize)
+	finalize := uint64(aspectmoduletypes.JoinPointRunType_OnBlockFinalize)
+
+	aspects := []store.BlockAspect{
+		{AspectID: common.BigToAddress(big.NewInt(1)), JoinPoint: initialize},
+		{AspectID: common.BigToAddress(big.NewInt(2)), JoinPoint: finalize},
+		{AspectID: common.BigToAddress(big.NewInt(3)), JoinPoint: initialize | finalize},
+		{AspectID: common.BigToAddress(big.NewInt(4)), JoinPoint: initialize},
+	}
+
+	ids := func(aspects []store.BlockAspect) []int64 {
+		result := make([]int64, 0, len(aspects))
+		for _, aspect := range aspects {
+			result = append(result, aspect.AspectID.Big().Int64())
+		}
+		return result
+	}
+
+	point := aspectmoduletypes.ON_BLOCK_INITIALIZE_METHOD
+	require.Equal(t, []int64{1, 3, 4}, ids(BlockAspectsInOrder(aspects, point, 3)))
+	require.Equal(t, []int64{3, 4, 1}, ids(BlockAspectsInOrder(aspects, point, 4)))
+	require.Equal(t, []int64{4, 1, 3}, ids(BlockAspectsInOrder(aspects, point, 5)))
+	require.Equal(t, []int64{2, 3}, ids(BlockAspectsInOrder(aspects, aspectmoduletypes.ON_BLOCK_FINALIZE_METHOD, 2)))
+	require.Empty(t, BlockAspectsInOrder(aspects[:1], aspectmoduletypes.ON_BLOCK_FINALIZE_METHOD, 1))
+
+	// every aspect is the first one once in a while
+	first := make(map[int64]bool)
+	for height := int64(1); height <= 3; height++ {
+		first[BlockAspectsInOrder(aspects, point, height)[0].AspectID.Big().Int64()] = true
+	}
+	require.Len(t, first, 3)
+
+	// the input is not modified
+	require.Equal(t, []int64{1, 2, 3, 4}, ids(aspects))
+}
+
+func TestBlockAspectFee(t *testing.T) {
+	require.Equal(t, int64(0), blockAspectFee(100, nil).Int64())
+	require.Equal(t, int64(0), blockAspectFee(0, big.NewInt(7)).Int64())
+	require.Equal(t, int64(700), blockAspectFee(100, big.NewInt(7)).Int64())
+}
@@ -50,6 +50,8 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
+	// apply the block overrides of eth_call / eth_estimateGas, if any
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := artcore.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -181,6 +183,7 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	}
 	ctx.Logger().Debug("ApplyMessageWithConfig", "txhash", tx.Hash().String(), "response", res)
 
+	res.Logs = receiptLogs(res)
 	logs := support.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
@@ -236,6 +239,28 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
 
+	// the verification happens before the execution, so the verifier aspect is not recorded by the execution
+	if usage, ok := k.aspectVerifyGasUsage(tx); ok {
+		res.AspectGasUsed = append([]txs.AspectGasUsage{usage}, res.AspectGasUsed...)
+	}
+
+	if err = k.shareAspectFees(ctx, msg, res.GasUsed, evmConfig.Params.EvmDenom, res.AspectGasUsed); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to share aspect fees")
+	}
+
+	// the aspect gas is part of the tx result only if enabled, as it changes the result bytes
+	if !evmConfig.Params.RecordAspectGas {
+		res.AspectGasUsed = nil
+	}
+
+	// record the priority fee paid for the fee history
+	k.feeKeeper.AddTransientTxReward(ctx, txConfig.TxHash, res.GasUsed, tx.EffectiveGasTipValue(evmConfig.BaseFee))
+
+	// record the gas used for the base fee adjustment
+	if _, err = k.feeKeeper.AddTransientEthGasUsed(ctx, res.GasUsed); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add transient eth gas used")
+	}
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
@@ -255,6 +280,17 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	return res, nil
 }
 
+// receiptLogs returns the logs of the transaction receipt. The logs of the evm call frames are reverted
+// together with the states by the state db journal, so a failed transaction only carries the logs emitted
+// by aspects before the call, e.g. at the pre tx execute join point. The states changed by the aspects of a
+// failed transaction are discarded, so are the logs, which leaves the receipt without logs as in ethereum.
+func receiptLogs(res *txs.MsgEthereumTxResponse) []*support.Log {
+	if res.Failed() {
+		return nil
+	}
+	return res.Logs
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx cosmos.Context, msg *core.Message, tracer vm.EVMLogger, commit bool) (*txs.MsgEthereumTxResponse, error) {
 	evmConfig, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
@@ -333,7 +369,15 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	}
 
 	stateDB := states.New(ctx, k, txConfig)
-	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	if err := cfg.Overrides.Apply(stateDB); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+	}
+	// record the gas consumed by each aspect on top of the given tracer
+	if tracer == nil {
+		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
+	}
+	aspectGas := txs.NewAspectGasRecorder(tracer)
+	evm := k.NewEVM(ctx, msg, cfg, aspectGas, stateDB)
 
 	// Aspect Runtime Context Lifecycle: set EVM params.
 	// Before the pre-transaction execution, establish the EVM context, encompassing details such as
@@ -349,15 +393,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	leftoverGas := msg.GasLimit
 
 	// Allow the tracer captures the txs level events, mainly the gas consumption.
-	var aspectLogger asptypes.AspectLogger
-	if tracer != nil {
-		tracer.CaptureTxStart(leftoverGas)
-		defer func() {
-			tracer.CaptureTxEnd(leftoverGas)
-		}()
-
-		aspectLogger, _ = tracer.(asptypes.AspectLogger)
-	}
+	var aspectLogger asptypes.AspectLogger = aspectGas
+	aspectGas.CaptureTxStart(leftoverGas)
+	defer func() {
+		aspectGas.CaptureTxEnd(leftoverGas)
+	}()
 
 	sender := vm.AccountRef(msg.From)
 	contractCreation := msg.To == nil
@@ -531,5 +571,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		Ret:     ret,
 		Logs:    support.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
+
+		AspectGasUsed: aspectGas.Usages(),
 	}, nil
 }
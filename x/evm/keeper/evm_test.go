@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+func TestReceiptLogs(t *testing.T) {
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	// the aspect log is emitted before the call, the contract log is emitted inside the call
+	stateDB := states.New(cosmos.Context{}, nil, states.TxConfig{})
+	stateDB.AddLog(&ethereum.Log{Address: aspectID})
+	snapshot := stateDB.Snapshot()
+	stateDB.AddLog(&ethereum.Log{Address: contract})
+
+	succeeded := &txs.MsgEthereumTxResponse{Logs: support.NewLogsFromEth(stateDB.Logs())}
+	require.Len(t, receiptLogs(succeeded), 2)
+
+	// the reverted call drops the contract log only, the aspect log is left
+	stateDB.RevertToSnapshot(snapshot)
+	failed := &txs.MsgEthereumTxResponse{
+		Logs:    support.NewLogsFromEth(stateDB.Logs()),
+		VmError: "execution reverted",
+	}
+	require.Len(t, failed.Logs, 1)
+	require.Equal(t, aspectID.Hex(), failed.Logs[0].Address)
+	require.Nil(t, receiptLogs(failed))
+
+	// a failed transaction without aspect logs is not changed
+	require.Nil(t, receiptLogs(&txs.MsgEthereumTxResponse{VmError: "execution reverted"}))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/artela-network/artela-evm/tracers"
@@ -239,6 +240,9 @@ func (k Keeper) EthCall(c context.Context, req *txs.EthCallRequest) (*txs.MsgEth
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := setCallOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -320,6 +324,9 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if err := setCallOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -389,6 +396,186 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	return &txs.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api.
+// It repeatedly executes the call with an access list tracer, feeding the access list collected
+// in the previous round into the next one, until the access list does not change anymore.
+func (k Keeper) CreateAccessList(c context.Context, req *txs.EthCallRequest) (*txs.QueryCreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var args txs.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := setCallOverrides(cfg, req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender and the recipient are always warm, so they are excluded from the access list
+	from := args.GetFrom()
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	// precompiles are always warm, so they are excluded from the access list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+	precompiles := vm.ActivePrecompiles(rules)
+
+	// create an initial tracer
+	prevTracer := logger.NewAccessListTracer(nil, from, to, precompiles)
+	if args.AccessList != nil {
+		prevTracer = logger.NewAccessListTracer(*args.AccessList, from, to, precompiles)
+	}
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	isCustomVerification := len(args.GetValidationData()) > 0
+	for {
+		// retrieve the current access list to expand
+		accessList := prevTracer.AccessList()
+		k.Logger(ctx).Debug("creating access list", "input", accessList)
+
+		// apply the access list collected in the last round
+		args.AccessList = &accessList
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// apply the message with the access list tracer, using a cache context
+		// to avoid the state changes affecting each round
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		rsp, err := k.applyAccessListRound(ctx, &args, msg, tracer, cfg, txConfig, isCustomVerification)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to apply transaction: %s err: %v", args.String(), err)
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &txs.QueryCreateAccessListResponse{
+				AccessList: txs.NewAccessList(&accessList),
+				GasUsed:    rsp.GasUsed,
+				VmError:    rsp.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// applyAccessListRound executes a single round of CreateAccessList with the given tracer.
+func (k Keeper) applyAccessListRound(ctx cosmos.Context, args *txs.TransactionArgs, msg *core.Message, tracer vm.EVMLogger,
+	cfg *states.EVMConfig, txConfig states.TxConfig, isCustomVerification bool,
+) (*txs.MsgEthereumTxResponse, error) {
+	tmpCtx, _ := ctx.CacheContext()
+	// Aspect Runtime Context Lifecycle: create aspect context.
+	// This marks the beginning of running an aspect of CreateAccessList, creating the aspect context,
+	// and establishing the link with the SDK context.
+	cosmosCtx, aspectCtx := k.WithAspectContext(tmpCtx, args.ToTransaction().AsEthCallTransaction(), cfg,
+		artelatypes.NewEthBlockContextFromQuery(tmpCtx, k.clientContext))
+	defer aspectCtx.Destroy()
+
+	// pass false to not commit StateDB
+	return k.ApplyMessageWithConfig(cosmosCtx, aspectCtx, msg, tracer, false, cfg, txConfig, isCustomVerification)
+}
+
+// SimulateAspects implements aspect_simulate rpc api.
+// It runs the transaction through the whole execution path in a cache context, including the
+// aspect verification of the unsigned transaction, and returns the results of the executed aspects.
+func (k Keeper) SimulateAspects(c context.Context, req *txs.QuerySimulateAspectsRequest) (*txs.QuerySimulateAspectsResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tx := req.Msg.AsTransaction()
+
+	// Aspect Runtime Context Lifecycle: create aspect context.
+	// This marks the beginning of running an aspect of SimulateAspects, creating the aspect context,
+	// and establishing the link with the SDK context.
+	cacheCtx, _ := ctx.CacheContext()
+	ctx, aspectCtx := k.WithAspectContext(cacheCtx, tx, cfg,
+		artelatypes.NewEthBlockContextFromQuery(cacheCtx, k.clientContext))
+	defer aspectCtx.Destroy()
+
+	// the tracer is created before the verification, so the verifier aspect is traced as well
+	tracer, err := txs.NewAspectTracer(&tracers.Context{TxHash: tx.Hash()}, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	aspectTracer := tracer.(*txs.AspectTracer)
+
+	// the query must not touch the verification cache shared by the block execution
+	isCustomVerification := k.isCustomizedVerification(tx)
+	sender, _, err := k.VerifySigNoCache(ctx, tx, aspectTracer)
+	if err != nil {
+		// fallback to the given sender only if the transaction is not signed
+		if isCustomVerification || req.Msg.From == "" || isSignedTx(tx) {
+			aspects, marshalErr := json.Marshal(aspectTracer.Aspects())
+			if marshalErr != nil {
+				return nil, status.Error(codes.Internal, marshalErr.Error())
+			}
+			return &txs.QuerySimulateAspectsResponse{VerifyError: err.Error(), Aspects: aspects}, nil
+		}
+		sender = common.HexToAddress(req.Msg.From)
+	}
+
+	// the sender has been resolved above, so the sender recovery error can be ignored here
+	msg, _ := txs.ToMessage(tx, ethereum.LatestSignerForChainID(chainID), cfg.BaseFee)
+	msg.From = sender
+	if msg.Data, err = k.processMsgData(tx); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	txConfig.TxHash = tx.Hash()
+
+	// pass false to not commit StateDB
+	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, false, cfg, txConfig, isCustomVerification)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	aspects, err := json.Marshal(aspectTracer.Aspects())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &txs.QuerySimulateAspectsResponse{
+		Sender:  sender.Hex(),
+		GasUsed: res.GasUsed,
+		Ret:     res.Ret,
+		VmError: res.VmError,
+		Aspects: aspects,
+	}, nil
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -674,8 +861,9 @@ func (k Keeper) GetSender(c context.Context, in *txs.MsgEthereumTx) (*txs.GetSen
 		evmConfig, artelatypes.NewEthBlockContextFromHeight(ctx.BlockHeight()))
 	defer aspectCtx.Destroy()
 
+	// the query must not touch the verification cache shared by the block execution
 	tx := in.AsTransaction()
-	sender, _, err := k.tryAspectVerifier(ctx, tx)
+	sender, _, err := k.aspectVerify(ctx, tx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -699,6 +887,31 @@ func (k Keeper) WithAspectContext(ctx cosmos.Context, tx *ethereum.Transaction,
 	return ctx.WithValue(artelatypes.AspectContextKey, aspectCtx), aspectCtx
 }
 
+// isSignedTx returns whether the transaction carries a signature.
+func isSignedTx(tx *ethereum.Transaction) bool {
+	_, r, s := tx.RawSignatureValues()
+	return (r != nil && r.Sign() != 0) || (s != nil && s.Sign() != 0)
+}
+
+// setCallOverrides decodes the state and block overrides of the eth_call request into the evm config.
+func setCallOverrides(cfg *states.EVMConfig, req *txs.EthCallRequest) error {
+	if len(req.Overrides) > 0 {
+		var overrides states.StateOverride
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return fmt.Errorf("invalid state overrides: %w", err)
+		}
+		cfg.Overrides = &overrides
+	}
+	if len(req.BlockOverrides) > 0 {
+		var blockOverrides states.BlockOverrides
+		if err := json.Unmarshal(req.BlockOverrides, &blockOverrides); err != nil {
+			return fmt.Errorf("invalid block overrides: %w", err)
+		}
+		cfg.BlockOverrides = &blockOverrides
+	}
+	return nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx cosmos.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -34,3 +34,11 @@ func (m Migrator) Migrate7to8(ctx sdk.Context) error {
 	// no historic data to migrate
 	return nil
 }
+
+// Migrate8to9 migrates the store from consensus version 8 to 9, the existing chains start recording
+// the aspect gas in the tx results from the upgrade.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.RecordAspectGas = true
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela/x/aspect/types"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+const (
+	// MaxSchedulesPerBlock is the max number of scheduled calls executed in a block,
+	// the due schedules exceeding the limit are postponed to the following blocks.
+	MaxSchedulesPerBlock = 100
+
+	// MaxScheduleGasPerBlock is the max total gas limit of the scheduled calls executed in a block, the due
+	// schedules exceeding the limit are postponed to the following blocks. It's no less than the max gas limit
+	// of a single schedule, so the first due schedule is always executed.
+	MaxScheduleGasPerBlock = 2 * aspectmoduletypes.MaxScheduleGasLimit
+)
+
+// ExecuteSchedules executes the scheduled calls due at the current block. Each call is sent from and
+// paid by the payer of the schedule at the current base fee, the gas fee of the whole gas limit is deducted
+// before the execution and the leftover is refunded afterward, like a normal transaction. A failed
+// execution does not cancel the schedule, the error is recorded in the last result of the schedule.
+// The due schedules are executed in the queue order until the count or the total gas limit of the
+// block is reached, the rest stay in the queue for the following blocks.
+func (k *Keeper) ExecuteSchedules(ctx cosmos.Context) {
+	// gas costs of the scheduled calls are paid by the payers
+	ctx = ctx.WithGasMeter(cosmos.NewInfiniteGasMeter())
+
+	evmStoreKey, aspectStoreKey := artelatypes.StoreKeys()
+	storeCtx := aspectmoduletypes.NewGasFreeStoreContext(ctx, aspectStoreKey, evmStoreKey)
+	entries := store.LoadDueSchedules(storeCtx, ctx.BlockHeight(), MaxSchedulesPerBlock)
+	if len(entries) == 0 {
+		return
+	}
+
+	evmConfig, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.ChainID())
+	if err != nil {
+		k.Logger(ctx).Error("failed to load evm config for schedules", "err", err)
+		return
+	}
+
+	gasBudget := MaxScheduleGasPerBlock
+	for _, entry := range entries {
+		schedule, err := store.LoadSchedule(storeCtx, entry.ID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to load schedule", "id", entry.ID, "err", err)
+			store.DequeueSchedule(storeCtx, entry.Height, entry.ID)
+			continue
+		}
+		// stale queue entry, should not happen
+		if schedule == nil || schedule.Status != aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_ACTIVE ||
+			schedule.NextHeight != entry.Height {
+			store.DequeueSchedule(storeCtx, entry.Height, entry.ID)
+			continue
+		}
+
+		// the schedules registered before the gas limit was capped may never fit in a block
+		if schedule.GasLimit > MaxScheduleGasPerBlock {
+			store.DequeueSchedule(storeCtx, entry.Height, entry.ID)
+			schedule.Status = aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_CANCELLED
+			schedule.LastResult = &aspectmoduletypes.ScheduleResult{
+				Height: ctx.BlockHeight(),
+				Error:  fmt.Sprintf("gas limit exceeds the max schedule gas per block %d", MaxScheduleGasPerBlock),
+			}
+			if err := store.StoreSchedule(storeCtx, schedule); err != nil {
+				k.Logger(ctx).Error("failed to update schedule", "id", schedule.Id, "err", err)
+			}
+			continue
+		}
+
+		// keep the queue order, the schedule and the rest are executed in the following blocks
+		if schedule.GasLimit > gasBudget {
+			break
+		}
+		gasBudget -= schedule.GasLimit
+
+		store.DequeueSchedule(storeCtx, entry.Height, entry.ID)
+
+		// a call not paid for is not counted as executed
+		var charged bool
+		schedule.LastResult, charged = k.executeSchedule(ctx, evmConfig, schedule)
+		if charged {
+			schedule.Executed++
+		}
+		if schedule.LastResult.Error != "" {
+			k.Logger(ctx).Info("scheduled call failed", "id", schedule.Id, "aspect", schedule.AspectId,
+				"err", schedule.LastResult.Error)
+		}
+
+		if schedule.Interval > 0 && (schedule.Count == 0 || schedule.Executed < schedule.Count) {
+			schedule.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+			store.EnqueueSchedule(storeCtx, schedule.NextHeight, schedule.Id)
+		} else {
+			schedule.Status = aspectmoduletypes.ScheduleStatus_SCHEDULE_STATUS_FINISHED
+		}
+
+		if err := store.StoreSchedule(storeCtx, schedule); err != nil {
+			k.Logger(ctx).Error("failed to update schedule", "id", schedule.Id, "err", err)
+		}
+	}
+}
+
+// executeSchedule executes the scheduled call in a cache context, which is committed only if the
+// gas fee is paid and the message is applied, a reverted call is still committed to charge the fee.
+// It reports whether the cache context is committed, i.e. the gas fee is charged.
+// There is no receipt for the scheduled call, its logs are emitted as the tx log events of the
+// schedule execution and added to the block bloom.
+func (k *Keeper) executeSchedule(ctx cosmos.Context, evmConfig *states.EVMConfig, schedule *aspectmoduletypes.Schedule,
+) (result *aspectmoduletypes.ScheduleResult, charged bool) {
+	result = &aspectmoduletypes.ScheduleResult{Height: ctx.BlockHeight()}
+
+	// host apis and the evm may panic on unexpected errors, which must not halt the chain in begin block
+	defer func() {
+		if r := recover(); r != nil {
+			result.Error = fmt.Sprintf("scheduled call panic: %v", r)
+		}
+	}()
+
+	payer, target := common.HexToAddress(schedule.Payer), common.HexToAddress(schedule.Target)
+	gasPrice := new(big.Int)
+	if evmConfig.BaseFee != nil {
+		gasPrice.Set(evmConfig.BaseFee)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(schedule.GasLimit))
+	if fee.Sign() > 0 {
+		fees := cosmos.Coins{cosmos.NewCoin(evmConfig.Params.EvmDenom, sdkmath.NewIntFromBigInt(fee))}
+		if err := k.DeductTxCostsFromUserBalance(cacheCtx, fees, payer); err != nil {
+			result.Error = err.Error()
+			return result, false
+		}
+	}
+
+	// the scheduled call is not signed, the synthetic tx only carries the call for the aspect runtime context
+	tx := ethereum.NewTx(&ethereum.LegacyTx{
+		Nonce:    schedule.Executed,
+		GasPrice: gasPrice,
+		Gas:      schedule.GasLimit,
+		To:       &target,
+		Data:     schedule.Calldata,
+	})
+	msg := &core.Message{
+		From:              payer,
+		To:                &target,
+		Value:             new(big.Int),
+		GasLimit:          schedule.GasLimit,
+		GasPrice:          gasPrice,
+		GasFeeCap:         gasPrice,
+		GasTipCap:         new(big.Int),
+		Data:              schedule.Calldata,
+		SkipAccountChecks: true,
+	}
+
+	cacheCtx, aspectCtx := k.WithAspectContext(cacheCtx, tx, evmConfig, k.BlockContext)
+	defer aspectCtx.Destroy()
+
+	txConfig := states.NewTxConfig(common.BytesToHash(cacheCtx.HeaderHash()), tx.Hash(), 0, 0, ethereum.LegacyTxType)
+	res, err := k.ApplyMessageWithConfig(cacheCtx, aspectCtx, msg, nil, true, evmConfig, txConfig, false)
+	if err != nil {
+		result.Error = err.Error()
+		return result, false
+	}
+
+	if err := k.RefundGas(cacheCtx, msg, schedule.GasLimit-res.GasUsed, evmConfig.Params.EvmDenom); err != nil {
+		result.Error = err.Error()
+		return result, false
+	}
+
+	if err := k.emitScheduleEvents(cacheCtx, schedule, tx.Hash(), res); err != nil {
+		result.Error = err.Error()
+		return result, false
+	}
+
+	writeCache()
+	charged = true
+
+	result.GasUsed = res.GasUsed
+	result.Ret = res.Ret
+	result.Error = res.VmError
+	return result, charged
+}
+
+// emitScheduleEvents emits the events of the schedule execution, and adds the logs to the block bloom.
+func (k *Keeper) emitScheduleEvents(ctx cosmos.Context, schedule *aspectmoduletypes.Schedule, txHash common.Hash,
+	res *txs.MsgEthereumTxResponse,
+) error {
+	attrs := []cosmos.Attribute{
+		cosmos.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+		cosmos.NewAttribute(types.AttributeKeyAspectID, schedule.AspectId),
+		cosmos.NewAttribute(types.AttributeKeyEthereumTxHash, txHash.Hex()),
+		cosmos.NewAttribute(types.AttributeKeyRecipient, schedule.Target),
+		cosmos.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+	}
+	if res.Failed() {
+		attrs = append(attrs, cosmos.NewAttribute(types.AttributeKeyEthereumTxFailed, res.VmError))
+	}
+
+	txLogAttrs := make([]cosmos.Attribute, len(res.Logs))
+	for i, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = cosmos.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	ctx.EventManager().EmitEvents(cosmos.Events{
+		cosmos.NewEvent(types.EventTypeSchedule, attrs...),
+		cosmos.NewEvent(types.EventTypeTxLog, txLogAttrs...),
+	})
+
+	if logs := support.LogsToEthereum(res.Logs); len(logs) > 0 {
+		bloom := k.GetBlockBloomTransient(ctx)
+		bloom.Or(bloom, new(big.Int).SetBytes(ethereum.LogsBloom(logs)))
+		k.SetBlockBloomTransient(ctx, bloom)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,10 +15,26 @@ import (
 	"github.com/artela-network/artela/ethereum/utils"
 	artelatype "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/aspect-core/djpm"
+	asptypes "github.com/artela-network/aspect-core/types"
 )
 
+// VerifySig verifies the signature of the transaction and returns the sender, the transaction without
+// signature is verified by the verifier aspect of the called contract, and the result is cached for
+// the block execution.
 func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error) {
+	return k.verifySig(ctx, tx, true, nil)
+}
+
+// VerifySigNoCache verifies the transaction same as VerifySig, but it never reads or writes the aspect
+// verification cache shared by the block execution, so it's safe to be called by the queries. The
+// verifier aspect execution is reported to the tracer, if it's not nil.
+func (k *Keeper) VerifySigNoCache(ctx cosmos.Context, tx *ethereum.Transaction, tracer asptypes.AspectLogger) (common.Address, []byte, error) {
+	return k.verifySig(ctx, tx, false, tracer)
+}
+
+func (k *Keeper) verifySig(ctx cosmos.Context, tx *ethereum.Transaction, cache bool, tracer asptypes.AspectLogger) (common.Address, []byte, error) {
 	// tx without signature
 	txConfig := k.TxConfig(ctx, tx.Hash(), tx.Type())
 	stateDB := states.New(ctx, k, txConfig)
@@ -26,6 +43,9 @@ func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common
 	// this verification method is only allowed in call contract,
 	// transactions that transfer value or creating contract must be signed
 	if k.isCustomizedVerification(tx) && (stateDB.GetCodeHash(*tx.To()) != common.Hash{}) {
+		if !cache {
+			return k.aspectVerify(ctx, tx, tracer)
+		}
 		return k.tryAspectVerifier(ctx, tx)
 	}
 
@@ -55,39 +75,138 @@ func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common
 	return sender, nil, nil
 }
 
+// aspectVerification is the result of the transaction verification with the verifier aspect.
+type aspectVerification struct {
+	sender   common.Address
+	callData []byte
+	err      error
+	// verifier is the id of the verifier aspect executed, zero if the verification fails before the execution
+	verifier common.Address
+	gasUsed  uint64
+}
+
 func (k *Keeper) tryAspectVerifier(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error) {
 	value, ok := k.VerifySigCache.Load(tx.Hash())
 	if ok {
-		retValue := value.(struct {
-			sender   common.Address
-			callData []byte
-			err      error
-		})
-		return retValue.sender, retValue.callData, retValue.err
+		verification := value.(aspectVerification)
+		return verification.sender, verification.callData, verification.err
+	}
+
+	verification := k.verifyWithAspect(ctx, tx, nil)
+
+	// not cache for eth_all, which hash is empty
+	if tx.Hash() != (common.Hash{}) {
+		k.VerifySigCache.Store(tx.Hash(), verification)
 	}
 
+	return verification.sender, verification.callData, verification.err
+}
+
+// aspectVerifyGasUsage returns the gas consumed by the verifier aspect of the transaction verified in the
+// current block, false if the transaction is not verified by any aspect.
+func (k *Keeper) aspectVerifyGasUsage(tx *ethereum.Transaction) (txs.AspectGasUsage, bool) {
+	value, ok := k.VerifySigCache.Load(tx.Hash())
+	if !ok {
+		return txs.AspectGasUsage{}, false
+	}
+	verification := value.(aspectVerification)
+	if verification.verifier == (common.Address{}) {
+		return txs.AspectGasUsage{}, false
+	}
+
+	return txs.AspectGasUsage{
+		AspectId:  verification.verifier.Hex(),
+		JoinPoint: asptypes.JoinPointRunType_VerifyTx.String(),
+		GasUsed:   verification.gasUsed,
+	}, true
+}
+
+// aspectVerify verifies the transaction without signature with the verifier aspect bound to the called
+// contract, and checks the sender accepts the verifier. It's the same as djpm.GetSenderAndCallData, except
+// that the verifier execution is reported to the tracer.
+func (k *Keeper) aspectVerify(ctx cosmos.Context, tx *ethereum.Transaction, tracer asptypes.AspectLogger) (common.Address, []byte, error) {
+	verification := k.verifyWithAspect(ctx, tx, tracer)
+	return verification.sender, verification.callData, verification.err
+}
+
+// verifyWithAspect runs the verifier aspect of the transaction, see aspectVerify.
+func (k *Keeper) verifyWithAspect(ctx cosmos.Context, tx *ethereum.Transaction, tracer asptypes.AspectLogger) aspectVerification {
 	// retrieve aspectCtx from sdk.Context
 	aspectCtx, ok := ctx.Value(artelatype.AspectContextKey).(*artelatype.AspectRuntimeContext)
 	if !ok {
-		return common.Address{}, []byte{}, errors.New("aspect transaction verification failed")
+		return aspectVerification{callData: []byte{}, err: errors.New("aspect transaction verification failed")}
 	}
 
-	sender, call, err := djpm.AspectInstance().GetSenderAndCallData(aspectCtx, aspectCtx.EthBlockContext().BlockHeader().Number.Int64(), tx)
+	// the data is encoded as abi.encode(validationData, callData), validationData is passed to
+	// the verifier aspect and callData is passed to the contract
+	validation, call, err := djpm.DecodeValidationAndCallData(tx.Data())
+	if err != nil {
+		return aspectVerification{err: err}
+	}
+	if tx.To() == nil {
+		return aspectVerification{err: errors.New("contract creation is not allowed for customized verification")}
+	}
 
-	// not cache for eth_all, which hash is empty
-	if tx.Hash() != (common.Hash{}) {
-		k.VerifySigCache.Store(tx.Hash(), struct {
-			sender   common.Address
-			callData []byte
-			err      error
-		}{
-			sender:   sender,
-			callData: call,
-			err:      err,
-		})
-	}
-
-	return sender, call, err
+	verifiers, err := k.aspect.GetAccountVerifiers(aspectCtx, *tx.To())
+	if err != nil {
+		return aspectVerification{err: err}
+	}
+	if len(verifiers) != 1 {
+		return aspectVerification{err: fmt.Errorf("invalid number of contract verifiers: %d", len(verifiers))}
+	}
+	verifier := verifiers[0]
+
+	block := aspectCtx.EthBlockContext().BlockHeader().Number.Int64()
+	uintBlock := uint64(block)
+	request := &asptypes.TxVerifyInput{
+		Tx: &asptypes.NoFromTxInput{
+			Hash: tx.Hash().Bytes(),
+			To:   tx.To().Bytes(),
+		},
+		Block:          &asptypes.BlockInput{Number: &uintBlock},
+		ValidationData: validation,
+		CallData:       call,
+	}
+
+	// the verifier execution is not counted into the execution gas, a fixed gas is given instead
+	if tracer != nil {
+		if hostLogger, ok := tracer.(artelatype.AspectHostLogger); ok {
+			hostLogger.CaptureAspectBindings(asptypes.VERIFY_TX, verifiers)
+			aspectCtx.WithVerifyLogger(hostLogger)
+		}
+		tracer.CaptureAspectEnter(asptypes.JoinPointRunType_VerifyTx, common.Address{}, *tx.To(),
+			common.HexToAddress(verifier.AspectId), nil, djpm.MaxTxVerificationGas, nil, request)
+	}
+	res := djpm.AspectInstance().VerifyTx(aspectCtx, *tx.To(), block, djpm.MaxTxVerificationGas, request)
+	if tracer != nil {
+		tracer.CaptureAspectExit(asptypes.JoinPointRunType_VerifyTx, res)
+		aspectCtx.WithVerifyLogger(nil)
+	}
+
+	verification := aspectVerification{verifier: common.HexToAddress(verifier.AspectId)}
+	if res.Gas < djpm.MaxTxVerificationGas {
+		verification.gasUsed = djpm.MaxTxVerificationGas - res.Gas
+	}
+	if res.Err != nil {
+		verification.err = res.Err
+		return verification
+	}
+
+	// make sure sender accepts this aspect as verifier
+	sender := common.BytesToAddress(res.Ret)
+	senderVerifiers, err := k.aspect.GetAccountVerifiers(aspectCtx, sender)
+	if err != nil {
+		verification.err = err
+		return verification
+	}
+	for _, senderVerifier := range senderVerifiers {
+		if senderVerifier.AspectId == verifier.AspectId {
+			verification.sender, verification.callData = sender, call
+			return verification
+		}
+	}
+	verification.err = errors.New("unable to verify tx with aspect")
+	return verification
 }
 
 func (k *Keeper) MakeSigner(ctx cosmos.Context, tx *ethereum.Transaction, config *params.ChainConfig, blockNumber *big.Int, blockTime uint64) ethereum.Signer {
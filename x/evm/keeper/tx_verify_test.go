@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+func TestAspectVerifyGasUsage(t *testing.T) {
+	k := &Keeper{VerifySigCache: &sync.Map{}}
+	verifier := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx := ethereum.NewTx(&ethereum.LegacyTx{Nonce: 1})
+
+	// not verified with aspect
+	_, ok := k.aspectVerifyGasUsage(tx)
+	require.False(t, ok)
+
+	// failed before the verifier runs
+	k.VerifySigCache.Store(tx.Hash(), aspectVerification{err: errors.New("invalid number of contract verifiers")})
+	_, ok = k.aspectVerifyGasUsage(tx)
+	require.False(t, ok)
+
+	// the verifier gas is recorded even if the verification fails
+	k.VerifySigCache.Store(tx.Hash(), aspectVerification{
+		verifier: verifier,
+		gasUsed:  1000,
+		err:      errors.New("unable to verify tx with aspect"),
+	})
+	usage, ok := k.aspectVerifyGasUsage(tx)
+	require.True(t, ok)
+	require.Equal(t, txs.AspectGasUsage{
+		AspectId:  verifier.Hex(),
+		JoinPoint: asptypes.JoinPointRunType_VerifyTx.String(),
+		GasUsed:   1000,
+	}, usage)
+}
@@ -24,7 +24,7 @@ import (
 )
 
 // TODO mark ConsensusVersion defines the current x/evm module consensus version.
-const ConsensusVersion = 8
+const ConsensusVersion = 9
 
 var (
 	_ module.AppModule      = AppModule{}
@@ -130,6 +130,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
 		panic(err)
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(err)
+	}
 }
 
 // NewAppModule creates a new AppModule object
@@ -16,6 +16,10 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides is the state overrides applied to the StateDB before the message is executed
+	Overrides *StateOverride
+	// BlockOverrides is the block header overrides applied to the EVM block context
+	BlockOverrides *BlockOverrides
 }
 
 // TxConfig encapulates the readonly information of current txs for `StateDB`.
@@ -23,6 +23,8 @@ type stateObject struct {
 	originStorage Storage
 	// Storage entries that have been modified in the current transaction execution
 	dirtyStorage Storage
+	// Fake storage which constructed by caller for debugging purpose, e.g. state overrides of eth_call
+	fakeStorage Storage
 
 	address common.Address
 
@@ -100,6 +102,10 @@ func (s *stateObject) setBalance(amount *big.Int) {
 
 // GetCommittedState query the committed states
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
+	// If the fake storage is set, only lookup the states here (in the debugging mode)
+	if s.fakeStorage != nil {
+		return s.fakeStorage[key]
+	}
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
@@ -137,6 +143,18 @@ func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
 
+// SetStorage replaces the entire storage of the account with the given one,
+// it should only be used for debugging and the mutations must be discarded afterwards.
+func (s *stateObject) SetStorage(storage map[common.Hash]common.Hash) {
+	// Allocate fake storage if it's nil.
+	if s.fakeStorage == nil {
+		s.fakeStorage = make(Storage)
+	}
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+}
+
 // ----------------------------------------------------------------------------
 // 							 attribute accessors
 // ----------------------------------------------------------------------------
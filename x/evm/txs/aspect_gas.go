@@ -0,0 +1,122 @@
+package txs
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela-evm/vm"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+var (
+	_ vm.EVMLogger                 = (*AspectGasRecorder)(nil)
+	_ asptypes.AspectLogger        = (*AspectGasRecorder)(nil)
+	_ artelatypes.AspectHostLogger = (*AspectGasRecorder)(nil)
+)
+
+// AspectGasRecorder wraps the evm tracer of a transaction and records the gas consumed by each aspect
+// at each join point. The evm events are delegated to the wrapped tracer, and the aspect events are
+// forwarded if the wrapped tracer implements asptypes.AspectLogger or artelatypes.AspectHostLogger.
+// An aspect may trigger other aspects, e.g. the static call of an aspect calls a contract with aspects
+// bound, the gas of the nested aspects is recorded for themselves only, not their callers.
+type AspectGasRecorder struct {
+	vm.EVMLogger
+
+	usages []AspectGasUsage
+	// index of the usages by join point and aspect id
+	index map[asptypes.JoinPointRunType]map[common.Address]int
+	// running is the stack of the aspect executions not finished yet
+	running []runningAspect
+}
+
+// runningAspect is an aspect execution not finished yet
+type runningAspect struct {
+	usage int
+	gas   uint64
+	// nested is the gas consumed by the aspects triggered by this execution
+	nested uint64
+}
+
+// NewAspectGasRecorder creates a new aspect gas recorder on top of the given tracer.
+func NewAspectGasRecorder(tracer vm.EVMLogger) *AspectGasRecorder {
+	return &AspectGasRecorder{
+		EVMLogger: tracer,
+		index:     make(map[asptypes.JoinPointRunType]map[common.Address]int),
+	}
+}
+
+// CaptureAspectEnter implements asptypes.AspectLogger interface
+func (r *AspectGasRecorder) CaptureAspectEnter(joinpoint asptypes.JoinPointRunType, from, to, aspectId common.Address,
+	input []byte, gas uint64, value *big.Int, execCtx proto.Message,
+) {
+	if _, ok := r.index[joinpoint]; !ok {
+		r.index[joinpoint] = make(map[common.Address]int)
+	}
+	usage, ok := r.index[joinpoint][aspectId]
+	if !ok {
+		usage = len(r.usages)
+		r.index[joinpoint][aspectId] = usage
+		r.usages = append(r.usages, AspectGasUsage{
+			AspectId:  aspectId.Hex(),
+			JoinPoint: joinpoint.String(),
+		})
+	}
+	r.running = append(r.running, runningAspect{usage: usage, gas: gas})
+
+	if logger, ok := r.EVMLogger.(asptypes.AspectLogger); ok {
+		logger.CaptureAspectEnter(joinpoint, from, to, aspectId, input, gas, value, execCtx)
+	}
+}
+
+// CaptureAspectExit implements asptypes.AspectLogger interface
+func (r *AspectGasRecorder) CaptureAspectExit(joinpoint asptypes.JoinPointRunType, result *asptypes.AspectExecutionResult) {
+	if logger, ok := r.EVMLogger.(asptypes.AspectLogger); ok {
+		logger.CaptureAspectExit(joinpoint, result)
+	}
+
+	if len(r.running) == 0 {
+		return
+	}
+	running := r.running[len(r.running)-1]
+	r.running = r.running[:len(r.running)-1]
+
+	if result == nil || running.gas <= result.Gas {
+		return
+	}
+	gasUsed := running.gas - result.Gas
+	if len(r.running) > 0 {
+		r.running[len(r.running)-1].nested += gasUsed
+	}
+	if gasUsed > running.nested {
+		r.usages[running.usage].GasUsed += gasUsed - running.nested
+	}
+}
+
+// CaptureAspectBindings implements artelatypes.AspectHostLogger interface
+func (r *AspectGasRecorder) CaptureAspectBindings(point asptypes.PointCut, aspects []*asptypes.AspectCode) {
+	if logger, ok := r.EVMLogger.(artelatypes.AspectHostLogger); ok {
+		logger.CaptureAspectBindings(point, aspects)
+	}
+}
+
+// CaptureHostAPICall implements artelatypes.AspectHostLogger interface
+func (r *AspectGasRecorder) CaptureHostAPICall(ctx *asptypes.RunnerContext, api string) {
+	if logger, ok := r.EVMLogger.(artelatypes.AspectHostLogger); ok {
+		logger.CaptureHostAPICall(ctx, api)
+	}
+}
+
+// CaptureAspectStateAccess implements artelatypes.AspectHostLogger interface
+func (r *AspectGasRecorder) CaptureAspectStateAccess(ctx *asptypes.RunnerContext, key string, write bool) {
+	if logger, ok := r.EVMLogger.(artelatypes.AspectHostLogger); ok {
+		logger.CaptureAspectStateAccess(ctx, key, write)
+	}
+}
+
+// Usages returns the gas consumed by each aspect at each join point, in the order of the first execution
+func (r *AspectGasRecorder) Usages() []AspectGasUsage {
+	return r.usages
+}
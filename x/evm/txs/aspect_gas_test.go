@@ -0,0 +1,31 @@
+package txs
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+func TestAspectGasRecorderNested(t *testing.T) {
+	outer := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	inner := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	recorder := NewAspectGasRecorder(nil)
+
+	// the outer aspect triggers the inner aspect, which uses 200 of the 400 gas used by the outer aspect
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, common.Address{}, common.Address{}, outer, nil, 1000, nil, nil)
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PostContractCall, common.Address{}, common.Address{}, inner, nil, 500, nil, nil)
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PostContractCall, &asptypes.AspectExecutionResult{Gas: 300})
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 600})
+
+	// the outer aspect runs again at the same join point
+	recorder.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, common.Address{}, common.Address{}, outer, nil, 1000, nil, nil)
+	recorder.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{Gas: 900})
+
+	require.Equal(t, []AspectGasUsage{
+		{AspectId: outer.Hex(), JoinPoint: asptypes.JoinPointRunType_PreContractCall.String(), GasUsed: 300},
+		{AspectId: inner.Hex(), JoinPoint: asptypes.JoinPointRunType_PostContractCall.String(), GasUsed: 200},
+	}, recorder.Usages())
+}
@@ -0,0 +1,187 @@
+package txs
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela-evm/tracers"
+	// the call tracer wrapped by the aspect tracer
+	_ "github.com/artela-network/artela-evm/tracers/native"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+// TracerAspect is the name of the built-in tracer which traces both the evm calls and the aspect executions.
+const TracerAspect = "aspectTracer"
+
+var (
+	_ tracers.Tracer               = (*AspectTracer)(nil)
+	_ asptypes.AspectLogger        = (*AspectTracer)(nil)
+	_ artelatypes.AspectHostLogger = (*AspectTracer)(nil)
+)
+
+func init() {
+	tracers.DefaultDirectory.Register(TracerAspect, NewAspectTracer, false)
+}
+
+// AspectStateAccess is an aspect state read or write made by an aspect execution.
+type AspectStateAccess struct {
+	Key   string `json:"key"`
+	Write bool   `json:"write"`
+}
+
+// AspectTrace is the trace of an aspect execution at a join point.
+type AspectTrace struct {
+	JoinPoint    string              `json:"joinPoint"`
+	AspectID     common.Address      `json:"aspectId"`
+	Version      uint64              `json:"version"`
+	Priority     int8                `json:"priority"`
+	Gas          hexutil.Uint64      `json:"gas"`
+	GasUsed      hexutil.Uint64      `json:"gasUsed"`
+	Output       hexutil.Bytes       `json:"output,omitempty"`
+	Error        string              `json:"error,omitempty"`
+	RevertReason string              `json:"revertReason,omitempty"`
+	StateAccess  []AspectStateAccess `json:"stateAccess,omitempty"`
+	HostAPICalls []string            `json:"hostApiCalls,omitempty"`
+}
+
+// aspectTracerResult is the output of the aspect tracer.
+type aspectTracerResult struct {
+	Calls   json.RawMessage `json:"calls"`
+	Aspects []*AspectTrace  `json:"aspects"`
+}
+
+// AspectTracer records every aspect invoked at each join point on top of the call tracer,
+// the evm events are delegated to the wrapped call tracer, and the trace config is passed
+// to the call tracer as is.
+type AspectTracer struct {
+	tracers.Tracer
+
+	// bindings are the aspects resolved for each join point, used to look up the version and priority
+	bindings map[asptypes.JoinPointRunType]map[common.Address]*asptypes.AspectCode
+	aspects  []*AspectTrace
+	// running is the stack of the aspect executions not finished yet
+	running []*AspectTrace
+}
+
+// NewAspectTracer creates a new aspect tracer.
+func NewAspectTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	callTracer, err := tracers.DefaultDirectory.New("callTracer", ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AspectTracer{
+		Tracer:   callTracer,
+		bindings: make(map[asptypes.JoinPointRunType]map[common.Address]*asptypes.AspectCode),
+		aspects:  make([]*AspectTrace, 0),
+	}, nil
+}
+
+// CaptureAspectBindings implements artelatypes.AspectHostLogger interface
+func (t *AspectTracer) CaptureAspectBindings(point asptypes.PointCut, aspects []*asptypes.AspectCode) {
+	jp := asptypes.JoinPointRunType(asptypes.JoinPointRunType_value[string(point)])
+	bindings := make(map[common.Address]*asptypes.AspectCode, len(aspects))
+	for _, aspect := range aspects {
+		bindings[common.HexToAddress(aspect.AspectId)] = aspect
+	}
+	t.bindings[jp] = bindings
+}
+
+// CaptureAspectEnter implements asptypes.AspectLogger interface
+func (t *AspectTracer) CaptureAspectEnter(joinpoint asptypes.JoinPointRunType, _, _, aspectId common.Address,
+	_ []byte, gas uint64, _ *big.Int, _ proto.Message,
+) {
+	trace := &AspectTrace{
+		JoinPoint: joinpoint.String(),
+		AspectID:  aspectId,
+		Gas:       hexutil.Uint64(gas),
+	}
+	if binding, ok := t.bindings[joinpoint][aspectId]; ok {
+		trace.Version = binding.Version
+		trace.Priority = binding.Priority
+	}
+
+	t.aspects = append(t.aspects, trace)
+	t.running = append(t.running, trace)
+}
+
+// CaptureAspectExit implements asptypes.AspectLogger interface
+func (t *AspectTracer) CaptureAspectExit(_ asptypes.JoinPointRunType, result *asptypes.AspectExecutionResult) {
+	trace := t.current()
+	if trace == nil {
+		return
+	}
+	t.running = t.running[:len(t.running)-1]
+
+	if result == nil {
+		return
+	}
+	if uint64(trace.Gas) > result.Gas {
+		trace.GasUsed = trace.Gas - hexutil.Uint64(result.Gas)
+	}
+	trace.Output = result.Ret
+	if result.Err != nil {
+		trace.Error = result.Err.Error()
+		if reason, err := abi.UnpackRevert(result.Ret); err == nil {
+			trace.RevertReason = reason
+		}
+	}
+}
+
+// CaptureHostAPICall implements artelatypes.AspectHostLogger interface
+func (t *AspectTracer) CaptureHostAPICall(ctx *asptypes.RunnerContext, api string) {
+	if trace := t.currentOf(ctx); trace != nil {
+		trace.HostAPICalls = append(trace.HostAPICalls, api)
+	}
+}
+
+// CaptureAspectStateAccess implements artelatypes.AspectHostLogger interface
+func (t *AspectTracer) CaptureAspectStateAccess(ctx *asptypes.RunnerContext, key string, write bool) {
+	if trace := t.currentOf(ctx); trace != nil {
+		trace.StateAccess = append(trace.StateAccess, AspectStateAccess{Key: key, Write: write})
+	}
+}
+
+// GetResult returns the call tracer output together with the aspect traces
+func (t *AspectTracer) GetResult() (json.RawMessage, error) {
+	calls, err := t.Tracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&aspectTracerResult{
+		Calls:   calls,
+		Aspects: t.aspects,
+	})
+}
+
+// Aspects returns the traces of all the aspects executed so far, in execution order
+func (t *AspectTracer) Aspects() []*AspectTrace {
+	return t.aspects
+}
+
+func (t *AspectTracer) current() *AspectTrace {
+	if len(t.running) == 0 {
+		return nil
+	}
+	return t.running[len(t.running)-1]
+}
+
+// currentOf returns the running aspect execution which the host api call belongs to,
+// the version is filled from the runner context if it is not resolved from bindings.
+func (t *AspectTracer) currentOf(ctx *asptypes.RunnerContext) *AspectTrace {
+	trace := t.current()
+	if trace == nil || ctx == nil || trace.AspectID != ctx.AspectId {
+		return nil
+	}
+	if trace.Version == 0 {
+		trace.Version = ctx.AspectVersion
+	}
+	return trace
+}
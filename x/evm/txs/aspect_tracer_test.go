@@ -0,0 +1,97 @@
+package txs
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+)
+
+type testCallFrame struct {
+	Type  string          `json:"type"`
+	From  common.Address  `json:"from"`
+	To    common.Address  `json:"to"`
+	Input hexutil.Bytes   `json:"input"`
+	Calls []testCallFrame `json:"calls"`
+}
+
+func TestAspectTracerHostAPICall(t *testing.T) {
+	from := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	callee := common.HexToAddress("0x0000000000000000000000000000000000000003")
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000004")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000005")
+
+	tracer, err := tracers.DefaultDirectory.New(TracerAspect, &tracers.Context{}, nil)
+	require.NoError(t, err)
+	aspectTracer, ok := tracer.(*AspectTracer)
+	require.True(t, ok)
+
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, from, contract, false, []byte{0x01}, 100000, big.NewInt(0))
+
+	aspectTracer.CaptureAspectBindings(asptypes.PRE_CONTRACT_CALL_METHOD, []*asptypes.AspectCode{
+		{AspectId: aspectID.Hex(), Version: 2, Priority: 1},
+	})
+	aspectTracer.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, from, contract, aspectID, nil, 1000, nil, nil)
+
+	// the aspect makes a static call to another contract through the host api
+	runnerCtx := &asptypes.RunnerContext{AspectId: aspectID, AspectVersion: 2}
+	aspectTracer.CaptureHostAPICall(runnerCtx, "evmCall.staticCall")
+	tracer.CaptureEnter(vm.STATICCALL, contract, callee, []byte{0x02}, 500, nil)
+	tracer.CaptureExit([]byte{0x03}, 100, nil)
+	aspectTracer.CaptureAspectStateAccess(runnerCtx, "key", true)
+	// host api calls of other aspects are not attributed to the running one
+	aspectTracer.CaptureHostAPICall(&asptypes.RunnerContext{AspectId: other}, "stateDb.getBalance")
+
+	aspectTracer.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{
+		Gas: 400,
+		Ret: []byte{0x04},
+		Err: errors.New("revert"),
+	})
+	// host api calls after the aspect exits are ignored
+	aspectTracer.CaptureHostAPICall(runnerCtx, "evmCall.staticCall")
+
+	tracer.CaptureEnd(nil, 1000, nil)
+	tracer.CaptureTxEnd(50000)
+
+	raw, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var result aspectTracerResult
+	require.NoError(t, json.Unmarshal(raw, &result))
+
+	var calls testCallFrame
+	require.NoError(t, json.Unmarshal(result.Calls, &calls))
+	require.Equal(t, "CALL", calls.Type)
+	require.Equal(t, from, calls.From)
+	require.Equal(t, contract, calls.To)
+	require.Equal(t, []testCallFrame{{
+		Type:  "STATICCALL",
+		From:  contract,
+		To:    callee,
+		Input: []byte{0x02},
+	}}, calls.Calls)
+
+	require.Equal(t, []*AspectTrace{{
+		JoinPoint:    asptypes.JoinPointRunType_PreContractCall.String(),
+		AspectID:     aspectID,
+		Version:      2,
+		Priority:     1,
+		Gas:          1000,
+		GasUsed:      600,
+		Output:       []byte{0x04},
+		Error:        "revert",
+		StateAccess:  []AspectStateAccess{{Key: "key", Write: true}},
+		HostAPICalls: []string{"evmCall.staticCall"},
+	}}, result.Aspects)
+	require.Equal(t, result.Aspects, aspectTracer.Aspects())
+}
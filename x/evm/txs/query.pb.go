@@ -799,6 +799,11 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the state overrides applied before the call, uses the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the block header overrides applied to the block context, uses the same json format
+	// as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -862,6 +867,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -908,6 +927,227 @@ func (m *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// QueryCreateAccessListResponse defines CreateAccessList response
+type QueryCreateAccessListResponse struct {
+	// access_list is the EIP-2930 access list generated for the call
+	AccessList AccessList `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3,castrepeated=AccessList" json:"accessList"`
+	// gas_used is the gas used by the call with the generated access list
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by vm execution with the generated access list
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *QueryCreateAccessListResponse) Reset()         { *m = QueryCreateAccessListResponse{} }
+func (m *QueryCreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreateAccessListResponse) ProtoMessage()    {}
+func (*QueryCreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{18}
+}
+func (m *QueryCreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreateAccessListResponse.Merge(m, src)
+}
+func (m *QueryCreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreateAccessListResponse proto.InternalMessageInfo
+
+func (m *QueryCreateAccessListResponse) GetAccessList() AccessList {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *QueryCreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QueryCreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+// QuerySimulateAspectsRequest defines SimulateAspects request
+type QuerySimulateAspectsRequest struct {
+	// msg is the MsgEthereumTx to simulate, the from field is used as the sender
+	// if the transaction is neither signed nor verified by aspect
+	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,2,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QuerySimulateAspectsRequest) Reset()         { *m = QuerySimulateAspectsRequest{} }
+func (m *QuerySimulateAspectsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAspectsRequest) ProtoMessage()    {}
+func (*QuerySimulateAspectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{19}
+}
+func (m *QuerySimulateAspectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAspectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAspectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateAspectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAspectsRequest.Merge(m, src)
+}
+func (m *QuerySimulateAspectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAspectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAspectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateAspectsRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateAspectsRequest) GetMsg() *MsgEthereumTx {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QuerySimulateAspectsRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QuerySimulateAspectsRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// QuerySimulateAspectsResponse defines SimulateAspects response
+type QuerySimulateAspectsResponse struct {
+	// sender is the hex address of the transaction sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// verify_error is the error returned by the sender verification, the transaction
+	// is not executed if the verification failed
+	VerifyError string `protobuf:"bytes,2,opt,name=verify_error,json=verifyError,proto3" json:"verify_error,omitempty"`
+	// gas_used is the gas used by the transaction, including the aspect executions
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// ret is the returned data from evm function or the reverted aspect
+	Ret []byte `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
+	// vm_error is the error returned by vm execution or aspect execution
+	VmError string `protobuf:"bytes,5,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// aspects is the json encoded results of the aspects executed at each join point
+	Aspects []byte `protobuf:"bytes,6,opt,name=aspects,proto3" json:"aspects,omitempty"`
+}
+
+func (m *QuerySimulateAspectsResponse) Reset()         { *m = QuerySimulateAspectsResponse{} }
+func (m *QuerySimulateAspectsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAspectsResponse) ProtoMessage()    {}
+func (*QuerySimulateAspectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d7bc138cc47c0d0, []int{20}
+}
+func (m *QuerySimulateAspectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAspectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAspectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateAspectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAspectsResponse.Merge(m, src)
+}
+func (m *QuerySimulateAspectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAspectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAspectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateAspectsResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateAspectsResponse) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuerySimulateAspectsResponse) GetVerifyError() string {
+	if m != nil {
+		return m.VerifyError
+	}
+	return ""
+}
+
+func (m *QuerySimulateAspectsResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QuerySimulateAspectsResponse) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *QuerySimulateAspectsResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *QuerySimulateAspectsResponse) GetAspects() []byte {
+	if m != nil {
+		return m.Aspects
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -935,7 +1175,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{18}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{21}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1277,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{19}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{22}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1337,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{20}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{23}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1432,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{21}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{24}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,7 +1477,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{22}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{25}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1516,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{23}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{26}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1555,7 @@ func (m *GetSenderResponse) Reset()         { *m = GetSenderResponse{} }
 func (m *GetSenderResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSenderResponse) ProtoMessage()    {}
 func (*GetSenderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d7bc138cc47c0d0, []int{24}
+	return fileDescriptor_8d7bc138cc47c0d0, []int{27}
 }
 func (m *GetSenderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,6 +1610,9 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "artela.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "artela.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "artela.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryCreateAccessListResponse)(nil), "artela.evm.v1.QueryCreateAccessListResponse")
+	proto.RegisterType((*QuerySimulateAspectsRequest)(nil), "artela.evm.v1.QuerySimulateAspectsRequest")
+	proto.RegisterType((*QuerySimulateAspectsResponse)(nil), "artela.evm.v1.QuerySimulateAspectsResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "artela.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "artela.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "artela.evm.v1.QueryTraceBlockRequest")
@@ -1382,101 +1625,118 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/query.proto", fileDescriptor_8d7bc138cc47c0d0) }
 
 var fileDescriptor_8d7bc138cc47c0d0 = []byte{
-	// 1500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xcf, 0xc6, 0x4e, 0xec, 0x3c, 0x4e, 0xda, 0x74, 0x9a, 0x36, 0x89, 0x9b, 0xd8, 0xc9, 0xf6,
-	0x6d, 0xe2, 0x7e, 0xed, 0xbe, 0x49, 0xa5, 0xf7, 0x15, 0x48, 0x08, 0xea, 0x28, 0x0d, 0xfd, 0x42,
-	0xc5, 0x8d, 0x38, 0x20, 0x55, 0xd6, 0x78, 0x3d, 0x5d, 0x5b, 0xb1, 0x77, 0xdc, 0x9d, 0xb1, 0x71,
-	0x28, 0x11, 0x52, 0x0f, 0x08, 0x89, 0x4b, 0x25, 0xc4, 0xbd, 0x27, 0x4e, 0xdc, 0xf9, 0x17, 0x7a,
-	0xac, 0xc4, 0x05, 0x71, 0x28, 0xa8, 0xe5, 0xc0, 0x91, 0x33, 0x5c, 0xd0, 0x7c, 0xac, 0xed, 0xdd,
-	0xac, 0xe3, 0x96, 0x8f, 0x1b, 0xa7, 0xdd, 0x99, 0x79, 0xe6, 0xf9, 0xfd, 0x9e, 0x79, 0x9e, 0x99,
-	0xe7, 0x07, 0x8b, 0xd8, 0xe7, 0xa4, 0x81, 0x6d, 0xd2, 0x69, 0xda, 0x9d, 0x0d, 0xfb, 0x41, 0x9b,
-	0xf8, 0xfb, 0x56, 0xcb, 0xa7, 0x9c, 0xa2, 0x19, 0xb5, 0x64, 0x91, 0x4e, 0xd3, 0xea, 0x6c, 0x64,
-	0x2f, 0x38, 0x94, 0x35, 0x29, 0xb3, 0x2b, 0x98, 0x11, 0x65, 0x67, 0x77, 0x36, 0x2a, 0x84, 0xe3,
-	0x0d, 0xbb, 0x85, 0xdd, 0xba, 0x87, 0x79, 0x9d, 0x7a, 0x6a, 0x6b, 0x76, 0x3e, 0xec, 0x55, 0x78,
-	0x50, 0x0b, 0xa7, 0xc3, 0x0b, 0xbc, 0xab, 0xe7, 0xe7, 0x5c, 0xea, 0x52, 0xf9, 0x6b, 0x8b, 0x3f,
-	0x3d, 0xbb, 0xe4, 0x52, 0xea, 0x36, 0x88, 0x8d, 0x5b, 0x75, 0x1b, 0x7b, 0x1e, 0xe5, 0x12, 0x83,
-	0xe9, 0xd5, 0xbc, 0x5e, 0x95, 0xa3, 0x4a, 0xfb, 0xbe, 0xcd, 0xeb, 0x4d, 0xc2, 0x38, 0x6e, 0xb6,
-	0x94, 0x81, 0xf9, 0x06, 0x9c, 0x7c, 0x5f, 0xf0, 0xbc, 0xea, 0x38, 0xb4, 0xed, 0xf1, 0x12, 0x79,
-	0xd0, 0x26, 0x8c, 0xa3, 0x05, 0x48, 0xe1, 0x6a, 0xd5, 0x27, 0x8c, 0x2d, 0x18, 0x2b, 0x46, 0x61,
-	0xaa, 0x14, 0x0c, 0xdf, 0x4c, 0x7f, 0xfe, 0x24, 0x3f, 0xf6, 0xcb, 0x93, 0xfc, 0x98, 0xe9, 0xc0,
-	0x5c, 0x78, 0x2b, 0x6b, 0x51, 0x8f, 0x11, 0xb1, 0xb7, 0x82, 0x1b, 0xd8, 0x73, 0x48, 0xb0, 0x57,
-	0x0f, 0xd1, 0x19, 0x98, 0x72, 0x68, 0x95, 0x94, 0x6b, 0x98, 0xd5, 0x16, 0xc6, 0xe5, 0x5a, 0x5a,
-	0x4c, 0xbc, 0x8b, 0x59, 0x0d, 0xcd, 0xc1, 0x84, 0x47, 0xc5, 0xa6, 0xc4, 0x8a, 0x51, 0x48, 0x96,
-	0xd4, 0xc0, 0x7c, 0x1b, 0x16, 0x25, 0xc8, 0x96, 0x3c, 0xd8, 0x3f, 0xc1, 0xf2, 0x33, 0x03, 0xb2,
-	0x71, 0x1e, 0x34, 0xd9, 0x73, 0x70, 0x4c, 0xe5, 0xac, 0x1c, 0xf6, 0x34, 0xa3, 0x66, 0xaf, 0xaa,
-	0x49, 0x94, 0x85, 0x34, 0x13, 0xa0, 0x82, 0xdf, 0xb8, 0xe4, 0xd7, 0x1b, 0x0b, 0x17, 0x58, 0x79,
-	0x2d, 0x7b, 0xed, 0x66, 0x85, 0xf8, 0x3a, 0x82, 0x19, 0x3d, 0xfb, 0x9e, 0x9c, 0x34, 0x6f, 0xc2,
-	0x92, 0xe4, 0xf1, 0x01, 0x6e, 0xd4, 0xab, 0x98, 0x53, 0x3f, 0x12, 0xcc, 0x2a, 0x4c, 0x3b, 0xd4,
-	0x8b, 0xf2, 0xc8, 0x88, 0xb9, 0xab, 0x87, 0xa2, 0xfa, 0xc2, 0x80, 0xe5, 0x21, 0xde, 0x74, 0x60,
-	0xeb, 0x70, 0x3c, 0x60, 0x15, 0xf6, 0x18, 0x90, 0xfd, 0x1b, 0x43, 0x0b, 0x8a, 0xa8, 0xa8, 0xf2,
-	0xfc, 0x3a, 0xe9, 0xf9, 0xaf, 0x2e, 0xa2, 0xde, 0xd6, 0x51, 0x45, 0x64, 0xde, 0xd4, 0x60, 0x77,
-	0x39, 0xf5, 0xb1, 0x3b, 0x1a, 0x0c, 0xcd, 0x42, 0x62, 0x8f, 0xec, 0xeb, 0x7a, 0x13, 0xbf, 0x03,
-	0xf0, 0x97, 0x34, 0x7c, 0xcf, 0x99, 0x86, 0x9f, 0x83, 0x89, 0x0e, 0x6e, 0xb4, 0x03, 0x70, 0x35,
-	0x30, 0xff, 0x07, 0xb3, 0xba, 0x94, 0xaa, 0xaf, 0x15, 0xe4, 0x3a, 0x9c, 0x18, 0xd8, 0xa7, 0x21,
-	0x10, 0x24, 0x45, 0xed, 0xcb, 0x5d, 0xd3, 0x25, 0xf9, 0x6f, 0x7e, 0x0c, 0x48, 0x1a, 0xee, 0x76,
-	0x6f, 0x51, 0x97, 0x05, 0x10, 0x08, 0x92, 0xf2, 0xc6, 0x28, 0xff, 0xf2, 0x1f, 0x5d, 0x03, 0xe8,
-	0xbf, 0x28, 0x32, 0xb6, 0xcc, 0xe6, 0x9a, 0xa5, 0x8a, 0xd6, 0x12, 0xcf, 0x8f, 0xa5, 0x9e, 0x29,
-	0xfd, 0xfc, 0x58, 0x77, 0xfa, 0x47, 0x55, 0x1a, 0xd8, 0x19, 0xbe, 0x28, 0x27, 0x43, 0xe0, 0x9a,
-	0xe7, 0x1a, 0x24, 0x1b, 0xd4, 0x15, 0xd1, 0x25, 0x0a, 0x99, 0x4d, 0x64, 0x85, 0x5e, 0x3c, 0xeb,
-	0x16, 0x75, 0x4b, 0x72, 0x1d, 0xed, 0xc4, 0x30, 0x5a, 0x1f, 0xc9, 0x48, 0x81, 0x0c, 0x52, 0x32,
-	0xe7, 0xf4, 0x21, 0xdc, 0xc1, 0x3e, 0x6e, 0x06, 0x87, 0x60, 0xde, 0xd0, 0xec, 0x82, 0x59, 0xcd,
-	0xee, 0x0a, 0x4c, 0xb6, 0xe4, 0x8c, 0x3c, 0x9d, 0xcc, 0xe6, 0xa9, 0x08, 0x3f, 0x65, 0x5e, 0x4c,
-	0x3e, 0x7d, 0x9e, 0x1f, 0x2b, 0x69, 0x53, 0xf3, 0x5b, 0x03, 0x8e, 0x6d, 0xf3, 0xda, 0x16, 0x6e,
-	0x34, 0x06, 0xce, 0x18, 0xfb, 0x2e, 0x0b, 0xb2, 0x21, 0xfe, 0xd1, 0x3c, 0xa4, 0x5c, 0xcc, 0xca,
-	0x0e, 0x6e, 0xe9, 0x8b, 0x31, 0xe9, 0x62, 0xb6, 0x85, 0x5b, 0xe8, 0x1e, 0xcc, 0xb6, 0x7c, 0xda,
-	0xa2, 0x8c, 0xf8, 0xbd, 0xcb, 0x25, 0x2e, 0xc6, 0x74, 0x71, 0xf3, 0xb7, 0xe7, 0x79, 0xcb, 0xad,
-	0xf3, 0x5a, 0xbb, 0x62, 0x39, 0xb4, 0x69, 0xeb, 0x7e, 0xa0, 0x3e, 0x97, 0x59, 0x75, 0xcf, 0xe6,
-	0xfb, 0x2d, 0xc2, 0xac, 0xad, 0xfe, 0xad, 0x2e, 0x1d, 0x0f, 0x7c, 0x05, 0x37, 0x72, 0x11, 0xd2,
-	0x4e, 0x0d, 0xd7, 0xbd, 0x72, 0xbd, 0xba, 0x90, 0x5c, 0x31, 0x0a, 0x89, 0x52, 0x4a, 0x8e, 0xaf,
-	0x57, 0xcd, 0x75, 0x38, 0xb9, 0xcd, 0x78, 0xbd, 0x89, 0x39, 0xd9, 0xc1, 0xfd, 0x53, 0x98, 0x85,
-	0x84, 0x8b, 0x15, 0xf9, 0x64, 0x49, 0xfc, 0x9a, 0xbf, 0x27, 0x82, 0x6c, 0xfa, 0xd8, 0x21, 0xbb,
-	0xdd, 0x20, 0x4e, 0x0b, 0x12, 0x4d, 0xe6, 0xea, 0xc3, 0x5a, 0x8a, 0x1c, 0xd6, 0x6d, 0xe6, 0x6e,
-	0xf3, 0x1a, 0xf1, 0x49, 0xbb, 0xb9, 0xdb, 0x2d, 0x09, 0x43, 0xf4, 0x16, 0x4c, 0x73, 0xe1, 0xa1,
-	0xec, 0x50, 0xef, 0x7e, 0xdd, 0x95, 0x61, 0x66, 0x36, 0xb3, 0x91, 0x8d, 0x12, 0x64, 0x4b, 0x5a,
-	0x94, 0x32, 0xbc, 0x3f, 0x40, 0xef, 0xc0, 0x74, 0xcb, 0x27, 0x55, 0xe2, 0x10, 0xc6, 0xa8, 0xcf,
-	0x16, 0x92, 0xb2, 0x88, 0x8e, 0xc6, 0x0d, 0xed, 0x10, 0xcf, 0x62, 0xa5, 0x41, 0x9d, 0xbd, 0xe0,
-	0x01, 0x9a, 0x90, 0x07, 0x92, 0x91, 0x73, 0xea, 0xf9, 0x41, 0xcb, 0x00, 0xca, 0x44, 0xde, 0x92,
-	0x49, 0x79, 0x4b, 0xa6, 0xe4, 0x8c, 0x6c, 0x2c, 0x5b, 0xc1, 0xb2, 0xe8, 0x7d, 0x0b, 0x29, 0x1d,
-	0x80, 0x6a, 0x8c, 0x56, 0xd0, 0x18, 0xad, 0xdd, 0xa0, 0x31, 0x16, 0xd3, 0xa2, 0x56, 0x1e, 0xff,
-	0x98, 0x37, 0xb4, 0x13, 0xb1, 0x12, 0x9b, 0xf2, 0xf4, 0x3f, 0x93, 0xf2, 0xa9, 0x50, 0xca, 0x91,
-	0x09, 0x33, 0x8a, 0x7e, 0x13, 0x77, 0xcb, 0x22, 0xcb, 0x30, 0x70, 0x02, 0xb7, 0x71, 0x77, 0x07,
-	0xb3, 0x1b, 0xc9, 0xf4, 0xf8, 0x6c, 0xa2, 0x94, 0xe6, 0xdd, 0x72, 0xdd, 0xab, 0x92, 0xae, 0x79,
-	0x41, 0x3f, 0x6b, 0xbd, 0xe4, 0xf7, 0xdf, 0x9c, 0x2a, 0xe6, 0x38, 0xa8, 0x72, 0xf1, 0x6f, 0x7e,
-	0x93, 0x80, 0xd3, 0x7d, 0xe3, 0xa2, 0xf0, 0x3a, 0x50, 0x2c, 0xbc, 0x1b, 0xdc, 0xfc, 0x11, 0xc5,
-	0xc2, 0xbb, 0xec, 0xaf, 0x16, 0xcb, 0xbf, 0xa9, 0x1e, 0x9d, 0x6a, 0xf3, 0x32, 0xcc, 0x1f, 0xca,
-	0xd6, 0x11, 0xd9, 0x3d, 0xd5, 0x6b, 0xcd, 0x8c, 0x5c, 0x23, 0x41, 0x0b, 0x30, 0xef, 0xf5, 0xda,
-	0xae, 0x9e, 0xd6, 0x2e, 0xb6, 0x21, 0x2d, 0x9e, 0xea, 0xf2, 0x7d, 0xa2, 0x5b, 0x5f, 0xf1, 0xc2,
-	0x0f, 0xcf, 0xf3, 0x6b, 0xaf, 0x10, 0xf3, 0x75, 0x8f, 0x8b, 0x1e, 0x2d, 0xdd, 0x99, 0x17, 0xe1,
-	0xc4, 0x0e, 0xe1, 0x77, 0x89, 0x57, 0x25, 0x7e, 0xcf, 0xf7, 0x69, 0x98, 0x64, 0x72, 0x46, 0x37,
-	0x32, 0x3d, 0xda, 0xfc, 0x75, 0x1a, 0x26, 0x24, 0x19, 0xf4, 0x09, 0xa4, 0xb4, 0x8c, 0x41, 0x66,
-	0xa4, 0x68, 0x62, 0x44, 0x6a, 0xf6, 0xec, 0x91, 0x36, 0x0a, 0xd5, 0x2c, 0x3c, 0xfa, 0xee, 0xe7,
-	0x2f, 0xc7, 0x4d, 0xb4, 0x62, 0x87, 0x65, 0xb5, 0x56, 0x30, 0xf6, 0x43, 0x9d, 0xe2, 0x03, 0xf4,
-	0x95, 0x01, 0x33, 0x21, 0x91, 0x88, 0x0a, 0x71, 0x00, 0x71, 0x4a, 0x34, 0x7b, 0xfe, 0x15, 0x2c,
-	0x35, 0x21, 0x5b, 0x12, 0x3a, 0x8f, 0xd6, 0x23, 0x84, 0x02, 0x19, 0x7a, 0x88, 0xd7, 0xd7, 0x06,
-	0xcc, 0x46, 0x65, 0x1e, 0xba, 0x18, 0x07, 0x38, 0x44, 0x5a, 0x66, 0x2f, 0xbd, 0x9a, 0xb1, 0x26,
-	0xf8, 0x7f, 0x49, 0x70, 0x03, 0xd9, 0x11, 0x82, 0x9d, 0x60, 0x43, 0x9f, 0xe3, 0xa0, 0x60, 0x3d,
-	0x40, 0x07, 0x90, 0xd2, 0x32, 0x2e, 0x3e, 0x7d, 0x61, 0x79, 0x18, 0x9f, 0xbe, 0x88, 0x0e, 0x34,
-	0xcf, 0x4b, 0x32, 0x67, 0xd1, 0x6a, 0x84, 0x8c, 0x56, 0x83, 0x6c, 0xe0, 0x9c, 0x1e, 0x19, 0x90,
-	0xd2, 0x3a, 0x2e, 0x1e, 0x3f, 0xac, 0x18, 0xe3, 0xf1, 0x23, 0x42, 0xd0, 0xb4, 0x24, 0x7e, 0x01,
-	0xad, 0x45, 0xf0, 0x99, 0xb2, 0xeb, 0xc3, 0xdb, 0x0f, 0xf7, 0xc8, 0xfe, 0x01, 0x7a, 0x00, 0x49,
-	0xa1, 0xf2, 0x50, 0x3e, 0xbe, 0x20, 0x7a, 0xba, 0x31, 0xbb, 0x32, 0xdc, 0x40, 0x43, 0xaf, 0x49,
-	0xe8, 0x15, 0x94, 0x3b, 0x54, 0x28, 0xd5, 0x50, 0xdc, 0x1e, 0x4c, 0x2a, 0x95, 0x83, 0x56, 0xe3,
-	0x7c, 0x86, 0x64, 0x54, 0xd6, 0x3c, 0xca, 0x44, 0x03, 0x2f, 0x4b, 0xe0, 0x79, 0x74, 0x2a, 0x02,
-	0xac, 0xd4, 0x13, 0xa2, 0x90, 0xd2, 0xe2, 0x09, 0x2d, 0x47, 0xbc, 0x85, 0x45, 0x55, 0xf6, 0x3f,
-	0x47, 0xb6, 0x8c, 0x00, 0x2e, 0x2f, 0xe1, 0x16, 0xd1, 0x7c, 0x04, 0x8e, 0xf0, 0x5a, 0xd9, 0x11,
-	0x28, 0x6d, 0xc8, 0x0c, 0x88, 0x9e, 0x51, 0xa0, 0xd1, 0x08, 0x63, 0xf4, 0x92, 0x79, 0x56, 0x42,
-	0x2e, 0xa3, 0x33, 0x51, 0x48, 0x6d, 0x2b, 0x1e, 0x5f, 0xc4, 0x20, 0xa5, 0xfb, 0x67, 0x7c, 0x39,
-	0x85, 0x95, 0x55, 0x7c, 0x39, 0x45, 0x1a, 0xf0, 0xd0, 0x58, 0x55, 0xdb, 0xe4, 0x5d, 0xf4, 0x29,
-	0x40, 0xff, 0x65, 0x47, 0xe7, 0x86, 0xfa, 0x1c, 0xec, 0xd3, 0xd9, 0xb5, 0x51, 0x66, 0x1a, 0xdd,
-	0x94, 0xe8, 0x4b, 0x28, 0x1b, 0x8b, 0x2e, 0xbb, 0x8c, 0x88, 0x5a, 0x37, 0x85, 0x61, 0x97, 0x78,
-	0xb0, 0x91, 0x0c, 0xbb, 0xc4, 0xa1, 0xae, 0x32, 0x34, 0xea, 0xa0, 0xd5, 0x20, 0x0f, 0xa6, 0x7a,
-	0xfd, 0x02, 0x1d, 0x29, 0x34, 0x0e, 0xdd, 0x9b, 0x43, 0x7d, 0xc6, 0x5c, 0x95, 0x68, 0x67, 0xd0,
-	0x62, 0x04, 0xcd, 0x25, 0xbc, 0xac, 0x5a, 0x4e, 0xf1, 0xfa, 0xd3, 0x17, 0x39, 0xe3, 0xd9, 0x8b,
-	0x9c, 0xf1, 0xd3, 0x8b, 0x9c, 0xf1, 0xf8, 0x65, 0x6e, 0xec, 0xd9, 0xcb, 0xdc, 0xd8, 0xf7, 0x2f,
-	0x73, 0x63, 0x1f, 0xda, 0x03, 0xad, 0x4e, 0x6d, 0xbf, 0xec, 0x11, 0xfe, 0x11, 0xf5, 0xf7, 0x02,
-	0x6f, 0x9d, 0x0d, 0xbb, 0x2b, 0x5d, 0xca, 0xbe, 0x57, 0x99, 0x94, 0xb2, 0xe2, 0xca, 0x1f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0xbc, 0x1c, 0x46, 0x25, 0x12, 0x00, 0x00,
+	// 1761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0x7b, 0xc6, 0x9e, 0xf1, 0x1b, 0x3b, 0x99, 0xad, 0x4c, 0x62, 0xbb, 0x63, 0x7b, 0xc6,
+	0x6d, 0xd6, 0x76, 0x92, 0x4d, 0x37, 0xf6, 0x4a, 0x20, 0x90, 0x10, 0x64, 0x2c, 0x6f, 0xc8, 0x6e,
+	0x16, 0x96, 0x59, 0xc3, 0x01, 0x29, 0x6a, 0xd5, 0x74, 0x57, 0x7a, 0x5a, 0x9e, 0xe9, 0x9e, 0x74,
+	0xd5, 0x0c, 0x63, 0x96, 0x08, 0x69, 0x0f, 0x08, 0xc1, 0x65, 0xa5, 0x88, 0xfb, 0x9e, 0x38, 0x20,
+	0x8e, 0xdc, 0xf8, 0x00, 0xec, 0x71, 0x25, 0x2e, 0x88, 0x43, 0x16, 0x25, 0x1c, 0x10, 0x1f, 0x01,
+	0x24, 0x84, 0xea, 0x4f, 0xcf, 0x74, 0xb7, 0xdb, 0x33, 0xde, 0x85, 0xdc, 0xf6, 0xd4, 0x5d, 0xaf,
+	0x5e, 0xbd, 0xdf, 0xaf, 0xde, 0x7b, 0xf5, 0xea, 0x15, 0xac, 0xe3, 0x88, 0x91, 0x2e, 0xb6, 0xc8,
+	0xb0, 0x67, 0x0d, 0x0f, 0xac, 0x27, 0x03, 0x12, 0x9d, 0x99, 0xfd, 0x28, 0x64, 0x21, 0x5a, 0x91,
+	0x53, 0x26, 0x19, 0xf6, 0xcc, 0xe1, 0x81, 0x7e, 0xdb, 0x09, 0x69, 0x2f, 0xa4, 0x56, 0x1b, 0x53,
+	0x22, 0xf5, 0xac, 0xe1, 0x41, 0x9b, 0x30, 0x7c, 0x60, 0xf5, 0xb1, 0xe7, 0x07, 0x98, 0xf9, 0x61,
+	0x20, 0x97, 0xea, 0xab, 0x69, 0xab, 0xdc, 0x82, 0x9c, 0xb8, 0x91, 0x9e, 0x60, 0x23, 0x25, 0xaf,
+	0x79, 0xa1, 0x17, 0x8a, 0x5f, 0x8b, 0xff, 0x29, 0xe9, 0x86, 0x17, 0x86, 0x5e, 0x97, 0x58, 0xb8,
+	0xef, 0x5b, 0x38, 0x08, 0x42, 0x26, 0x30, 0xa8, 0x9a, 0xad, 0xab, 0x59, 0x31, 0x6a, 0x0f, 0x1e,
+	0x5b, 0xcc, 0xef, 0x11, 0xca, 0x70, 0xaf, 0x2f, 0x15, 0x8c, 0x6f, 0xc0, 0xb5, 0x1f, 0x70, 0x9e,
+	0xf7, 0x1c, 0x27, 0x1c, 0x04, 0xac, 0x45, 0x9e, 0x0c, 0x08, 0x65, 0x68, 0x0d, 0x4a, 0xd8, 0x75,
+	0x23, 0x42, 0xe9, 0x9a, 0xd6, 0xd0, 0xf6, 0x97, 0x5a, 0xf1, 0xf0, 0x9b, 0xe5, 0x5f, 0x7e, 0x5c,
+	0x9f, 0xfb, 0xc7, 0xc7, 0xf5, 0x39, 0xc3, 0x81, 0x5a, 0x7a, 0x29, 0xed, 0x87, 0x01, 0x25, 0x7c,
+	0x6d, 0x1b, 0x77, 0x71, 0xe0, 0x90, 0x78, 0xad, 0x1a, 0xa2, 0x9b, 0xb0, 0xe4, 0x84, 0x2e, 0xb1,
+	0x3b, 0x98, 0x76, 0xd6, 0xe6, 0xc5, 0x5c, 0x99, 0x0b, 0xbe, 0x8b, 0x69, 0x07, 0xd5, 0x60, 0x21,
+	0x08, 0xf9, 0xa2, 0x42, 0x43, 0xdb, 0x2f, 0xb6, 0xe4, 0xc0, 0xf8, 0x36, 0xac, 0x0b, 0x90, 0x23,
+	0xe1, 0xd8, 0x2f, 0xc0, 0xf2, 0x17, 0x1a, 0xe8, 0x79, 0x16, 0x14, 0xd9, 0xd7, 0xe1, 0x8a, 0x8c,
+	0x99, 0x9d, 0xb6, 0xb4, 0x22, 0xa5, 0xf7, 0xa4, 0x10, 0xe9, 0x50, 0xa6, 0x1c, 0x94, 0xf3, 0x9b,
+	0x17, 0xfc, 0xc6, 0x63, 0x6e, 0x02, 0x4b, 0xab, 0x76, 0x30, 0xe8, 0xb5, 0x49, 0xa4, 0x76, 0xb0,
+	0xa2, 0xa4, 0xdf, 0x13, 0x42, 0xe3, 0x1d, 0xd8, 0x10, 0x3c, 0x7e, 0x84, 0xbb, 0xbe, 0x8b, 0x59,
+	0x18, 0x65, 0x36, 0xb3, 0x0d, 0xcb, 0x4e, 0x18, 0x64, 0x79, 0x54, 0xb8, 0xec, 0xde, 0xb9, 0x5d,
+	0xfd, 0x5a, 0x83, 0xcd, 0x0b, 0xac, 0xa9, 0x8d, 0xed, 0xc1, 0xd5, 0x98, 0x55, 0xda, 0x62, 0x4c,
+	0xf6, 0xff, 0xb8, 0xb5, 0x38, 0x89, 0x9a, 0x32, 0xce, 0x9f, 0x27, 0x3c, 0x5f, 0x85, 0x5a, 0x7a,
+	0xe9, 0xac, 0x24, 0x32, 0xde, 0x51, 0x60, 0xef, 0xb3, 0x30, 0xc2, 0xde, 0x6c, 0x30, 0x54, 0x85,
+	0xc2, 0x29, 0x39, 0x53, 0xf9, 0xc6, 0x7f, 0x13, 0xf0, 0x6f, 0x40, 0x2d, 0x6d, 0x4c, 0xc1, 0xd7,
+	0x60, 0x61, 0x88, 0xbb, 0x83, 0x18, 0x5c, 0x0e, 0x8c, 0xaf, 0x41, 0x55, 0xa5, 0x92, 0xfb, 0xb9,
+	0x36, 0xb9, 0x07, 0xaf, 0x25, 0xd6, 0x29, 0x08, 0x04, 0x45, 0x9e, 0xfb, 0x62, 0xd5, 0x72, 0x4b,
+	0xfc, 0x1b, 0x3f, 0x05, 0x24, 0x14, 0x4f, 0x46, 0x0f, 0x43, 0x8f, 0xc6, 0x10, 0x08, 0x8a, 0xe2,
+	0xc4, 0x48, 0xfb, 0xe2, 0x1f, 0xbd, 0x05, 0x30, 0xa9, 0x28, 0x62, 0x6f, 0x95, 0xc3, 0x5d, 0x53,
+	0x26, 0xad, 0xc9, 0xcb, 0x8f, 0x29, 0xcb, 0x94, 0x2a, 0x3f, 0xe6, 0x7b, 0x13, 0x57, 0xb5, 0x12,
+	0x2b, 0xd3, 0x07, 0xe5, 0x5a, 0x0a, 0x5c, 0xf1, 0xdc, 0x85, 0x62, 0x37, 0xf4, 0xf8, 0xee, 0x0a,
+	0xfb, 0x95, 0x43, 0x64, 0xa6, 0x2a, 0x9e, 0xf9, 0x30, 0xf4, 0x5a, 0x62, 0x1e, 0xdd, 0xcf, 0x61,
+	0xb4, 0x37, 0x93, 0x91, 0x04, 0x49, 0x52, 0x32, 0x6a, 0xca, 0x09, 0xef, 0xe1, 0x08, 0xf7, 0x62,
+	0x27, 0x18, 0x6f, 0xc3, 0xb5, 0x94, 0x54, 0xb1, 0x7b, 0x13, 0x16, 0xfb, 0x42, 0x22, 0xbc, 0x53,
+	0x39, 0xbc, 0x9e, 0xe1, 0x27, 0xd5, 0x9b, 0xc5, 0x4f, 0x9e, 0xd7, 0xe7, 0x5a, 0x4a, 0xd5, 0xf8,
+	0x8f, 0x06, 0x57, 0x8e, 0x59, 0xe7, 0x08, 0x77, 0xbb, 0x09, 0x1f, 0xe3, 0xc8, 0xa3, 0x71, 0x34,
+	0xf8, 0x3f, 0x5a, 0x85, 0x92, 0x87, 0xa9, 0xed, 0xe0, 0xbe, 0x3a, 0x18, 0x8b, 0x1e, 0xa6, 0x47,
+	0xb8, 0x8f, 0x1e, 0x41, 0xb5, 0x1f, 0x85, 0xfd, 0x90, 0x92, 0x68, 0x7c, 0xb8, 0xf8, 0xc1, 0x58,
+	0x6e, 0x1e, 0xfe, 0xeb, 0x79, 0xdd, 0xf4, 0x7c, 0xd6, 0x19, 0xb4, 0x4d, 0x27, 0xec, 0x59, 0xea,
+	0x3e, 0x90, 0x9f, 0xbb, 0xd4, 0x3d, 0xb5, 0xd8, 0x59, 0x9f, 0x50, 0xf3, 0x68, 0x72, 0xaa, 0x5b,
+	0x57, 0x63, 0x5b, 0xf1, 0x89, 0x5c, 0x87, 0xb2, 0xd3, 0xc1, 0x7e, 0x60, 0xfb, 0xee, 0x5a, 0xb1,
+	0xa1, 0xed, 0x17, 0x5a, 0x25, 0x31, 0x7e, 0xe0, 0xa2, 0x0d, 0x58, 0x0a, 0x87, 0x24, 0x8a, 0x7c,
+	0x97, 0xd0, 0xb5, 0x05, 0xc1, 0x75, 0x22, 0xe0, 0x67, 0xbe, 0xdd, 0x0d, 0x9d, 0x53, 0x7b, 0xa2,
+	0xb3, 0x28, 0x74, 0xae, 0x08, 0xf1, 0xf7, 0x63, 0xa9, 0xb1, 0x07, 0xd7, 0x8e, 0x29, 0xf3, 0x7b,
+	0x98, 0x91, 0xfb, 0x78, 0xe2, 0xcc, 0x2a, 0x14, 0x3c, 0x2c, 0x7d, 0x50, 0x6c, 0xf1, 0x5f, 0xe3,
+	0x0f, 0x71, 0x9d, 0x39, 0x8a, 0x08, 0x66, 0xe4, 0x9e, 0xe3, 0x10, 0x4a, 0x1f, 0xfa, 0x74, 0x52,
+	0x67, 0x1e, 0x41, 0x05, 0x0b, 0xa9, 0xdd, 0xf5, 0x29, 0x53, 0x59, 0xa2, 0x67, 0xa2, 0x20, 0xd7,
+	0x9d, 0x0c, 0xfa, 0x5d, 0xd2, 0x6c, 0xf0, 0x50, 0xfc, 0xf3, 0x79, 0x1d, 0xf0, 0xd8, 0xd8, 0xef,
+	0x3e, 0xab, 0x43, 0xc2, 0x74, 0x62, 0x86, 0xfb, 0x82, 0xc7, 0x60, 0x40, 0x89, 0xab, 0x82, 0xc0,
+	0x63, 0xf2, 0x43, 0x4a, 0x5c, 0x3e, 0x35, 0xec, 0xd9, 0x24, 0x8a, 0x42, 0x59, 0x96, 0x96, 0x5a,
+	0xa5, 0x61, 0xef, 0x98, 0x0f, 0x8d, 0x3f, 0x69, 0x70, 0x53, 0x9e, 0x6b, 0xbf, 0x37, 0xe8, 0x72,
+	0xe2, 0xb4, 0x4f, 0x1c, 0x36, 0x3e, 0x51, 0x26, 0x14, 0x7a, 0xd4, 0x53, 0x29, 0xb3, 0x91, 0x21,
+	0xfb, 0x2e, 0xf5, 0x8e, 0x59, 0x87, 0x44, 0x64, 0xd0, 0x3b, 0x19, 0xb5, 0xb8, 0x62, 0x6e, 0xc0,
+	0xe7, 0x5f, 0x4d, 0xc0, 0x0b, 0xa9, 0x80, 0x1b, 0x7f, 0xd4, 0x60, 0x23, 0x7f, 0x27, 0xca, 0xff,
+	0x37, 0x60, 0x91, 0x92, 0xc0, 0x25, 0x91, 0x2a, 0x0f, 0x6a, 0xc4, 0xaf, 0x93, 0x21, 0x89, 0xfc,
+	0xc7, 0x67, 0xca, 0x43, 0xb2, 0xfc, 0x55, 0xa4, 0x4c, 0x78, 0x29, 0xe5, 0xdb, 0x42, 0xda, 0xb7,
+	0x55, 0x28, 0x44, 0x84, 0x89, 0xec, 0x5b, 0x6e, 0xf1, 0xdf, 0x94, 0xb7, 0x17, 0x52, 0xde, 0x16,
+	0x25, 0x50, 0xb2, 0x52, 0xe9, 0x16, 0x0f, 0x8d, 0x7f, 0x17, 0xe2, 0x9a, 0x12, 0x61, 0x87, 0x9c,
+	0x8c, 0xbe, 0xa8, 0xff, 0xbf, 0x05, 0xcb, 0x8c, 0x5b, 0xb0, 0x9d, 0x30, 0x78, 0xec, 0x7b, 0x82,
+	0xed, 0xf9, 0x2c, 0x13, 0x20, 0x47, 0x42, 0xa3, 0x55, 0x61, 0x93, 0x01, 0xfa, 0x0e, 0x2c, 0xf7,
+	0x23, 0xe2, 0x12, 0x9e, 0x55, 0x61, 0x44, 0xd7, 0x8a, 0x8d, 0xc2, 0x4c, 0xdc, 0xd4, 0x0a, 0xee,
+	0x4d, 0x79, 0xb2, 0xd4, 0x35, 0xb8, 0x20, 0xa2, 0x54, 0x11, 0x32, 0x79, 0x09, 0xa2, 0x4d, 0x00,
+	0xa9, 0x22, 0x6a, 0xf5, 0xa2, 0x70, 0xd1, 0x92, 0x90, 0x88, 0xf6, 0xe6, 0x28, 0x9e, 0xe6, 0x1d,
+	0xd8, 0x5a, 0x49, 0x6d, 0x40, 0xb6, 0x67, 0x66, 0xdc, 0x9e, 0x99, 0x27, 0x71, 0x7b, 0xd6, 0x2c,
+	0xf3, 0x63, 0xf2, 0xd1, 0x67, 0x75, 0x4d, 0x19, 0xe1, 0x33, 0xb9, 0x79, 0x58, 0x7e, 0x35, 0x79,
+	0xb8, 0x94, 0x2e, 0x3c, 0x06, 0xac, 0x48, 0xfa, 0x3d, 0x3c, 0xb2, 0x79, 0x91, 0x80, 0x84, 0x07,
+	0xde, 0xc5, 0xa3, 0xfb, 0x98, 0xbe, 0x5d, 0x2c, 0xcf, 0x57, 0x0b, 0xad, 0x32, 0x1b, 0xd9, 0x7e,
+	0xe0, 0x92, 0x91, 0x71, 0x5b, 0x5d, 0xae, 0xe3, 0xe0, 0x4f, 0x6e, 0x3e, 0x17, 0x33, 0x1c, 0xd7,
+	0x5a, 0xfe, 0x6f, 0xfc, 0xbe, 0x00, 0x37, 0x26, 0xca, 0x4d, 0x6e, 0x35, 0x91, 0x2c, 0x6c, 0x14,
+	0xdf, 0x3f, 0x33, 0x92, 0x85, 0x8d, 0xe8, 0xff, 0x9a, 0x2c, 0x5f, 0x86, 0x7a, 0x76, 0xa8, 0x8d,
+	0xbb, 0xb0, 0x7a, 0x2e, 0x5a, 0x53, 0xa2, 0x7b, 0x7d, 0xdc, 0x20, 0x52, 0xf2, 0x16, 0x89, 0x1b,
+	0x11, 0xe3, 0x11, 0xd4, 0xd2, 0x62, 0x65, 0xe2, 0x18, 0xca, 0xbc, 0x61, 0xb0, 0x1f, 0x13, 0xd5,
+	0x80, 0x35, 0x6f, 0xff, 0xf5, 0x79, 0x7d, 0xf7, 0x12, 0x7b, 0x7e, 0x10, 0x30, 0xde, 0x29, 0x0a,
+	0x73, 0xc6, 0x1d, 0x78, 0xed, 0x3e, 0x61, 0xef, 0x8b, 0x7a, 0x38, 0xab, 0x5e, 0x1e, 0x3e, 0xbb,
+	0x0a, 0x0b, 0x82, 0x0c, 0xfa, 0x19, 0x94, 0x54, 0x33, 0x8d, 0x8c, 0x4c, 0xd2, 0xe4, 0x3c, 0x95,
+	0xf4, 0x9d, 0xa9, 0x3a, 0x12, 0xd5, 0xd8, 0xff, 0xf0, 0xcf, 0x7f, 0x7f, 0x36, 0x6f, 0xa0, 0x86,
+	0x95, 0x7e, 0xdc, 0xa9, 0x3e, 0xda, 0xfa, 0x40, 0x85, 0xf8, 0x29, 0xfa, 0x8d, 0x06, 0x2b, 0xa9,
+	0xa7, 0x0a, 0xda, 0xcf, 0x03, 0xc8, 0x7b, 0x0f, 0xe9, 0xb7, 0x2e, 0xa1, 0xa9, 0x08, 0x59, 0x82,
+	0xd0, 0x2d, 0xb4, 0x97, 0x21, 0x14, 0x3f, 0x86, 0xce, 0xf1, 0xfa, 0xad, 0x06, 0xd5, 0xec, 0x63,
+	0x03, 0xdd, 0xc9, 0x03, 0xbc, 0xe0, 0x81, 0xa3, 0xbf, 0x71, 0x39, 0x65, 0x45, 0xf0, 0xeb, 0x82,
+	0xe0, 0x01, 0xb2, 0x32, 0x04, 0x87, 0xf1, 0x82, 0x09, 0xc7, 0xe4, 0xb3, 0xe9, 0x29, 0x7a, 0x0a,
+	0x25, 0xf5, 0x98, 0xc8, 0x0f, 0x5f, 0xfa, 0x91, 0xa2, 0xef, 0x4c, 0xd5, 0x51, 0x64, 0x6e, 0x09,
+	0x32, 0x3b, 0x68, 0x3b, 0x43, 0x46, 0xbd, 0x49, 0x68, 0xc2, 0x4f, 0x1f, 0x6a, 0x50, 0x52, 0xaf,
+	0x89, 0x7c, 0xfc, 0xf4, 0xbb, 0x45, 0xdf, 0x99, 0xaa, 0xa3, 0xf0, 0x4d, 0x81, 0xbf, 0x8f, 0x76,
+	0x33, 0xf8, 0x54, 0xea, 0x4d, 0xe0, 0xad, 0x0f, 0x4e, 0xc9, 0xd9, 0x53, 0xf4, 0x04, 0x8a, 0xfc,
+	0xad, 0x81, 0xea, 0xf9, 0x09, 0x31, 0x7e, 0xbd, 0xe8, 0x8d, 0x8b, 0x15, 0x14, 0xf4, 0xae, 0x80,
+	0x6e, 0xa0, 0xad, 0x73, 0x89, 0xe2, 0xa6, 0xf6, 0x1d, 0xc0, 0xa2, 0xec, 0xb5, 0xd1, 0x76, 0x9e,
+	0xcd, 0x54, 0x33, 0xaf, 0x1b, 0xd3, 0x54, 0x14, 0xf0, 0xa6, 0x00, 0x5e, 0x45, 0xd7, 0x33, 0xc0,
+	0xb2, 0x87, 0x47, 0x21, 0x94, 0x54, 0x0b, 0x8f, 0x36, 0x33, 0xd6, 0xd2, 0xad, 0xbd, 0xfe, 0x95,
+	0xa9, 0x57, 0x46, 0x0c, 0x57, 0x17, 0x70, 0xeb, 0x68, 0x35, 0x03, 0x47, 0x58, 0xc7, 0x76, 0x38,
+	0xca, 0x00, 0x2a, 0x89, 0x9e, 0x79, 0x16, 0x68, 0x76, 0x87, 0x39, 0xed, 0xb6, 0xb1, 0x23, 0x20,
+	0x37, 0xd1, 0xcd, 0x2c, 0xa4, 0xd2, 0xe5, 0xc5, 0x17, 0xfd, 0x4a, 0x83, 0x6a, 0xb6, 0xf9, 0x9e,
+	0x05, 0x9e, 0x7b, 0xd2, 0x2e, 0xea, 0xe0, 0x2f, 0x4c, 0x6e, 0x47, 0x2c, 0xb0, 0x13, 0xdd, 0x3d,
+	0x7a, 0xa6, 0xc1, 0xd5, 0x4c, 0x23, 0x8a, 0x6e, 0xe7, 0x26, 0x70, 0x6e, 0xdf, 0xad, 0xdf, 0xb9,
+	0x94, 0xae, 0xe2, 0xb5, 0x27, 0x78, 0x6d, 0xa3, 0x7a, 0x36, 0xe9, 0x95, 0xbe, 0xad, 0xba, 0x4c,
+	0x44, 0xa1, 0xa4, 0x5a, 0x8c, 0xfc, 0x13, 0x97, 0x6e, 0x3e, 0xf5, 0x9d, 0xa9, 0x3a, 0x33, 0xd2,
+	0x41, 0x76, 0x16, 0x6c, 0x84, 0x7e, 0x0e, 0x30, 0xb9, 0xfc, 0xd0, 0xeb, 0x17, 0xda, 0x4c, 0xb6,
+	0x32, 0xfa, 0xee, 0x2c, 0x35, 0x85, 0x6e, 0x08, 0xf4, 0x0d, 0xa4, 0xe7, 0xa2, 0x8b, 0x8b, 0x98,
+	0xef, 0x5a, 0xdd, 0x9b, 0x17, 0xd5, 0xb9, 0xe4, 0x5d, 0xab, 0xef, 0x4c, 0xd5, 0x99, 0xb1, 0xeb,
+	0xf8, 0x36, 0x46, 0x01, 0x2c, 0x8d, 0xaf, 0x54, 0x34, 0xb5, 0x17, 0x3b, 0x57, 0x5a, 0xce, 0x5d,
+	0xc5, 0xc6, 0xb6, 0x40, 0xbb, 0x89, 0xd6, 0x33, 0x68, 0x1e, 0x61, 0xb6, 0xbc, 0x95, 0x9b, 0x0f,
+	0x3e, 0x79, 0xb1, 0xa5, 0x7d, 0xfa, 0x62, 0x4b, 0xfb, 0xdb, 0x8b, 0x2d, 0xed, 0xa3, 0x97, 0x5b,
+	0x73, 0x9f, 0xbe, 0xdc, 0x9a, 0xfb, 0xcb, 0xcb, 0xad, 0xb9, 0x1f, 0x5b, 0x89, 0x6e, 0x40, 0x2e,
+	0xbf, 0x1b, 0x10, 0xf6, 0x93, 0x30, 0x3a, 0x8d, 0xad, 0x0d, 0x0f, 0xac, 0x91, 0x30, 0x29, 0x5a,
+	0x83, 0xf6, 0xa2, 0xe8, 0xbc, 0xde, 0xfc, 0xef, 0x00, 0x65, 0x5a, 0x82, 0x68, 0xce, 0x15, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1511,6 +1771,10 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryCreateAccessListResponse, error)
+	// SimulateAspects implements the `aspect_simulate` rpc api
+	SimulateAspects(ctx context.Context, in *QuerySimulateAspectsRequest, opts ...grpc.CallOption) (*QuerySimulateAspectsResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1611,6 +1875,24 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*QueryCreateAccessListResponse, error) {
+	out := new(QueryCreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAspects(ctx context.Context, in *QuerySimulateAspectsRequest, opts ...grpc.CallOption) (*QuerySimulateAspectsResponse, error) {
+	out := new(QuerySimulateAspectsResponse)
+	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/SimulateAspects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/artela.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1669,6 +1951,10 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*QueryCreateAccessListResponse, error)
+	// SimulateAspects implements the `aspect_simulate` rpc api
+	SimulateAspects(context.Context, *QuerySimulateAspectsRequest) (*QuerySimulateAspectsResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1711,6 +1997,12 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*QueryCreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
+func (*UnimplementedQueryServer) SimulateAspects(ctx context.Context, req *QuerySimulateAspectsRequest) (*QuerySimulateAspectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAspects not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -1890,6 +2182,42 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateAspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAspectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.evm.v1.Query/SimulateAspects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAspects(ctx, req.(*QuerySimulateAspectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2002,6 +2330,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
+		{
+			MethodName: "SimulateAspects",
+			Handler:    _Query_SimulateAspects_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2589,6 +2925,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2644,7 +2994,7 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCreateAccessListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2654,57 +3004,32 @@ func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x10
 	}
-	if len(m.Predecessors) > 0 {
-		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2712,24 +3037,208 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAspectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAspectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAspectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAspectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAspectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAspectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aspects) > 0 {
+		i -= len(m.Aspects)
+		copy(dAtA[i:], m.Aspects)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Aspects)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VerifyError) > 0 {
+		i -= len(m.VerifyError)
+		copy(dAtA[i:], m.VerifyError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VerifyError)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Predecessors) > 0 {
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2809,12 +3318,12 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x42
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BlockHash) > 0 {
@@ -3242,6 +3751,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3257,6 +3774,80 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateAspectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QuerySimulateAspectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VerifyError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Aspects)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5055,27 +5646,95 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5145,6 +5804,513 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCreateAccessListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreateAccessListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList, support.AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateAspectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateAspectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateAspectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateAspectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateAspectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateAspectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aspects", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aspects = append(m.Aspects[:0], dAtA[iNdEx:postIndex]...)
+			if m.Aspects == nil {
+				m.Aspects = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
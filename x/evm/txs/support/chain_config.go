@@ -27,6 +27,8 @@ func (cc ChainConfig) EthereumConfig(currentBlock int64, chainID *big.Int) *para
 	if currentBlock < cc.ShanghaiBlock.Int64() {
 		shanghaiTime = epochInfinite
 	}
+	// blob txs are not supported after cancun either, the BLOBHASH (EIP-4844) and BLOBBASEFEE (EIP-7516)
+	// opcodes stay disabled in the evm jump table until an evm release supporting blobs is adopted
 	cancunTime := epochZero
 	if currentBlock < cc.CancunBlock.Int64() {
 		cancunTime = epochInfinite
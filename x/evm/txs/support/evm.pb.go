@@ -41,6 +41,12 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the states machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// block_aspect_gas_limit defines the gas budget shared by all the block-level
+	// aspects at each block join point, 0 disables the block-level aspects.
+	BlockAspectGasLimit uint64 `protobuf:"varint,7,opt,name=block_aspect_gas_limit,json=blockAspectGasLimit,proto3" json:"block_aspect_gas_limit,omitempty" yaml:"block_aspect_gas_limit"`
+	// record_aspect_gas enables recording the gas consumed by each aspect in the
+	// txs results, which changes the result bytes of the transactions.
+	RecordAspectGas bool `protobuf:"varint,8,opt,name=record_aspect_gas,json=recordAspectGas,proto3" json:"record_aspect_gas,omitempty" yaml:"record_aspect_gas"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -118,6 +124,20 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetBlockAspectGasLimit() uint64 {
+	if m != nil {
+		return m.BlockAspectGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetRecordAspectGas() bool {
+	if m != nil {
+		return m.RecordAspectGas
+	}
+	return false
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -690,108 +710,112 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/evm.proto", fileDescriptor_c95fb7abfbae4d4d) }
 
 var fileDescriptor_c95fb7abfbae4d4d = []byte{
-	// 1605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x23, 0xb7,
-	0x19, 0xb6, 0xad, 0xb1, 0x3d, 0xa2, 0xbe, 0xc6, 0xb4, 0xd6, 0xab, 0xec, 0x02, 0x1e, 0x63, 0x0e,
-	0x81, 0x0f, 0x59, 0x2b, 0x76, 0x60, 0x74, 0x91, 0xa2, 0x05, 0xac, 0x5d, 0x27, 0xb1, 0xbb, 0x49,
-	0x16, 0x5c, 0x07, 0x05, 0x72, 0x19, 0x50, 0x33, 0xcc, 0x68, 0xa2, 0x99, 0xa1, 0x40, 0x72, 0xb4,
-	0x52, 0xdb, 0x73, 0x91, 0x63, 0xff, 0x40, 0x8b, 0xfe, 0x9c, 0xa0, 0xa7, 0x1c, 0x8b, 0x1e, 0x06,
-	0x85, 0xf7, 0xe6, 0xa3, 0x7e, 0x41, 0xc1, 0x0f, 0x7d, 0xda, 0x08, 0x6a, 0x9d, 0x34, 0xcf, 0xfb,
-	0xf1, 0x3c, 0xe4, 0xcb, 0x97, 0x22, 0x09, 0x9e, 0x62, 0x26, 0x48, 0x82, 0xdb, 0x64, 0x98, 0xb6,
-	0x87, 0xa7, 0xf2, 0xe7, 0x64, 0xc0, 0xa8, 0xa0, 0xb0, 0xa6, 0x1d, 0x27, 0xd2, 0x32, 0x3c, 0x7d,
-	0xd6, 0x8c, 0x68, 0x44, 0x95, 0xa7, 0x2d, 0xbf, 0x74, 0x90, 0xf7, 0xd7, 0x12, 0xd8, 0x79, 0x8b,
-	0x19, 0x4e, 0x39, 0x3c, 0x05, 0x65, 0x32, 0x4c, 0xfd, 0x90, 0x64, 0x34, 0x6d, 0x6d, 0x1e, 0x6d,
-	0x1e, 0x97, 0x3b, 0xcd, 0x49, 0xe1, 0x3a, 0x63, 0x9c, 0x26, 0x9f, 0x7b, 0x33, 0x97, 0x87, 0x6c,
-	0x32, 0x4c, 0x5f, 0xcb, 0x4f, 0xf8, 0x3b, 0x50, 0x23, 0x19, 0xee, 0x26, 0xc4, 0x0f, 0x18, 0xc1,
-	0x82, 0xb4, 0xb6, 0x8e, 0x36, 0x8f, 0xed, 0x4e, 0x6b, 0x52, 0xb8, 0x4d, 0x93, 0xb6, 0xe8, 0xf6,
-	0x50, 0x55, 0xe3, 0x57, 0x0a, 0xc2, 0xdf, 0x80, 0xca, 0xd4, 0x8f, 0x93, 0xa4, 0x55, 0x52, 0xc9,
-	0x07, 0x93, 0xc2, 0x85, 0xcb, 0xc9, 0x38, 0x49, 0x3c, 0x04, 0x4c, 0x2a, 0x4e, 0x12, 0x78, 0x01,
-	0x00, 0x19, 0x09, 0x86, 0x7d, 0x12, 0x0f, 0x78, 0xcb, 0x3a, 0x2a, 0x1d, 0x97, 0x3a, 0xde, 0x6d,
-	0xe1, 0x96, 0x2f, 0xa5, 0xf5, 0xf2, 0xea, 0x2d, 0x9f, 0x14, 0xee, 0x9e, 0x21, 0x99, 0x05, 0x7a,
-	0xa8, 0xac, 0xc0, 0x65, 0x3c, 0xe0, 0xf0, 0x7b, 0x50, 0x0d, 0x7a, 0x38, 0xce, 0xfc, 0x80, 0x66,
-	0x3f, 0xc4, 0x51, 0x6b, 0xfb, 0x68, 0xf3, 0xb8, 0x72, 0xf6, 0xec, 0x64, 0xa9, 0x68, 0x27, 0xaf,
-	0x64, 0xc8, 0x2b, 0x15, 0xd1, 0x79, 0xfe, 0x73, 0xe1, 0x6e, 0x4c, 0x0a, 0x77, 0x5f, 0xf3, 0x2e,
-	0x66, 0x7b, 0xa8, 0x12, 0xcc, 0x23, 0xe1, 0x19, 0x78, 0x82, 0x93, 0x84, 0xbe, 0xf7, 0xf3, 0x4c,
-	0x56, 0x99, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf1, 0xd6, 0x8e, 0x9c, 0x21, 0xda, 0x57, 0xce, 0xef,
-	0xe6, 0xbe, 0x9b, 0x11, 0xf7, 0xfe, 0xb1, 0x07, 0x2a, 0x0b, 0x6a, 0x30, 0x05, 0x8d, 0x1e, 0x4d,
-	0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0x41, 0xdf, 0xac, 0xc9, 0xeb, 0xff, 0x14, 0xee, 0xc7,
-	0x51, 0x2c, 0x7a, 0x79, 0xf7, 0x24, 0xa0, 0x69, 0x3b, 0xa0, 0x3c, 0xa5, 0xdc, 0xfc, 0xbc, 0xe0,
-	0x61, 0xbf, 0x2d, 0xc6, 0x03, 0xc2, 0x4f, 0xae, 0x32, 0x31, 0x29, 0xdc, 0x03, 0x3d, 0xd8, 0x15,
-	0x2a, 0x0f, 0xd5, 0x67, 0x96, 0x8e, 0x34, 0xc0, 0x31, 0xa8, 0x87, 0x98, 0xfa, 0x3f, 0x50, 0xd6,
-	0x37, 0x6a, 0x5b, 0x4a, 0xed, 0xdd, 0xff, 0xaf, 0x76, 0x5b, 0xb8, 0xd5, 0xd7, 0x17, 0xdf, 0x7e,
-	0x41, 0x59, 0x5f, 0x71, 0x4e, 0x0a, 0xf7, 0x89, 0x56, 0x5f, 0x66, 0xf6, 0x50, 0x35, 0xc4, 0x74,
-	0x16, 0x06, 0xff, 0x08, 0x9c, 0x59, 0x00, 0xcf, 0x07, 0x03, 0xca, 0x84, 0x69, 0x85, 0x17, 0xb7,
-	0x85, 0x5b, 0x37, 0x94, 0xef, 0xb4, 0x67, 0x52, 0xb8, 0x4f, 0x57, 0x48, 0x4d, 0x8e, 0x87, 0xea,
-	0x86, 0xd6, 0x84, 0x42, 0x0e, 0xaa, 0x24, 0x1e, 0x9c, 0x9e, 0x7f, 0x6a, 0x66, 0x64, 0xa9, 0x19,
-	0xbd, 0x7d, 0xd4, 0x8c, 0x2a, 0x97, 0x57, 0x6f, 0x4f, 0xcf, 0x3f, 0x9d, 0x4e, 0xc8, 0xac, 0xfd,
-	0x22, 0xad, 0x87, 0x2a, 0x1a, 0xea, 0xd9, 0x5c, 0x01, 0x03, 0xfd, 0x1e, 0xe6, 0x3d, 0xd5, 0x56,
-	0xe5, 0xce, 0xf1, 0x6d, 0xe1, 0x02, 0xcd, 0xf4, 0x15, 0xe6, 0xbd, 0xf9, 0xba, 0x74, 0xc7, 0x7f,
-	0xc2, 0x99, 0x88, 0xf3, 0x74, 0xca, 0x05, 0x74, 0xb2, 0x8c, 0x9a, 0x8d, 0xff, 0xdc, 0x8c, 0x7f,
-	0x67, 0xed, 0xf1, 0x9f, 0x3f, 0x34, 0xfe, 0xf3, 0xe5, 0xf1, 0xeb, 0x98, 0x99, 0xe8, 0x4b, 0x23,
-	0xba, 0xbb, 0xb6, 0xe8, 0xcb, 0x87, 0x44, 0x5f, 0x2e, 0x8b, 0xea, 0x18, 0xd9, 0xec, 0x2b, 0x95,
-	0x68, 0xd9, 0xeb, 0x37, 0xfb, 0xbd, 0xa2, 0xd6, 0x67, 0x16, 0x2d, 0xf7, 0x17, 0xd0, 0x0c, 0x68,
-	0xc6, 0x85, 0xb4, 0x65, 0x74, 0x90, 0x10, 0xa3, 0x59, 0x56, 0x9a, 0x57, 0x8f, 0xd2, 0x7c, 0x6e,
-	0xfe, 0x0d, 0x1e, 0xe0, 0xf3, 0xd0, 0xfe, 0xb2, 0x59, 0xab, 0x0f, 0x80, 0x33, 0x20, 0x82, 0x30,
-	0xde, 0xcd, 0x59, 0x64, 0x94, 0x81, 0x52, 0xbe, 0x7c, 0x94, 0xb2, 0xd9, 0x07, 0xab, 0x5c, 0x1e,
-	0x6a, 0xcc, 0x4d, 0x5a, 0xf1, 0x47, 0x50, 0x8f, 0xe5, 0x30, 0xba, 0x79, 0x62, 0xf4, 0x2a, 0x4a,
-	0xef, 0xd5, 0xa3, 0xf4, 0xcc, 0x66, 0x5e, 0x66, 0xf2, 0x50, 0x6d, 0x6a, 0xd0, 0x5a, 0x39, 0x80,
-	0x69, 0x1e, 0x33, 0x3f, 0x4a, 0x70, 0x10, 0x13, 0x66, 0xf4, 0xaa, 0x4a, 0xef, 0xcb, 0x47, 0xe9,
-	0x7d, 0xa4, 0xf5, 0xee, 0xb3, 0x79, 0xc8, 0x91, 0xc6, 0x2f, 0xb5, 0x4d, 0xcb, 0x86, 0xa0, 0xda,
-	0x25, 0x2c, 0x89, 0x33, 0x23, 0x58, 0x53, 0x82, 0x17, 0x8f, 0x12, 0x34, 0x7d, 0xba, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x33, 0x95, 0x84, 0x66, 0x21, 0x9d, 0xaa, 0xec, 0xad, 0xaf, 0xb2, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x5a, 0x65, 0x04, 0xf6, 0x31, 0x63, 0xf4, 0xfd, 0x4a, 0x0d, 0xa1, 0x12, 0xfb,
-	0xea, 0x51, 0x62, 0xcf, 0xb4, 0xd8, 0x03, 0x74, 0x1e, 0xda, 0x53, 0xd6, 0xa5, 0x2a, 0xe6, 0x00,
-	0x46, 0x0c, 0x8f, 0x57, 0x84, 0x9b, 0xeb, 0x2f, 0xde, 0x7d, 0x36, 0x0f, 0x39, 0xd2, 0xb8, 0x24,
-	0xfb, 0x67, 0xd0, 0x4c, 0x09, 0x8b, 0x88, 0x9f, 0x11, 0xc1, 0x07, 0x49, 0x2c, 0x8c, 0xf0, 0x93,
-	0xf5, 0xf7, 0xe3, 0x43, 0x7c, 0x1e, 0x82, 0xca, 0xfc, 0x8d, 0xb1, 0xce, 0x36, 0x07, 0xef, 0xe1,
-	0x2c, 0xea, 0xe1, 0xd8, 0xc8, 0x1e, 0xac, 0xbf, 0x39, 0x96, 0x99, 0x3c, 0x54, 0x9b, 0x1a, 0x66,
-	0xfd, 0x13, 0xe0, 0x2c, 0xc8, 0xa7, 0xfd, 0xf3, 0x74, 0xfd, 0xfe, 0x59, 0xe4, 0x91, 0xd7, 0x0f,
-	0x05, 0x95, 0xca, 0xb5, 0x65, 0xd7, 0x9d, 0xc6, 0xb5, 0x65, 0x37, 0x1c, 0xe7, 0xda, 0xb2, 0x1d,
-	0x67, 0xef, 0xda, 0xb2, 0xf7, 0x9d, 0x26, 0xaa, 0x8d, 0x69, 0x42, 0xfd, 0xe1, 0x67, 0x3a, 0x09,
-	0x55, 0xc8, 0x7b, 0xcc, 0xcd, 0x7f, 0x24, 0xaa, 0x07, 0x58, 0xe0, 0x64, 0xcc, 0x4d, 0xa9, 0x90,
-	0xa3, 0x0b, 0xb8, 0x70, 0x6a, 0xb7, 0xc1, 0xf6, 0x3b, 0x21, 0x6f, 0x6d, 0x0e, 0x28, 0xf5, 0xc9,
-	0x58, 0xdf, 0x46, 0x90, 0xfc, 0x84, 0x4d, 0xb0, 0x3d, 0xc4, 0x49, 0xae, 0xaf, 0x7f, 0x65, 0xa4,
-	0x81, 0xf7, 0x35, 0x68, 0xdc, 0x30, 0x9c, 0x71, 0x1c, 0x88, 0x98, 0x66, 0x6f, 0x68, 0xc4, 0x21,
-	0x04, 0x96, 0x3a, 0x15, 0x75, 0xae, 0xfa, 0x86, 0x1f, 0x03, 0x2b, 0xa1, 0x11, 0x6f, 0x6d, 0x1d,
-	0x95, 0x8e, 0x2b, 0x67, 0x70, 0xe5, 0x02, 0xf6, 0x86, 0x46, 0x48, 0xf9, 0xbd, 0x7f, 0x6d, 0x81,
-	0xd2, 0x1b, 0x1a, 0xc1, 0x16, 0xd8, 0xc5, 0x61, 0xc8, 0x08, 0xe7, 0x86, 0x66, 0x0a, 0xe1, 0x01,
-	0xd8, 0x11, 0x74, 0x10, 0x07, 0x9a, 0xab, 0x8c, 0x0c, 0x92, 0xaa, 0x21, 0x16, 0x58, 0x5d, 0x2a,
-	0xaa, 0x48, 0x7d, 0xc3, 0x33, 0x50, 0x55, 0xd3, 0xf2, 0xb3, 0x3c, 0xed, 0x12, 0xa6, 0xee, 0x06,
-	0x56, 0xa7, 0x71, 0x57, 0xb8, 0x15, 0x65, 0xff, 0x46, 0x99, 0xd1, 0x22, 0x80, 0x9f, 0x80, 0x5d,
-	0x31, 0x5a, 0x3c, 0xd6, 0xf7, 0xef, 0x0a, 0xb7, 0x21, 0xe6, 0x73, 0x94, 0xa7, 0x36, 0xda, 0x11,
-	0x23, 0x75, 0x7a, 0xb7, 0x81, 0x2d, 0x46, 0x7e, 0x9c, 0x85, 0x64, 0xa4, 0x4e, 0x6e, 0xab, 0xd3,
-	0xbc, 0x2b, 0x5c, 0x67, 0x21, 0xfc, 0x4a, 0xfa, 0xd0, 0xae, 0x18, 0xa9, 0x0f, 0xf8, 0x09, 0x00,
-	0x7a, 0x48, 0x4a, 0x41, 0x9f, 0xbb, 0xb5, 0xbb, 0xc2, 0x2d, 0x2b, 0xab, 0xe2, 0x9e, 0x7f, 0x42,
-	0x0f, 0x6c, 0x6b, 0x6e, 0x5b, 0x71, 0x57, 0xef, 0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b,
-	0x96, 0x8a, 0x91, 0x94, 0x0e, 0x49, 0xa8, 0x8e, 0x36, 0x1b, 0x4d, 0xa1, 0xf7, 0xd3, 0x16, 0xb0,
-	0x6f, 0x46, 0x88, 0xf0, 0x3c, 0x11, 0xf0, 0x0b, 0xe0, 0x04, 0x34, 0x13, 0x0c, 0x07, 0xc2, 0x5f,
-	0x2a, 0x6d, 0xe7, 0xf9, 0xfc, 0x98, 0x59, 0x8d, 0xf0, 0x50, 0x63, 0x6a, 0xba, 0x30, 0xf5, 0x6f,
-	0x82, 0xed, 0x6e, 0x42, 0x69, 0xaa, 0xda, 0xa0, 0x8a, 0x34, 0x80, 0xdf, 0xaa, 0xaa, 0xa9, 0x25,
-	0x2e, 0xa9, 0x3b, 0xf6, 0xe1, 0xca, 0x12, 0xaf, 0x34, 0x49, 0xe7, 0xc0, 0xdc, 0xb3, 0xeb, 0x5a,
-	0xd8, 0x24, 0x7b, 0xb2, 0xb0, 0xaa, 0x89, 0x1c, 0x50, 0x62, 0x44, 0xa8, 0x15, 0xab, 0x22, 0xf9,
-	0x09, 0x9f, 0x01, 0x9b, 0x91, 0x21, 0x61, 0x82, 0x84, 0x6a, 0x65, 0x6c, 0x34, 0xc3, 0xf0, 0x23,
-	0x60, 0x47, 0x98, 0xfb, 0x39, 0x27, 0xa1, 0x5e, 0x06, 0xb4, 0x1b, 0x61, 0xfe, 0x1d, 0x27, 0xe1,
-	0xe7, 0xd6, 0x4f, 0xff, 0x74, 0x37, 0x3c, 0x0c, 0x2a, 0x17, 0x41, 0x40, 0x38, 0xbf, 0xc9, 0x07,
-	0x09, 0xf9, 0x95, 0xf6, 0x3a, 0x03, 0x55, 0x2e, 0x28, 0xc3, 0x11, 0xf1, 0xfb, 0x64, 0x6c, 0x9a,
-	0x4c, 0xb7, 0x8c, 0xb1, 0xff, 0x81, 0x8c, 0x39, 0x5a, 0x04, 0x46, 0xe2, 0xef, 0x16, 0xa8, 0xdc,
-	0x30, 0x1c, 0x10, 0x73, 0xb7, 0x97, 0x8d, 0x2a, 0x21, 0x33, 0x12, 0x06, 0x49, 0x6d, 0x11, 0xa7,
-	0x84, 0xe6, 0xc2, 0xec, 0xa4, 0x29, 0x94, 0x19, 0x8c, 0x90, 0x11, 0x09, 0x54, 0x0d, 0x2d, 0x64,
-	0x10, 0x3c, 0x07, 0xb5, 0x30, 0xe6, 0xea, 0x95, 0xc4, 0x05, 0x0e, 0xfa, 0x7a, 0xfa, 0x1d, 0xe7,
-	0xae, 0x70, 0xab, 0xc6, 0xf1, 0x4e, 0xda, 0xd1, 0x12, 0x82, 0xbf, 0x05, 0x8d, 0x79, 0x9a, 0x1a,
-	0xad, 0x7e, 0x9a, 0x74, 0xe0, 0x5d, 0xe1, 0xd6, 0x67, 0xa1, 0xca, 0x83, 0x56, 0xb0, 0x5c, 0xe6,
-	0x90, 0x74, 0xf3, 0x48, 0x75, 0x9e, 0x8d, 0x34, 0x90, 0xd6, 0x24, 0x4e, 0x63, 0xa1, 0x3a, 0x6d,
-	0x1b, 0x69, 0x00, 0x5f, 0x82, 0x32, 0x1d, 0x12, 0xc6, 0xe2, 0x90, 0x70, 0x75, 0xc9, 0xf9, 0xd5,
-	0x27, 0x16, 0x9a, 0x07, 0xcb, 0x99, 0x99, 0xe7, 0x5f, 0x4a, 0x52, 0xca, 0xc6, 0xea, 0xca, 0x62,
-	0x66, 0xa6, 0x1d, 0x5f, 0x2b, 0x3b, 0x5a, 0x42, 0xb0, 0x03, 0xa0, 0x49, 0x63, 0x44, 0xe4, 0x2c,
-	0xf3, 0xd5, 0xce, 0xaf, 0xaa, 0x5c, 0xb5, 0xff, 0xb4, 0x17, 0x29, 0xe7, 0x6b, 0x2c, 0x30, 0xba,
-	0x67, 0x81, 0xbf, 0x07, 0x50, 0x2f, 0x88, 0xff, 0x23, 0xa7, 0xb3, 0x07, 0xa2, 0xbe, 0x51, 0x28,
-	0x7d, 0xed, 0x35, 0x63, 0x76, 0x34, 0xba, 0xe6, 0xd4, 0xcc, 0xe2, 0xda, 0xb2, 0x2d, 0x67, 0xfb,
-	0xda, 0xb2, 0x77, 0x1d, 0x7b, 0x56, 0x3c, 0x33, 0x0b, 0xb4, 0x3f, 0xc5, 0x0b, 0xc3, 0xeb, 0x5c,
-	0xfd, 0x7c, 0x7b, 0xb8, 0xf9, 0xcb, 0xed, 0xe1, 0xe6, 0x7f, 0x6f, 0x0f, 0x37, 0xff, 0xf6, 0xe1,
-	0x70, 0xe3, 0x97, 0x0f, 0x87, 0x1b, 0xff, 0xfe, 0x70, 0xb8, 0xf1, 0x7d, 0x7b, 0xe1, 0x58, 0xd0,
-	0x65, 0x7b, 0x91, 0x11, 0xf1, 0x9e, 0xb2, 0xbe, 0x81, 0xf2, 0xc9, 0x3f, 0x52, 0x6f, 0x7f, 0x75,
-	0x46, 0x74, 0x77, 0xd4, 0xb3, 0xfe, 0xb3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x6e, 0xd7,
-	0xa7, 0x16, 0x10, 0x00, 0x00,
+	// 1675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0x23, 0xb7,
+	0x19, 0xf6, 0xc7, 0xd8, 0x1e, 0x51, 0xb2, 0x34, 0xa6, 0xb5, 0x5e, 0x65, 0xb7, 0xf5, 0xb8, 0x3c,
+	0x04, 0x3e, 0x64, 0xed, 0xd8, 0x81, 0xd1, 0x45, 0x8a, 0x16, 0xb0, 0x76, 0x9d, 0x5d, 0xbb, 0x9b,
+	0x64, 0xc1, 0x75, 0x5a, 0x20, 0x97, 0x01, 0x35, 0xc3, 0x8c, 0x27, 0x9e, 0x19, 0x0a, 0x24, 0x47,
+	0x2b, 0xb5, 0xfd, 0x01, 0x39, 0xf6, 0x0f, 0xb4, 0xe8, 0xb1, 0x3f, 0x25, 0xe8, 0x29, 0xc7, 0xa2,
+	0x87, 0x41, 0xe1, 0xbd, 0xf9, 0xa8, 0x5f, 0x50, 0xf0, 0x43, 0x9f, 0x36, 0xda, 0x5a, 0x27, 0xf1,
+	0x7d, 0xde, 0x97, 0xcf, 0x43, 0xbe, 0x7c, 0x29, 0x92, 0x03, 0x1e, 0x13, 0x2e, 0x69, 0x4a, 0x0e,
+	0x69, 0x2f, 0x3b, 0xec, 0x1d, 0xa9, 0x9f, 0x83, 0x2e, 0x67, 0x92, 0xc1, 0x4d, 0xe3, 0x38, 0x50,
+	0x48, 0xef, 0xe8, 0x49, 0x33, 0x66, 0x31, 0xd3, 0x9e, 0x43, 0xd5, 0x32, 0x41, 0xe8, 0xef, 0x0e,
+	0x58, 0x7f, 0x4b, 0x38, 0xc9, 0x04, 0x3c, 0x02, 0x15, 0xda, 0xcb, 0x82, 0x88, 0xe6, 0x2c, 0x6b,
+	0x2d, 0xef, 0x2d, 0xef, 0x57, 0xda, 0xcd, 0x61, 0xe9, 0x7b, 0x03, 0x92, 0xa5, 0x9f, 0xa3, 0xb1,
+	0x0b, 0x61, 0x97, 0xf6, 0xb2, 0x97, 0xaa, 0x09, 0x7f, 0x0d, 0x36, 0x69, 0x4e, 0x3a, 0x29, 0x0d,
+	0x42, 0x4e, 0x89, 0xa4, 0xad, 0x95, 0xbd, 0xe5, 0x7d, 0xb7, 0xdd, 0x1a, 0x96, 0x7e, 0xd3, 0x76,
+	0x9b, 0x76, 0x23, 0x5c, 0x33, 0xf6, 0x0b, 0x6d, 0xc2, 0x5f, 0x82, 0xea, 0xc8, 0x4f, 0xd2, 0xb4,
+	0xb5, 0xaa, 0x3b, 0xef, 0x0c, 0x4b, 0x1f, 0xce, 0x76, 0x26, 0x69, 0x8a, 0x30, 0xb0, 0x5d, 0x49,
+	0x9a, 0xc2, 0x53, 0x00, 0x68, 0x5f, 0x72, 0x12, 0xd0, 0xa4, 0x2b, 0x5a, 0xce, 0xde, 0xea, 0xfe,
+	0x6a, 0x1b, 0xdd, 0x94, 0x7e, 0xe5, 0x4c, 0xa1, 0x67, 0xe7, 0x6f, 0xc5, 0xb0, 0xf4, 0xb7, 0x2c,
+	0xc9, 0x38, 0x10, 0xe1, 0x8a, 0x36, 0xce, 0x92, 0xae, 0x80, 0xdf, 0x82, 0x5a, 0x78, 0x45, 0x92,
+	0x3c, 0x08, 0x59, 0xfe, 0x5d, 0x12, 0xb7, 0xd6, 0xf6, 0x96, 0xf7, 0xab, 0xc7, 0x4f, 0x0e, 0x66,
+	0x92, 0x76, 0xf0, 0x42, 0x85, 0xbc, 0xd0, 0x11, 0xed, 0xa7, 0x3f, 0x96, 0xfe, 0xd2, 0xb0, 0xf4,
+	0xb7, 0x0d, 0xef, 0x74, 0x6f, 0x84, 0xab, 0xe1, 0x24, 0x12, 0x1e, 0x83, 0x47, 0x24, 0x4d, 0xd9,
+	0xfb, 0xa0, 0xc8, 0x55, 0x96, 0x69, 0x28, 0x69, 0x14, 0xc8, 0xbe, 0x68, 0xad, 0xab, 0x19, 0xe2,
+	0x6d, 0xed, 0xfc, 0x66, 0xe2, 0xbb, 0xec, 0x0b, 0xf8, 0x3b, 0xb0, 0xd3, 0x49, 0x59, 0x78, 0x1d,
+	0x10, 0xd1, 0xa5, 0xa1, 0x0c, 0x62, 0x22, 0x82, 0x34, 0xc9, 0x12, 0xd9, 0xda, 0xd8, 0x5b, 0xde,
+	0x77, 0xda, 0xbf, 0x18, 0x96, 0xfe, 0xcf, 0x8d, 0xf2, 0xfd, 0x71, 0x08, 0x6f, 0x6b, 0xc7, 0xa9,
+	0xc6, 0x5f, 0x11, 0xf1, 0x46, 0xa1, 0xf0, 0x35, 0xd8, 0xe2, 0x34, 0x64, 0x3c, 0x9a, 0xea, 0xd0,
+	0x72, 0x75, 0xa6, 0x7f, 0x36, 0x2c, 0xfd, 0x96, 0xa1, 0xbc, 0x13, 0x82, 0x70, 0xc3, 0x60, 0x63,
+	0x3a, 0xf4, 0xd7, 0x2d, 0x50, 0x9d, 0xca, 0x07, 0xcc, 0x40, 0xe3, 0x8a, 0x65, 0x54, 0x48, 0x4a,
+	0xa2, 0x40, 0x4b, 0xdb, 0xaa, 0x79, 0xf9, 0xaf, 0xd2, 0xff, 0x38, 0x4e, 0xe4, 0x55, 0xd1, 0x39,
+	0x08, 0x59, 0x76, 0x18, 0x32, 0x91, 0x31, 0x61, 0x7f, 0x9e, 0x89, 0xe8, 0xfa, 0x50, 0x0e, 0xba,
+	0x54, 0x1c, 0x9c, 0xe7, 0x72, 0x58, 0xfa, 0x3b, 0x66, 0x04, 0x73, 0x54, 0x08, 0xd7, 0xc7, 0x48,
+	0x5b, 0x01, 0x70, 0x00, 0xea, 0x11, 0x61, 0xc1, 0x77, 0x8c, 0x5f, 0x5b, 0xb5, 0x15, 0xad, 0xf6,
+	0xee, 0xff, 0x57, 0xbb, 0x29, 0xfd, 0xda, 0xcb, 0xd3, 0xaf, 0xbf, 0x60, 0xfc, 0x5a, 0x73, 0x0e,
+	0x4b, 0xff, 0x91, 0x51, 0x9f, 0x65, 0x46, 0xb8, 0x16, 0x11, 0x36, 0x0e, 0x83, 0xbf, 0x07, 0xde,
+	0x38, 0x40, 0x14, 0xdd, 0x2e, 0xe3, 0xd2, 0x16, 0xeb, 0xb3, 0x9b, 0xd2, 0xaf, 0x5b, 0xca, 0x77,
+	0xc6, 0x33, 0x2c, 0xfd, 0xc7, 0x73, 0xa4, 0xb6, 0x0f, 0xc2, 0x75, 0x4b, 0x6b, 0x43, 0xa1, 0x00,
+	0x35, 0x9a, 0x74, 0x8f, 0x4e, 0x3e, 0xb5, 0x33, 0x72, 0xf4, 0x8c, 0xde, 0x3e, 0x68, 0x46, 0xd5,
+	0xb3, 0xf3, 0xb7, 0x47, 0x27, 0x9f, 0x8e, 0x26, 0x64, 0xab, 0x73, 0x9a, 0x16, 0xe1, 0xaa, 0x31,
+	0xcd, 0x6c, 0xce, 0x81, 0x35, 0x83, 0x2b, 0x22, 0xae, 0x74, 0xe1, 0x57, 0xda, 0xfb, 0x37, 0xa5,
+	0x0f, 0x0c, 0xd3, 0x6b, 0x22, 0xae, 0x26, 0xeb, 0xd2, 0x19, 0xfc, 0x81, 0xe4, 0x32, 0x29, 0xb2,
+	0x11, 0x17, 0x30, 0x9d, 0x55, 0xd4, 0x78, 0xfc, 0x27, 0x76, 0xfc, 0xeb, 0x0b, 0x8f, 0xff, 0xe4,
+	0xbe, 0xf1, 0x9f, 0xcc, 0x8e, 0xdf, 0xc4, 0x8c, 0x45, 0x9f, 0x5b, 0xd1, 0x8d, 0x85, 0x45, 0x9f,
+	0xdf, 0x27, 0xfa, 0x7c, 0x56, 0xd4, 0xc4, 0xa8, 0x62, 0x9f, 0xcb, 0x44, 0xcb, 0x5d, 0xbc, 0xd8,
+	0xef, 0x24, 0xb5, 0x3e, 0x46, 0x8c, 0xdc, 0x9f, 0x40, 0x33, 0x64, 0xb9, 0x90, 0x0a, 0xcb, 0x59,
+	0x37, 0xa5, 0x56, 0xb3, 0xa2, 0x35, 0xcf, 0x1f, 0xa4, 0xf9, 0xd4, 0xfe, 0x5f, 0xdd, 0xc3, 0x87,
+	0xf0, 0xf6, 0x2c, 0x6c, 0xd4, 0xbb, 0xc0, 0xeb, 0x52, 0x49, 0xb9, 0xe8, 0x14, 0x3c, 0xb6, 0xca,
+	0x40, 0x2b, 0x9f, 0x3d, 0x48, 0xd9, 0xee, 0x83, 0x79, 0x2e, 0x84, 0x1b, 0x13, 0xc8, 0x28, 0x7e,
+	0x0f, 0xea, 0x89, 0x1a, 0x46, 0xa7, 0x48, 0xad, 0x5e, 0x55, 0xeb, 0xbd, 0x78, 0x90, 0x9e, 0xdd,
+	0xcc, 0xb3, 0x4c, 0x08, 0x6f, 0x8e, 0x00, 0xa3, 0x55, 0x00, 0x98, 0x15, 0x09, 0x0f, 0xe2, 0x94,
+	0x84, 0x09, 0xe5, 0x56, 0xaf, 0xa6, 0xf5, 0x5e, 0x3d, 0x48, 0xef, 0x23, 0xa3, 0x77, 0x97, 0x0d,
+	0x61, 0x4f, 0x81, 0xaf, 0x0c, 0x66, 0x64, 0x23, 0x50, 0xeb, 0x50, 0x9e, 0x26, 0xb9, 0x15, 0xdc,
+	0xd4, 0x82, 0xa7, 0x0f, 0x12, 0xb4, 0x75, 0x3a, 0xcd, 0x83, 0x70, 0xd5, 0x98, 0x63, 0x95, 0x94,
+	0xe5, 0x11, 0x1b, 0xa9, 0x6c, 0x2d, 0xae, 0x32, 0xcd, 0x83, 0x70, 0xd5, 0x98, 0x46, 0xa5, 0x0f,
+	0xb6, 0x09, 0xe7, 0xec, 0xfd, 0x5c, 0x0e, 0xa1, 0x16, 0x7b, 0xfd, 0x20, 0xb1, 0x27, 0x46, 0xec,
+	0x1e, 0x3a, 0x84, 0xb7, 0x34, 0x3a, 0x93, 0xc5, 0x02, 0xc0, 0x98, 0x93, 0xc1, 0x9c, 0x70, 0x73,
+	0xf1, 0xc5, 0xbb, 0xcb, 0x86, 0xb0, 0xa7, 0xc0, 0x19, 0xd9, 0x3f, 0x82, 0x66, 0x46, 0x79, 0x4c,
+	0x83, 0x9c, 0x4a, 0xd1, 0x4d, 0x13, 0x69, 0x85, 0x1f, 0x2d, 0xbe, 0x1f, 0xef, 0xe3, 0x43, 0x18,
+	0x6a, 0xf8, 0x2b, 0x8b, 0x8e, 0x37, 0x87, 0xb8, 0x22, 0x79, 0x7c, 0x45, 0x12, 0x2b, 0xbb, 0xb3,
+	0xf8, 0xe6, 0x98, 0x65, 0x42, 0x78, 0x73, 0x04, 0x8c, 0xeb, 0x27, 0x24, 0x79, 0x58, 0x8c, 0xea,
+	0xe7, 0xf1, 0xe2, 0xf5, 0x33, 0xcd, 0xa3, 0x2e, 0x48, 0xda, 0xd4, 0x2a, 0x17, 0x8e, 0x5b, 0xf7,
+	0x1a, 0x17, 0x8e, 0xdb, 0xf0, 0xbc, 0x0b, 0xc7, 0xf5, 0xbc, 0xad, 0x0b, 0xc7, 0xdd, 0xf6, 0x9a,
+	0x78, 0x73, 0xc0, 0x52, 0x16, 0xf4, 0x3e, 0x33, 0x9d, 0x70, 0x95, 0xbe, 0x27, 0xc2, 0xfe, 0x47,
+	0xe2, 0x7a, 0x48, 0x24, 0x49, 0x07, 0xc2, 0xa6, 0x0a, 0x7b, 0x26, 0x81, 0x53, 0xa7, 0xf6, 0x21,
+	0x58, 0x7b, 0x27, 0xd5, 0xbd, 0xd2, 0x03, 0xab, 0xd7, 0x74, 0x60, 0x6e, 0x23, 0x58, 0x35, 0x61,
+	0x13, 0xac, 0xf5, 0x48, 0x5a, 0x98, 0x0b, 0x6a, 0x05, 0x1b, 0x03, 0x7d, 0x09, 0x1a, 0x97, 0x9c,
+	0xe4, 0x82, 0x84, 0x32, 0x61, 0xf9, 0x1b, 0x16, 0x0b, 0x08, 0x81, 0xa3, 0x4f, 0x45, 0xd3, 0x57,
+	0xb7, 0xe1, 0xc7, 0xc0, 0x49, 0x59, 0x2c, 0x5a, 0x2b, 0x7b, 0xab, 0xfb, 0xd5, 0x63, 0x38, 0x77,
+	0x45, 0x7c, 0xc3, 0x62, 0xac, 0xfd, 0xe8, 0x1f, 0x2b, 0x60, 0xf5, 0x0d, 0x8b, 0x61, 0x0b, 0x6c,
+	0x90, 0x28, 0xe2, 0x54, 0x08, 0x4b, 0x33, 0x32, 0xe1, 0x0e, 0x58, 0x97, 0xac, 0x9b, 0x84, 0x86,
+	0xab, 0x82, 0xad, 0xa5, 0x54, 0x23, 0x22, 0x89, 0xbe, 0x54, 0xd4, 0xb0, 0x6e, 0xc3, 0x63, 0x50,
+	0x33, 0x17, 0xbd, 0xbc, 0xc8, 0x3a, 0x94, 0xeb, 0xbb, 0x81, 0xd3, 0x6e, 0xdc, 0x96, 0x7e, 0x55,
+	0xe3, 0x5f, 0x69, 0x18, 0x4f, 0x1b, 0xf0, 0x13, 0xb0, 0x21, 0xfb, 0xd3, 0xc7, 0xfa, 0xf6, 0x6d,
+	0xe9, 0x37, 0xe4, 0x64, 0x8e, 0xea, 0xd4, 0xc6, 0xeb, 0xb2, 0xaf, 0x7e, 0xe1, 0x21, 0x70, 0x65,
+	0x3f, 0x48, 0xf2, 0x88, 0xf6, 0xf5, 0xc9, 0xed, 0xb4, 0x9b, 0xb7, 0xa5, 0xef, 0x4d, 0x85, 0x9f,
+	0x2b, 0x1f, 0xde, 0x90, 0x7d, 0xdd, 0x80, 0x9f, 0x00, 0x60, 0x86, 0xa4, 0x15, 0xcc, 0xb9, 0xbb,
+	0x79, 0x5b, 0xfa, 0x15, 0x8d, 0x6a, 0xee, 0x49, 0x13, 0x22, 0xb0, 0x66, 0xb8, 0x5d, 0xcd, 0x5d,
+	0xbb, 0x2d, 0x7d, 0x37, 0x65, 0xb1, 0xe1, 0x34, 0x2e, 0x95, 0x2a, 0x4e, 0x33, 0xd6, 0xa3, 0x91,
+	0x3e, 0xda, 0x5c, 0x3c, 0x32, 0xd1, 0x0f, 0x2b, 0xc0, 0xbd, 0xec, 0x63, 0x2a, 0x8a, 0x54, 0xc2,
+	0x2f, 0x80, 0x17, 0xb2, 0x5c, 0x72, 0x12, 0xca, 0x60, 0x26, 0xb5, 0xed, 0xa7, 0x93, 0x63, 0x66,
+	0x3e, 0x02, 0xe1, 0xc6, 0x08, 0x3a, 0xb5, 0xf9, 0x6f, 0x82, 0xb5, 0x4e, 0xca, 0x58, 0xa6, 0xcb,
+	0xa0, 0x86, 0x8d, 0x01, 0xbf, 0xd6, 0x59, 0xd3, 0x4b, 0xbc, 0xaa, 0x5f, 0x01, 0xbb, 0x73, 0x4b,
+	0x3c, 0x57, 0x24, 0xed, 0x1d, 0xfb, 0x12, 0xa8, 0x1b, 0x61, 0xdb, 0x19, 0xa9, 0xc4, 0xea, 0x22,
+	0xf2, 0xc0, 0x2a, 0xa7, 0x52, 0xaf, 0x58, 0x0d, 0xab, 0x26, 0x7c, 0x02, 0x5c, 0x4e, 0x7b, 0x94,
+	0x4b, 0x1a, 0xe9, 0x95, 0x71, 0xf1, 0xd8, 0x86, 0x1f, 0x01, 0x57, 0x5d, 0xe2, 0x0b, 0x41, 0x23,
+	0xb3, 0x0c, 0x78, 0x23, 0x26, 0xe2, 0x1b, 0x41, 0xa3, 0xcf, 0x9d, 0x1f, 0xfe, 0xe6, 0x2f, 0x21,
+	0x02, 0xaa, 0xa7, 0x61, 0x48, 0x85, 0xb8, 0x2c, 0xba, 0x29, 0xfd, 0x2f, 0xe5, 0x75, 0x0c, 0x6a,
+	0x42, 0x32, 0x4e, 0x62, 0x1a, 0x5c, 0xd3, 0x81, 0x2d, 0x32, 0x53, 0x32, 0x16, 0xff, 0x2d, 0x1d,
+	0x08, 0x3c, 0x6d, 0x58, 0x89, 0xbf, 0x38, 0xa0, 0x7a, 0xc9, 0x49, 0x48, 0xed, 0xdd, 0x5e, 0x15,
+	0xaa, 0x32, 0xb9, 0x95, 0xb0, 0x96, 0xd2, 0x96, 0x49, 0x46, 0x59, 0x21, 0xed, 0x4e, 0x1a, 0x99,
+	0xaa, 0x07, 0xa7, 0xb4, 0x4f, 0x43, 0x9d, 0x43, 0x07, 0x5b, 0x0b, 0x9e, 0x80, 0xcd, 0x28, 0x11,
+	0xfa, 0x1d, 0x27, 0x24, 0x09, 0xaf, 0xcd, 0xf4, 0xdb, 0xde, 0x6d, 0xe9, 0xd7, 0xac, 0xe3, 0x9d,
+	0xc2, 0xf1, 0x8c, 0x05, 0x7f, 0x05, 0x1a, 0x93, 0x6e, 0x7a, 0xb4, 0xe6, 0xf1, 0xd4, 0x86, 0xb7,
+	0xa5, 0x5f, 0x1f, 0x87, 0x6a, 0x0f, 0x9e, 0xb3, 0xd5, 0x32, 0x47, 0xb4, 0x53, 0xc4, 0xe6, 0x9d,
+	0x83, 0x8d, 0xa1, 0x50, 0xf3, 0xa0, 0x52, 0x95, 0xb6, 0x86, 0x8d, 0x01, 0x9f, 0x83, 0x0a, 0xeb,
+	0x51, 0xce, 0x93, 0x88, 0x8a, 0x16, 0xf8, 0x5f, 0x8f, 0x40, 0x3c, 0x09, 0x56, 0x33, 0xb3, 0x0f,
+	0xd4, 0x8c, 0x66, 0x8c, 0x0f, 0x5a, 0xd5, 0xc9, 0xcc, 0x8c, 0xe3, 0x4b, 0x8d, 0xe3, 0x19, 0x0b,
+	0xb6, 0x01, 0xb4, 0xdd, 0x38, 0x95, 0x05, 0xcf, 0x03, 0xbd, 0xf3, 0x6b, 0xba, 0xaf, 0xde, 0x7f,
+	0xc6, 0x8b, 0xb5, 0xf3, 0x25, 0x91, 0x04, 0xdf, 0x41, 0xe0, 0x6f, 0x00, 0x34, 0x0b, 0x12, 0x7c,
+	0x2f, 0xd8, 0xf8, 0x09, 0x6b, 0x6e, 0x14, 0x5a, 0xdf, 0x78, 0xed, 0x98, 0x3d, 0x63, 0x5d, 0x08,
+	0x66, 0x67, 0x71, 0xe1, 0xb8, 0x8e, 0xb7, 0x76, 0xe1, 0xb8, 0x1b, 0x9e, 0x3b, 0x4e, 0x9e, 0x9d,
+	0x05, 0xde, 0x1e, 0xd9, 0x53, 0xc3, 0x6b, 0x9f, 0xff, 0x78, 0xb3, 0xbb, 0xfc, 0xd3, 0xcd, 0xee,
+	0xf2, 0xbf, 0x6f, 0x76, 0x97, 0xff, 0xfc, 0x61, 0x77, 0xe9, 0xa7, 0x0f, 0xbb, 0x4b, 0xff, 0xfc,
+	0xb0, 0xbb, 0xf4, 0xed, 0xe1, 0xd4, 0xb1, 0x60, 0xd2, 0xf6, 0x2c, 0xa7, 0xf2, 0x3d, 0xe3, 0xd7,
+	0xd6, 0x54, 0x1f, 0x25, 0xfa, 0xfa, 0xeb, 0x84, 0x3e, 0x23, 0x3a, 0xeb, 0xfa, 0xc3, 0xc3, 0x67,
+	0xff, 0x19, 0x00, 0x53, 0x03, 0x92, 0x73, 0xb8, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -814,6 +838,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecordAspectGas {
+		i--
+		if m.RecordAspectGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockAspectGasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.BlockAspectGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1568,6 +1607,12 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if m.BlockAspectGasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.BlockAspectGasLimit))
+	}
+	if m.RecordAspectGas {
+		n += 2
+	}
 	return n
 }
 
@@ -2064,6 +2109,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAspectGasLimit", wireType)
+			}
+			m.BlockAspectGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockAspectGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAspectGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordAspectGas = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -24,6 +24,12 @@ var (
 
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+
+	// DefaultBlockAspectGasLimit is the default gas budget of the block-level aspects at each block join point
+	DefaultBlockAspectGasLimit uint64 = 5_000_000
+
+	// DefaultRecordAspectGas records the gas consumed by each aspect in the tx results (i.e true)
+	DefaultRecordAspectGas = true
 )
 
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
@@ -41,10 +47,14 @@ var (
 	ParamStoreKeyExtraEIPs           = []byte("EnableExtraEIPs")
 	ParamStoreKeyChainConfig         = []byte("ChainConfig")
 	ParamStoreKeyAllowUnprotectedTxs = []byte("AllowUnprotectedTxs")
+	ParamStoreKeyBlockAspectGasLimit = []byte("BlockAspectGasLimit")
+	ParamStoreKeyRecordAspectGas     = []byte("RecordAspectGas")
 )
 
 // NewParams creates a new Params instance
-func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
+func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64,
+	blockAspectGasLimit uint64, recordAspectGas bool,
+) Params {
 	return Params{
 		EvmDenom:            evmDenom,
 		AllowUnprotectedTxs: allowUnprotectedTxs,
@@ -52,6 +62,8 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 		EnableCall:          enableCall,
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
+		BlockAspectGasLimit: blockAspectGasLimit,
+		RecordAspectGas:     recordAspectGas,
 	}
 }
 
@@ -65,6 +77,8 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		BlockAspectGasLimit: DefaultBlockAspectGasLimit,
+		RecordAspectGas:     DefaultRecordAspectGas,
 	}
 }
 
@@ -90,6 +104,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateUint64(p.BlockAspectGasLimit); err != nil {
+		return err
+	}
+
+	if err := validateBool(p.RecordAspectGas); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -122,6 +144,8 @@ func (p *Params) ParamSetPairs() paramsmodule.ParamSetPairs {
 		paramsmodule.NewParamSetPair(ParamStoreKeyExtraEIPs, &p.ExtraEIPs, validateEIPs),
 		paramsmodule.NewParamSetPair(ParamStoreKeyChainConfig, &p.ChainConfig, validateChainConfig),
 		paramsmodule.NewParamSetPair(ParamStoreKeyAllowUnprotectedTxs, &p.AllowUnprotectedTxs, validateBool),
+		paramsmodule.NewParamSetPair(ParamStoreKeyBlockAspectGasLimit, &p.BlockAspectGasLimit, validateUint64),
+		paramsmodule.NewParamSetPair(ParamStoreKeyRecordAspectGas, &p.RecordAspectGas, validateBool),
 	}
 }
 
@@ -142,6 +166,14 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateEIPs(i interface{}) error {
 	eips, ok := i.([]int64)
 	if !ok {
@@ -314,6 +314,9 @@ type MsgEthereumTxResponse struct {
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 	// cumulative gas used
 	CumulativeGasUsed uint64 `protobuf:"varint,6,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// aspect_gas_used is the gas consumed by each aspect at each join point, which is
+	// included in gas_used
+	AspectGasUsed []AspectGasUsage `protobuf:"bytes,7,rep,name=aspect_gas_used,json=aspectGasUsed,proto3" json:"aspect_gas_used"`
 }
 
 func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
@@ -349,6 +352,70 @@ func (m *MsgEthereumTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEthereumTxResponse proto.InternalMessageInfo
 
+// AspectGasUsage defines the gas consumed by an aspect at a join point
+type AspectGasUsage struct {
+	// aspect_id is the hex address of the aspect
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// join_point is the name of the join point
+	JoinPoint string `protobuf:"bytes,2,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+	// gas_used is the gas consumed by the aspect execution
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *AspectGasUsage) Reset()         { *m = AspectGasUsage{} }
+func (m *AspectGasUsage) String() string { return proto.CompactTextString(m) }
+func (*AspectGasUsage) ProtoMessage()    {}
+func (*AspectGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c43c0836c37bbe6, []int{6}
+}
+func (m *AspectGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectGasUsage.Merge(m, src)
+}
+func (m *AspectGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectGasUsage proto.InternalMessageInfo
+
+func (m *AspectGasUsage) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *AspectGasUsage) GetJoinPoint() string {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return ""
+}
+
+func (m *AspectGasUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 // MsgUpdateParams defines a Msg for updating the x/evm module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -362,7 +429,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c43c0836c37bbe6, []int{6}
+	return fileDescriptor_3c43c0836c37bbe6, []int{7}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +481,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c43c0836c37bbe6, []int{7}
+	return fileDescriptor_3c43c0836c37bbe6, []int{8}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +517,7 @@ func init() {
 	proto.RegisterType((*DynamicFeeTx)(nil), "artela.evm.v1.DynamicFeeTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "artela.evm.v1.ExtensionOptionsEthereumTx")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "artela.evm.v1.MsgEthereumTxResponse")
+	proto.RegisterType((*AspectGasUsage)(nil), "artela.evm.v1.AspectGasUsage")
 	proto.RegisterType((*MsgUpdateParams)(nil), "artela.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "artela.evm.v1.MsgUpdateParamsResponse")
 }
@@ -457,70 +525,74 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/v1/txs.proto", fileDescriptor_3c43c0836c37bbe6) }
 
 var fileDescriptor_3c43c0836c37bbe6 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x13, 0xe7, 0xd7, 0x24, 0x5b, 0x60, 0xe8, 0x52, 0x27, 0xaa, 0xe2, 0xc8, 0x42, 0x55,
-	0xb4, 0x52, 0x6c, 0xb5, 0x8b, 0x38, 0xf4, 0x44, 0xb3, 0xed, 0x56, 0x5d, 0xb5, 0x62, 0x65, 0xb2,
-	0x08, 0xc1, 0x21, 0x9a, 0x3a, 0x53, 0xc7, 0xda, 0xd8, 0x63, 0x79, 0xc6, 0x26, 0xe1, 0xb8, 0x27,
-	0x4e, 0x80, 0xc4, 0x3f, 0xc0, 0x99, 0x13, 0x12, 0x7b, 0xe5, 0xbe, 0xe2, 0xb4, 0xb0, 0x17, 0xc4,
-	0x21, 0xa0, 0x14, 0x09, 0xa9, 0x07, 0x0e, 0xfc, 0x05, 0x68, 0x66, 0x9c, 0xa6, 0x49, 0xd5, 0x0a,
-	0x96, 0x4a, 0x7b, 0xca, 0x3c, 0x7f, 0x6f, 0xbe, 0x79, 0xf3, 0xbe, 0x6f, 0x66, 0x02, 0xde, 0x42,
-	0x11, 0xc3, 0x43, 0x64, 0xe1, 0xc4, 0xb7, 0x92, 0x4d, 0x8b, 0x8d, 0xcc, 0x30, 0x22, 0x8c, 0xc0,
-	0x5b, 0xf2, 0xbb, 0x89, 0x13, 0xdf, 0x4c, 0x36, 0xeb, 0x6b, 0x0e, 0xa1, 0x3e, 0xa1, 0x96, 0x4f,
-	0x5d, 0x9e, 0xe6, 0x53, 0x57, 0xe6, 0xd5, 0x6b, 0x12, 0xe8, 0x89, 0xc8, 0x92, 0x41, 0x0a, 0xad,
-	0x2d, 0x52, 0x73, 0x26, 0x09, 0xac, 0xba, 0xc4, 0x25, 0x72, 0x02, 0x1f, 0xa5, 0x5f, 0xd7, 0x5d,
-	0x42, 0xdc, 0x21, 0xb6, 0x50, 0xe8, 0x59, 0x28, 0x08, 0x08, 0x43, 0xcc, 0x23, 0xc1, 0x8c, 0xac,
-	0x96, 0xa2, 0x22, 0x3a, 0x8e, 0x4f, 0x2c, 0x14, 0x8c, 0x25, 0x64, 0x7c, 0xa9, 0x80, 0x5b, 0x47,
-	0xd4, 0xdd, 0x63, 0x03, 0x1c, 0xe1, 0xd8, 0xef, 0x8e, 0x60, 0x0b, 0xa8, 0x7d, 0xc4, 0x90, 0xa6,
-	0x34, 0x95, 0x56, 0x65, 0x6b, 0xd5, 0x94, 0x73, 0xcd, 0xd9, 0x5c, 0x73, 0x27, 0x18, 0xdb, 0x22,
-	0x03, 0xd6, 0x80, 0x4a, 0xbd, 0xcf, 0xb0, 0x96, 0x6d, 0x2a, 0x2d, 0xa5, 0x93, 0x3f, 0x9b, 0xe8,
-	0x4a, 0xdb, 0x16, 0x9f, 0xa0, 0x0e, 0xd4, 0x01, 0xa2, 0x03, 0x2d, 0xd7, 0x54, 0x5a, 0xe5, 0x4e,
-	0xe5, 0xef, 0x89, 0x5e, 0x8c, 0x86, 0xe1, 0xb6, 0xd1, 0x36, 0x6c, 0x01, 0x40, 0x08, 0xd4, 0x93,
-	0x88, 0xf8, 0x9a, 0xca, 0x13, 0x6c, 0x31, 0xde, 0x56, 0x3f, 0xff, 0x46, 0xcf, 0x18, 0xdf, 0x67,
-	0x41, 0xe9, 0x10, 0xbb, 0xc8, 0x19, 0x77, 0x47, 0x70, 0x15, 0xe4, 0x03, 0x12, 0x38, 0x58, 0x54,
-	0xa3, 0xda, 0x32, 0x80, 0xfb, 0xa0, 0xec, 0x22, 0xde, 0x36, 0xcf, 0x91, 0xab, 0x97, 0x3b, 0x77,
-	0x7e, 0x9d, 0xe8, 0x1b, 0xae, 0xc7, 0x06, 0xf1, 0xb1, 0xe9, 0x10, 0x3f, 0x6d, 0x66, 0xfa, 0xd3,
-	0xa6, 0xfd, 0xc7, 0x16, 0x1b, 0x87, 0x98, 0x9a, 0x07, 0x01, 0xb3, 0x4b, 0x2e, 0xa2, 0x0f, 0xf9,
-	0x5c, 0xd8, 0x00, 0x39, 0x17, 0x51, 0x51, 0xa5, 0xda, 0xa9, 0x4e, 0x27, 0x7a, 0x69, 0x1f, 0xd1,
-	0x43, 0xcf, 0xf7, 0x98, 0xcd, 0x01, 0xb8, 0x02, 0xb2, 0x8c, 0xa4, 0x35, 0x66, 0x19, 0x81, 0x0f,
-	0x40, 0x3e, 0x41, 0xc3, 0x18, 0x6b, 0x79, 0xb1, 0xe8, 0x3b, 0xff, 0x7e, 0xd1, 0xe9, 0x44, 0x2f,
-	0xec, 0xf8, 0x24, 0x0e, 0x98, 0x2d, 0x29, 0x78, 0x07, 0x44, 0x9f, 0x0b, 0x4d, 0xa5, 0x55, 0x4d,
-	0x3b, 0x5a, 0x05, 0x4a, 0xa2, 0x15, 0xc5, 0x07, 0x25, 0xe1, 0x51, 0xa4, 0x95, 0x64, 0x14, 0xf1,
-	0x88, 0x6a, 0x65, 0x19, 0xd1, 0xed, 0x15, 0xde, 0xab, 0x1f, 0x9f, 0xb6, 0x0b, 0xdd, 0xd1, 0x2e,
-	0x62, 0xc8, 0xf8, 0x2b, 0x07, 0xaa, 0x3b, 0x8e, 0x83, 0x29, 0x3d, 0xf4, 0x28, 0xeb, 0x8e, 0xe0,
-	0x27, 0xa0, 0xe4, 0x0c, 0x90, 0x17, 0xf4, 0xbc, 0xbe, 0x68, 0x5e, 0xb9, 0xf3, 0xde, 0x7f, 0xaa,
-	0xb6, 0x78, 0x8f, 0xcf, 0x3e, 0xd8, 0x3d, 0x9b, 0xe8, 0x45, 0x47, 0x0e, 0xed, 0x74, 0xd0, 0x9f,
-	0xcb, 0x92, 0xbd, 0x52, 0x96, 0xdc, 0xff, 0x97, 0x45, 0xbd, 0x5e, 0x96, 0xfc, 0x65, 0x59, 0x0a,
-	0x37, 0x27, 0x4b, 0xf1, 0x82, 0x2c, 0x1f, 0x81, 0x12, 0x12, 0xbd, 0xc5, 0x54, 0x2b, 0x35, 0x73,
-	0xad, 0xca, 0x56, 0xdd, 0x5c, 0x38, 0xe2, 0xa6, 0x6c, 0x7d, 0x37, 0x0e, 0x87, 0xb8, 0xd3, 0x7c,
-	0x36, 0xd1, 0x33, 0x67, 0x13, 0x1d, 0xa0, 0x73, 0x3d, 0xbe, 0xfd, 0x4d, 0x07, 0x73, 0x75, 0xec,
-	0x73, 0x36, 0x29, 0x78, 0x79, 0x41, 0x70, 0xb0, 0x20, 0x78, 0xe5, 0x2a, 0xc1, 0x7f, 0x50, 0x41,
-	0x75, 0x77, 0x1c, 0x20, 0xdf, 0x73, 0xee, 0x63, 0xfc, 0x6a, 0x04, 0x7f, 0x00, 0x2a, 0x5c, 0x70,
-	0xe6, 0x85, 0x3d, 0x07, 0x85, 0x2f, 0x21, 0x39, 0xf7, 0x4b, 0xd7, 0x0b, 0xef, 0xa1, 0x70, 0xc6,
-	0x75, 0x82, 0xb1, 0xe0, 0x52, 0x5f, 0x8a, 0xeb, 0x3e, 0xc6, 0x9c, 0x2b, 0xf5, 0x4f, 0xfe, 0x7a,
-	0xff, 0x14, 0x2e, 0xfb, 0xa7, 0x78, 0x73, 0xfe, 0x29, 0x5d, 0xe1, 0x9f, 0xf2, 0xcd, 0xfb, 0x07,
-	0x2c, 0xf8, 0xa7, 0xb2, 0xe0, 0x9f, 0xea, 0x55, 0xfe, 0x31, 0x40, 0x7d, 0x6f, 0xc4, 0x70, 0x40,
-	0x3d, 0x12, 0xbc, 0x1f, 0x8a, 0xd7, 0x62, 0xfe, 0x08, 0xa4, 0x57, 0xf1, 0x4f, 0x0a, 0xb8, 0xbd,
-	0xf0, 0x38, 0xd8, 0x98, 0x86, 0x24, 0xa0, 0x62, 0x97, 0xe2, 0x7e, 0x57, 0xe4, 0xf5, 0x2d, 0xae,
-	0xf4, 0x0d, 0xa0, 0x0e, 0x89, 0x4b, 0xb5, 0xac, 0xd8, 0x21, 0x5c, 0xda, 0xe1, 0x21, 0x71, 0x6d,
-	0x81, 0xc3, 0xd7, 0x41, 0x2e, 0xc2, 0x4c, 0xb8, 0xa5, 0x6a, 0xf3, 0x21, 0xac, 0x81, 0x52, 0xe2,
-	0xf7, 0x70, 0x14, 0x91, 0x28, 0xbd, 0x6c, 0x8b, 0x89, 0xbf, 0xc7, 0x43, 0x0e, 0x71, 0x5b, 0xc4,
-	0x14, 0xf7, 0xa5, 0x9e, 0x76, 0xd1, 0x45, 0xf4, 0x11, 0xc5, 0x7d, 0x68, 0x82, 0x37, 0x9d, 0xd8,
-	0x8f, 0x87, 0x88, 0x79, 0x09, 0xee, 0x9d, 0x67, 0x15, 0x44, 0xd6, 0x1b, 0x73, 0x68, 0x5f, 0xe6,
-	0xa7, 0x7b, 0xfa, 0x42, 0x01, 0xaf, 0x1d, 0x51, 0xf7, 0x51, 0xd8, 0x47, 0x0c, 0x3f, 0x44, 0x11,
-	0xf2, 0x29, 0x7c, 0x17, 0x94, 0x51, 0xcc, 0x06, 0x24, 0xf2, 0xd8, 0x38, 0x3d, 0x3b, 0xda, 0xcf,
-	0x4f, 0xdb, 0xab, 0xe9, 0x8b, 0xbc, 0xd3, 0xef, 0x47, 0x98, 0xd2, 0x0f, 0x58, 0xe4, 0x05, 0xae,
-	0x3d, 0x4f, 0x85, 0x77, 0x41, 0x21, 0x14, 0x0c, 0xe2, 0x58, 0x54, 0xb6, 0x6e, 0x2f, 0xed, 0x59,
-	0xd2, 0x77, 0x54, 0x2e, 0xa8, 0x9d, 0xa6, 0x6e, 0xaf, 0x3c, 0xf9, 0xf3, 0xbb, 0x3b, 0x73, 0x12,
-	0xa3, 0x06, 0xd6, 0x96, 0xea, 0x99, 0x75, 0x79, 0xeb, 0x85, 0x02, 0x72, 0x47, 0xd4, 0x85, 0x0c,
-	0x80, 0x0b, 0x0f, 0xf4, 0xfa, 0xd2, 0x2a, 0x0b, 0x0a, 0xd5, 0xdf, 0xbe, 0x0e, 0x9d, 0x31, 0x1b,
-	0xc6, 0x93, 0x17, 0x7f, 0x7c, 0x9d, 0x5d, 0x37, 0xea, 0xd6, 0xd2, 0xff, 0x8c, 0x34, 0xb5, 0xc7,
-	0x46, 0xf0, 0x43, 0x50, 0x5d, 0xe8, 0x52, 0xe3, 0x32, 0xf3, 0x45, 0xbc, 0xbe, 0x71, 0x3d, 0x3e,
-	0x5b, 0xbb, 0x73, 0xf0, 0x6c, 0xda, 0x50, 0x9e, 0x4f, 0x1b, 0xca, 0xef, 0xd3, 0x86, 0xf2, 0xd5,
-	0x69, 0x23, 0xf3, 0xfc, 0xb4, 0x91, 0xf9, 0xe5, 0xb4, 0x91, 0xf9, 0xd8, 0xba, 0x70, 0xe8, 0x24,
-	0x57, 0x3b, 0xc0, 0xec, 0x53, 0x12, 0x3d, 0x9e, 0x95, 0x99, 0x6c, 0x5a, 0x23, 0x51, 0xab, 0x38,
-	0x81, 0xc7, 0x05, 0xf1, 0xaf, 0xe4, 0xee, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xec, 0xd3,
-	0xde, 0x89, 0x09, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xae, 0x13, 0xe7, 0x6b, 0x92, 0xed, 0xfe, 0x7e, 0x43, 0x97, 0x75, 0x43, 0x37, 0x8e, 0x2c,
+	0x54, 0x45, 0x2b, 0xc5, 0x56, 0xbb, 0x88, 0x43, 0x4f, 0x34, 0xdb, 0x6e, 0xd5, 0xa5, 0x15, 0x95,
+	0xc9, 0x22, 0x04, 0x87, 0x68, 0x6a, 0x4f, 0x1d, 0xb3, 0xb1, 0xc7, 0xf2, 0x4c, 0x4c, 0xc2, 0x71,
+	0x4f, 0x9c, 0x00, 0x89, 0x3b, 0xe2, 0xcc, 0x09, 0x89, 0xbd, 0x72, 0x5f, 0x71, 0x5a, 0xb1, 0x17,
+	0xc4, 0x21, 0xa0, 0x16, 0x09, 0xa9, 0x07, 0x0e, 0xfc, 0x05, 0x68, 0x66, 0x9c, 0xa6, 0x4e, 0xd5,
+	0x0a, 0x96, 0x4a, 0x9c, 0x3c, 0xef, 0xc7, 0x3c, 0xf3, 0xce, 0xfb, 0x3c, 0x9e, 0x19, 0xf0, 0x2a,
+	0x8a, 0x19, 0x1e, 0x20, 0x0b, 0x27, 0x81, 0x95, 0xac, 0x59, 0x6c, 0x64, 0x46, 0x31, 0x61, 0x04,
+	0xde, 0x90, 0x7e, 0x13, 0x27, 0x81, 0x99, 0xac, 0xd5, 0x6f, 0x3b, 0x84, 0x06, 0x84, 0x5a, 0x01,
+	0xf5, 0x78, 0x5a, 0x40, 0x3d, 0x99, 0x57, 0x5f, 0x96, 0x81, 0x9e, 0xb0, 0x2c, 0x69, 0xa4, 0xa1,
+	0xdb, 0x59, 0x68, 0x8e, 0x24, 0x03, 0x4b, 0x1e, 0xf1, 0x88, 0x9c, 0xc0, 0x47, 0xa9, 0x77, 0xc5,
+	0x23, 0xc4, 0x1b, 0x60, 0x0b, 0x45, 0xbe, 0x85, 0xc2, 0x90, 0x30, 0xc4, 0x7c, 0x12, 0x4e, 0xc1,
+	0x96, 0xd3, 0xa8, 0xb0, 0x0e, 0x87, 0x47, 0x16, 0x0a, 0xc7, 0x32, 0x64, 0x7c, 0xae, 0x80, 0x1b,
+	0xfb, 0xd4, 0xdb, 0x66, 0x7d, 0x1c, 0xe3, 0x61, 0xd0, 0x1d, 0xc1, 0x16, 0x50, 0x5d, 0xc4, 0x90,
+	0xa6, 0x34, 0x95, 0x56, 0x75, 0x7d, 0xc9, 0x94, 0x73, 0xcd, 0xe9, 0x5c, 0x73, 0x33, 0x1c, 0xdb,
+	0x22, 0x03, 0x2e, 0x03, 0x95, 0xfa, 0x9f, 0x60, 0x2d, 0xd7, 0x54, 0x5a, 0x4a, 0xa7, 0x70, 0x3a,
+	0xd1, 0x95, 0xb6, 0x2d, 0x5c, 0x50, 0x07, 0x6a, 0x1f, 0xd1, 0xbe, 0x96, 0x6f, 0x2a, 0xad, 0x4a,
+	0xa7, 0xfa, 0xe7, 0x44, 0x2f, 0xc5, 0x83, 0x68, 0xc3, 0x68, 0x1b, 0xb6, 0x08, 0x40, 0x08, 0xd4,
+	0xa3, 0x98, 0x04, 0x9a, 0xca, 0x13, 0x6c, 0x31, 0xde, 0x50, 0x3f, 0xfd, 0x5a, 0x5f, 0x30, 0xbe,
+	0xcb, 0x81, 0xf2, 0x1e, 0xf6, 0x90, 0x33, 0xee, 0x8e, 0xe0, 0x12, 0x28, 0x84, 0x24, 0x74, 0xb0,
+	0xa8, 0x46, 0xb5, 0xa5, 0x01, 0x77, 0x40, 0xc5, 0x43, 0xbc, 0x6d, 0xbe, 0x23, 0x57, 0xaf, 0x74,
+	0xee, 0xfe, 0x3c, 0xd1, 0x57, 0x3d, 0x9f, 0xf5, 0x87, 0x87, 0xa6, 0x43, 0x82, 0xb4, 0x99, 0xe9,
+	0xa7, 0x4d, 0xdd, 0xc7, 0x16, 0x1b, 0x47, 0x98, 0x9a, 0xbb, 0x21, 0xb3, 0xcb, 0x1e, 0xa2, 0x07,
+	0x7c, 0x2e, 0x6c, 0x80, 0xbc, 0x87, 0xa8, 0xa8, 0x52, 0xed, 0xd4, 0x8e, 0x27, 0x7a, 0x79, 0x07,
+	0xd1, 0x3d, 0x3f, 0xf0, 0x99, 0xcd, 0x03, 0x70, 0x11, 0xe4, 0x18, 0x49, 0x6b, 0xcc, 0x31, 0x02,
+	0x1f, 0x82, 0x42, 0x82, 0x06, 0x43, 0xac, 0x15, 0xc4, 0xa2, 0x6f, 0xfc, 0xfd, 0x45, 0x8f, 0x27,
+	0x7a, 0x71, 0x33, 0x20, 0xc3, 0x90, 0xd9, 0x12, 0x82, 0x77, 0x40, 0xf4, 0xb9, 0xd8, 0x54, 0x5a,
+	0xb5, 0xb4, 0xa3, 0x35, 0xa0, 0x24, 0x5a, 0x49, 0x38, 0x94, 0x84, 0x5b, 0xb1, 0x56, 0x96, 0x56,
+	0xcc, 0x2d, 0xaa, 0x55, 0xa4, 0x45, 0x37, 0x16, 0x79, 0xaf, 0x7e, 0x78, 0xda, 0x2e, 0x76, 0x47,
+	0x5b, 0x88, 0x21, 0xe3, 0x8f, 0x3c, 0xa8, 0x6d, 0x3a, 0x0e, 0xa6, 0x74, 0xcf, 0xa7, 0xac, 0x3b,
+	0x82, 0x1f, 0x82, 0xb2, 0xd3, 0x47, 0x7e, 0xd8, 0xf3, 0x5d, 0xd1, 0xbc, 0x4a, 0xe7, 0xad, 0x7f,
+	0x54, 0x6d, 0xe9, 0x3e, 0x9f, 0xbd, 0xbb, 0x75, 0x3a, 0xd1, 0x4b, 0x8e, 0x1c, 0xda, 0xe9, 0xc0,
+	0x9d, 0xd1, 0x92, 0xbb, 0x94, 0x96, 0xfc, 0xbf, 0xa7, 0x45, 0xbd, 0x9a, 0x96, 0xc2, 0x45, 0x5a,
+	0x8a, 0xd7, 0x47, 0x4b, 0xe9, 0x1c, 0x2d, 0xef, 0x83, 0x32, 0x12, 0xbd, 0xc5, 0x54, 0x2b, 0x37,
+	0xf3, 0xad, 0xea, 0x7a, 0xdd, 0xcc, 0xfc, 0xe2, 0xa6, 0x6c, 0x7d, 0x77, 0x18, 0x0d, 0x70, 0xa7,
+	0xf9, 0x6c, 0xa2, 0x2f, 0x9c, 0x4e, 0x74, 0x80, 0xce, 0xf8, 0xf8, 0xe6, 0x17, 0x1d, 0xcc, 0xd8,
+	0xb1, 0xcf, 0xd0, 0x24, 0xe1, 0x95, 0x0c, 0xe1, 0x20, 0x43, 0x78, 0xf5, 0x32, 0xc2, 0xbf, 0x57,
+	0x41, 0x6d, 0x6b, 0x1c, 0xa2, 0xc0, 0x77, 0x1e, 0x60, 0xfc, 0xdf, 0x10, 0xfe, 0x10, 0x54, 0x39,
+	0xe1, 0xcc, 0x8f, 0x7a, 0x0e, 0x8a, 0x5e, 0x82, 0x72, 0xae, 0x97, 0xae, 0x1f, 0xdd, 0x47, 0xd1,
+	0x14, 0xeb, 0x08, 0x63, 0x81, 0xa5, 0xbe, 0x14, 0xd6, 0x03, 0x8c, 0x39, 0x56, 0xaa, 0x9f, 0xc2,
+	0xd5, 0xfa, 0x29, 0x5e, 0xd4, 0x4f, 0xe9, 0xfa, 0xf4, 0x53, 0xbe, 0x44, 0x3f, 0x95, 0xeb, 0xd7,
+	0x0f, 0xc8, 0xe8, 0xa7, 0x9a, 0xd1, 0x4f, 0xed, 0x32, 0xfd, 0x18, 0xa0, 0xbe, 0x3d, 0x62, 0x38,
+	0xa4, 0x3e, 0x09, 0xdf, 0x89, 0xc4, 0x6d, 0x31, 0xbb, 0x04, 0xd2, 0xa3, 0xf8, 0xab, 0x1c, 0xb8,
+	0x95, 0xb9, 0x1c, 0x6c, 0x4c, 0x23, 0x12, 0x52, 0xb1, 0x4b, 0x71, 0xbe, 0x2b, 0xf2, 0xf8, 0xe6,
+	0x63, 0xb8, 0x0a, 0xd4, 0x01, 0xf1, 0xa8, 0x96, 0x13, 0x3b, 0x84, 0x73, 0x3b, 0xdc, 0x23, 0x9e,
+	0x2d, 0xe2, 0xf0, 0x7f, 0x20, 0x1f, 0x63, 0x26, 0xd4, 0x52, 0xb3, 0xf9, 0x10, 0x2e, 0x83, 0x72,
+	0x12, 0xf4, 0x70, 0x1c, 0x93, 0x38, 0x3d, 0x6c, 0x4b, 0x49, 0xb0, 0xcd, 0x4d, 0x1e, 0xe2, 0xb2,
+	0x18, 0x52, 0xec, 0x4a, 0x3e, 0xed, 0x92, 0x87, 0xe8, 0x23, 0x8a, 0x5d, 0x68, 0x82, 0x57, 0x9c,
+	0x61, 0x30, 0x1c, 0x20, 0xe6, 0x27, 0xb8, 0x77, 0x96, 0x55, 0x14, 0x59, 0xff, 0x9f, 0x85, 0x76,
+	0xd2, 0xfc, 0xb7, 0xc1, 0x4d, 0x44, 0x23, 0xec, 0xb0, 0x59, 0x6e, 0x49, 0x94, 0x7a, 0x67, 0x9e,
+	0x0c, 0x91, 0x25, 0xa6, 0x21, 0x0f, 0x77, 0x54, 0xce, 0x87, 0x7d, 0x03, 0xcd, 0xbc, 0xd8, 0x4d,
+	0x1b, 0xe4, 0x81, 0xc5, 0x6c, 0x32, 0x7c, 0x0d, 0x54, 0xd2, 0x45, 0xa6, 0xbf, 0xa1, 0x5d, 0x96,
+	0x8e, 0x5d, 0x17, 0xde, 0x01, 0xe0, 0x23, 0xe2, 0x87, 0xbd, 0x88, 0xf8, 0x21, 0x93, 0x17, 0x97,
+	0x5d, 0xe1, 0x9e, 0x03, 0xee, 0xc8, 0xec, 0x35, 0x9f, 0xd9, 0xab, 0xf1, 0x99, 0x02, 0x6e, 0xee,
+	0x53, 0xef, 0x51, 0xe4, 0x22, 0x86, 0x0f, 0x50, 0x8c, 0x02, 0x0a, 0xdf, 0x04, 0x15, 0x34, 0x64,
+	0x7d, 0x12, 0xfb, 0x6c, 0x9c, 0xfe, 0xf1, 0xda, 0x8f, 0x4f, 0xdb, 0x4b, 0xe9, 0x3b, 0x62, 0xd3,
+	0x75, 0x63, 0x4c, 0xe9, 0xbb, 0x2c, 0xf6, 0x43, 0xcf, 0x9e, 0xa5, 0xc2, 0x7b, 0xa0, 0x18, 0x09,
+	0x04, 0x51, 0x41, 0x75, 0xfd, 0xd6, 0xdc, 0xf6, 0x25, 0x7c, 0xba, 0xed, 0x34, 0x75, 0x63, 0xf1,
+	0xc9, 0xef, 0xdf, 0xde, 0x9d, 0x81, 0x18, 0xcb, 0xe0, 0xf6, 0x5c, 0x3d, 0x53, 0x6d, 0xac, 0xbf,
+	0x50, 0x40, 0x7e, 0x9f, 0x7a, 0x90, 0x01, 0x70, 0xee, 0x59, 0xb1, 0x32, 0xb7, 0x4a, 0x46, 0x57,
+	0xf5, 0xd7, 0xaf, 0x8a, 0x4e, 0x91, 0x0d, 0xe3, 0xc9, 0x8b, 0xdf, 0xbe, 0xcc, 0xad, 0x18, 0x75,
+	0x6b, 0xee, 0x75, 0x94, 0xa6, 0xf6, 0xd8, 0x08, 0xbe, 0x07, 0x6a, 0x99, 0x2e, 0x35, 0x2e, 0x22,
+	0x9f, 0x8f, 0xd7, 0x57, 0xaf, 0x8e, 0x4f, 0xd7, 0xee, 0xec, 0x3e, 0x3b, 0x6e, 0x28, 0xcf, 0x8f,
+	0x1b, 0xca, 0xaf, 0xc7, 0x0d, 0xe5, 0x8b, 0x93, 0xc6, 0xc2, 0xf3, 0x93, 0xc6, 0xc2, 0x4f, 0x27,
+	0x8d, 0x85, 0x0f, 0xac, 0x73, 0x47, 0x85, 0xc4, 0x6a, 0x87, 0x98, 0x7d, 0x4c, 0xe2, 0xc7, 0xd3,
+	0x32, 0x93, 0x35, 0x6b, 0x24, 0x6a, 0x15, 0xe7, 0xc6, 0x61, 0x51, 0xbc, 0xa5, 0xee, 0xfd, 0x35,
+	0x00, 0x8a, 0xd6, 0x2a, 0xcf, 0x3f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1083,6 +1155,20 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AspectGasUsed) > 0 {
+		for iNdEx := len(m.AspectGasUsed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AspectGasUsed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -1131,6 +1217,48 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AspectGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JoinPoint) > 0 {
+		i -= len(m.JoinPoint)
+		copy(dAtA[i:], m.JoinPoint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JoinPoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1421,6 +1549,32 @@ func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovTx(uint64(m.CumulativeGasUsed))
 	}
+	if len(m.AspectGasUsed) > 0 {
+		for _, e := range m.AspectGasUsed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AspectGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JoinPoint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -3024,6 +3178,173 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectGasUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectGasUsed = append(m.AspectGasUsed, AspectGasUsage{})
+			if err := m.AspectGasUsed[len(m.AspectGasUsed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -133,6 +133,25 @@ func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	return &res, nil
 }
 
+// DecodeTxResponses decodes an protobuf-encoded byte slice into TxResponses of all the messages
+func DecodeTxResponses(in []byte) ([]*MsgEthereumTxResponse, error) {
+	var txMsgData cosmos.TxMsgData
+	if err := proto.Unmarshal(in, &txMsgData); err != nil {
+		return nil, err
+	}
+
+	responses := make([]*MsgEthereumTxResponse, 0, len(txMsgData.MsgResponses))
+	for _, msgResponse := range txMsgData.MsgResponses {
+		var res MsgEthereumTxResponse
+		if err := proto.Unmarshal(msgResponse.Value, &res); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to unmarshal txs response message data")
+		}
+		responses = append(responses, &res)
+	}
+
+	return responses, nil
+}
+
 // EncodeTransactionLogs encodes TransactionLogs slice into a protobuf-encoded byte slice.
 func EncodeTransactionLogs(res *support.TransactionLogs) ([]byte, error) {
 	return proto.Marshal(res)
@@ -363,8 +363,12 @@ func NewTxDataFromTx(tx *ethereum.Transaction) (TxData, error) {
 		txData, err = newDynamicFeeTx(tx)
 	case ethereum.AccessListTxType:
 		txData, err = newAccessListTx(tx)
-	default:
+	case ethereum.LegacyTxType:
 		txData, err = newLegacyTx(tx)
+	case ethereum.BlobTxType:
+		return nil, errorsmod.Wrapf(types.ErrBlobTxNotSupported, "txs %s", tx.Hash().Hex())
+	default:
+		return nil, errorsmod.Wrapf(ethereum.ErrTxTypeNotSupported, "txs type %d", tx.Type())
 	}
 	if err != nil {
 		return nil, err
@@ -0,0 +1,40 @@
+package txs
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestNewTxDataFromTxBlobTx(t *testing.T) {
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx := ethereum.NewTx(&ethereum.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        21000,
+		To:         &to,
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: []common.Hash{{0x01}},
+	})
+
+	_, err := NewTxDataFromTx(tx)
+	require.ErrorIs(t, err, types.ErrBlobTxNotSupported)
+
+	// the other typed txs are still supported
+	txData, err := NewTxDataFromTx(ethereum.NewTx(&ethereum.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       21000,
+		To:        &to,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, uint8(ethereum.DynamicFeeTxType), txData.TxType())
+}
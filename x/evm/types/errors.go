@@ -29,6 +29,7 @@ const (
 	codeErrInvalidGasLimit
 	codeErrCallContract
 	codeErrAspectNotFound
+	codeErrBlobTxNotSupported
 )
 
 var (
@@ -80,6 +81,9 @@ var (
 	ErrCallContract = errorsmod.Register(ModuleName, codeErrCallContract, "call contract error")
 
 	ErrAspectNotFound = errorsmod.Register(ModuleName, codeErrAspectNotFound, "aspect not found error")
+
+	// ErrBlobTxNotSupported returns an error if an EIP-4844 blob txs is submitted, blobs are not supported.
+	ErrBlobTxNotSupported = errorsmod.Register(ModuleName, codeErrBlobTxNotSupported, "blob transactions are not supported")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -7,6 +7,7 @@ import (
 	authmodule "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramsmodule "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingmodule "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	feemodule "github.com/artela-network/artela/x/fee/types"
 )
@@ -44,6 +45,9 @@ type FeeKeeper interface {
 	GetBaseFee(ctx cosmos.Context) *big.Int
 	GetParams(ctx cosmos.Context) feemodule.Params
 	AddTransientGasWanted(ctx cosmos.Context, gasWanted uint64) (uint64, error)
+	ShareAspectFees(ctx cosmos.Context, gasPrice *big.Int, gasUsed uint64, denom string, aspectGas []feemodule.AspectGas) error
+	AddTransientTxReward(ctx cosmos.Context, txHash common.Hash, gasUsed uint64, reward *big.Int)
+	AddTransientEthGasUsed(ctx cosmos.Context, gasUsed uint64) (uint64, error)
 }
 
 type (
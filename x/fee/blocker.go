@@ -36,7 +36,8 @@ func BeginBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestBeginBlock)
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock updates the block gas used to adjust the base fee, according to the
+// base fee mode, and records the fee history of the block.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) {
@@ -58,12 +59,26 @@ func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) {
 		return
 	}
 
-	// to prevent BaseFee manipulation we limit the gasWanted so that
-	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
-	// this will be keep BaseFee protected from un-penalized manipulation
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := cosmos.NewDec(gasWanted.Int64()).Mul(minGasMultiplier)
-	updatedGasWanted := cosmos.MaxDec(limitedGasWanted, cosmos.NewDec(gasUsed.Int64())).TruncateInt().Uint64()
+	params := k.GetParams(ctx)
+	var updatedGasWanted uint64
+	switch params.BaseFeeMode {
+	case types.BaseFeeMode_BASE_FEE_MODE_GAS_USED:
+		// only the gas actually consumed by the ethereum txs is accounted, so that
+		// over-estimated gas limits don't push the base fee up
+		updatedGasWanted = k.GetTransientEthGasUsed(ctx)
+	case types.BaseFeeMode_BASE_FEE_MODE_HYBRID:
+		// the gas used by the ethereum txs, bounded below by the limited gas wanted
+		// to keep the protection against un-penalized manipulation
+		limitedGasWanted := cosmos.NewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
+		ethGasUsed := cosmos.NewDecFromInt(sdkmath.NewIntFromUint64(k.GetTransientEthGasUsed(ctx)))
+		updatedGasWanted = cosmos.MaxDec(limitedGasWanted, ethGasUsed).TruncateInt().Uint64()
+	default:
+		// to prevent BaseFee manipulation we limit the gasWanted so that
+		// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
+		// this will be keep BaseFee protected from un-penalized manipulation
+		limitedGasWanted := cosmos.NewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
+		updatedGasWanted = cosmos.MaxDec(limitedGasWanted, cosmos.NewDec(gasUsed.Int64())).TruncateInt().Uint64()
+	}
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
 	defer func() {
@@ -75,4 +90,6 @@ func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) {
 		cosmos.NewAttribute("height", fmt.Sprintf("%d", ctx.BlockHeight())),
 		cosmos.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
 	))
+
+	k.RecordFeeHistory(ctx, gasUsed.Uint64())
 }
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetAspectFeesCmd(),
+		GetFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -114,3 +118,72 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAspectFeesCmd queries the claimable aspect fees of a beneficiary
+func GetAspectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aspect-fees BENEFICIARY",
+		Short: "Get the claimable aspect fees of a beneficiary",
+		Long:  "Get the aspect fees shared with the beneficiary and not claimed yet.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AspectFees(cmd.Context(), &types.QueryAspectFeesRequest{Beneficiary: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeHistoryCmd queries the fee history of a range of blocks
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history LAST_BLOCK BLOCK_COUNT",
+		Short: "Get the fee history of a range of blocks",
+		Long: `Get the fee history of the BLOCK_COUNT blocks ending at LAST_BLOCK.
+If LAST_BLOCK is 0, it will use the latest height.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lastBlock, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			blockCount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{
+				LastBlock:  lastBlock,
+				BlockCount: blockCount,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
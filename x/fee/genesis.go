@@ -22,13 +22,18 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, genState.BlockGas)
 
+	for _, balance := range genState.AspectFees {
+		k.SetAspectFees(ctx, cosmos.MustAccAddressFromBech32(balance.Beneficiary), balance.Amount)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis states of the fee market module
 func ExportGenesis(ctx cosmos.Context, k *keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:     k.GetParams(ctx),
+		BlockGas:   k.GetBlockGasWanted(ctx),
+		AspectFees: k.GetAllAspectFees(ctx),
 	}
 }
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authmodule "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/artela-network/artela/x/fee/types"
+)
+
+// ShareAspectFees credits the beneficiaries of the aspects a share of the fees paid for the gas consumed by
+// the aspects, at the given gas price. The total share is capped at the fee paid for the gas used by the
+// transaction, the beneficiaries coming later get the remainder once the cap is reached. The shared fees are
+// moved from the fee collector to the module account, and held there until claimed by the beneficiaries.
+// CONTRACT: this should be only called after the leftover gas of the transaction is refunded.
+func (k Keeper) ShareAspectFees(ctx cosmos.Context, gasPrice *big.Int, gasUsed uint64, denom string, aspectGas []types.AspectGas) error {
+	params := k.GetParams(ctx)
+	if !params.IsAspectFeeShareEnabled() || gasPrice == nil || gasPrice.Sign() <= 0 {
+		return nil
+	}
+
+	price := sdkmath.NewIntFromBigInt(gasPrice)
+	remaining := price.Mul(sdkmath.NewIntFromUint64(gasUsed))
+	total := cosmos.NewCoins()
+	for _, gas := range aspectGas {
+		fee := params.AspectFeeShare.MulInt(price).MulInt(sdkmath.NewIntFromUint64(gas.GasUsed)).TruncateInt()
+		if fee.GT(remaining) {
+			fee = remaining
+		}
+		if !fee.IsPositive() {
+			continue
+		}
+		remaining = remaining.Sub(fee)
+
+		amount := cosmos.NewCoins(cosmos.NewCoin(denom, fee))
+		k.SetAspectFees(ctx, gas.Beneficiary, k.GetAspectFees(ctx, gas.Beneficiary).Add(amount...))
+		total = total.Add(amount...)
+
+		ctx.EventManager().EmitEvent(cosmos.NewEvent(
+			types.EventTypeAspectFee,
+			cosmos.NewAttribute(types.AttributeKeyBeneficiary, gas.Beneficiary.String()),
+			cosmos.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		))
+	}
+
+	if total.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authmodule.FeeCollectorName, types.ModuleName, total); err != nil {
+		return errorsmod.Wrapf(err, "failed to share aspect fees %s", total)
+	}
+	return nil
+}
+
+// WithdrawAspectFees pays out all the claimable aspect fees to the beneficiary.
+func (k Keeper) WithdrawAspectFees(ctx cosmos.Context, beneficiary cosmos.AccAddress) (cosmos.Coins, error) {
+	amount := k.GetAspectFees(ctx, beneficiary)
+	if amount.IsZero() {
+		return amount, nil
+	}
+
+	k.SetAspectFees(ctx, beneficiary, nil)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, amount); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to pay out aspect fees %s to %s", amount, beneficiary)
+	}
+
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(
+		types.EventTypeClaimAspectFees,
+		cosmos.NewAttribute(types.AttributeKeyBeneficiary, beneficiary.String()),
+		cosmos.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+	return amount, nil
+}
+
+// GetAspectFees returns the claimable aspect fees of the beneficiary.
+func (k Keeper) GetAspectFees(ctx cosmos.Context, beneficiary cosmos.AccAddress) cosmos.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AspectFeeKey(beneficiary))
+	if len(bz) == 0 {
+		return cosmos.NewCoins()
+	}
+
+	var balance types.AspectFeeBalance
+	k.cdc.MustUnmarshal(bz, &balance)
+	return balance.Amount
+}
+
+// SetAspectFees sets the claimable aspect fees of the beneficiary, the record is deleted if the amount is zero.
+func (k Keeper) SetAspectFees(ctx cosmos.Context, beneficiary cosmos.AccAddress, amount cosmos.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.AspectFeeKey(beneficiary))
+		return
+	}
+
+	store.Set(types.AspectFeeKey(beneficiary), k.cdc.MustMarshal(&types.AspectFeeBalance{
+		Beneficiary: beneficiary.String(),
+		Amount:      amount,
+	}))
+}
+
+// GetAllAspectFees returns the claimable aspect fees of all the beneficiaries.
+func (k Keeper) GetAllAspectFees(ctx cosmos.Context) []types.AspectFeeBalance {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAspectFee)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	balances := make([]types.AspectFeeBalance, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var balance types.AspectFeeBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &balance)
+		balances = append(balances, balance)
+	}
+	return balances
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/fee/types"
+)
+
+// mockBankKeeper keeps the balances of the module and user accounts by name
+type mockBankKeeper struct {
+	balances map[string]cosmos.Coins
+}
+
+func (b *mockBankKeeper) send(from, to string, amt cosmos.Coins) error {
+	balance, hasNeg := b.balances[from].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds of %s", from)
+	}
+	b.balances[from] = balance
+	b.balances[to] = b.balances[to].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(_ cosmos.Context, senderModule, recipientModule string, amt cosmos.Coins) error {
+	return b.send(senderModule, recipientModule, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ cosmos.Context, senderModule string, recipientAddr cosmos.AccAddress, amt cosmos.Coins) error {
+	return b.send(senderModule, recipientAddr.String(), amt)
+}
+
+func newAspectFeeTestKeeper(t *testing.T, share string) (*Keeper, cosmos.Context, *mockBankKeeper) {
+	k, ctx := newTestKeeper(t)
+	bank := &mockBankKeeper{balances: map[string]cosmos.Coins{
+		authtypes.FeeCollectorName: cosmos.NewCoins(cosmos.NewInt64Coin("aart", 1_000_000)),
+	}}
+	k.bankKeeper = bank
+
+	params := types.DefaultParams()
+	params.AspectFeeShare = cosmos.MustNewDecFromStr(share)
+	require.NoError(t, k.SetParams(ctx, params))
+	return k, ctx, bank
+}
+
+func TestShareAspectFees(t *testing.T) {
+	k, ctx, bank := newAspectFeeTestKeeper(t, "0.5")
+	first := cosmos.AccAddress([]byte("first_beneficiary___"))
+	second := cosmos.AccAddress([]byte("second_beneficiary__"))
+	third := cosmos.AccAddress([]byte("third_beneficiary___"))
+
+	// the fee of the tx is 10 * 100 = 1000, the shares are 10 * 80 * 0.5 = 400, 10 * 100 * 0.5 = 500,
+	// and 10 * 60 * 0.5 = 300 capped at the remaining 100
+	require.NoError(t, k.ShareAspectFees(ctx, big.NewInt(10), 100, "aart", []types.AspectGas{
+		{Beneficiary: first, GasUsed: 80},
+		{Beneficiary: second, GasUsed: 100},
+		{Beneficiary: third, GasUsed: 60},
+	}))
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("aart", 400)), k.GetAspectFees(ctx, first))
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("aart", 500)), k.GetAspectFees(ctx, second))
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("aart", 100)), k.GetAspectFees(ctx, third))
+
+	// the shared fees are moved from the fee collector to the module account
+	require.Equal(t, sdkmath.NewInt(999_000), bank.balances[authtypes.FeeCollectorName].AmountOf("aart"))
+	require.Equal(t, sdkmath.NewInt(1000), bank.balances[types.ModuleName].AmountOf("aart"))
+
+	// the shares are accumulated until claimed
+	require.NoError(t, k.ShareAspectFees(ctx, big.NewInt(10), 100, "aart", []types.AspectGas{
+		{Beneficiary: first, GasUsed: 20},
+	}))
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("aart", 500)), k.GetAspectFees(ctx, first))
+	require.Len(t, k.GetAllAspectFees(ctx), 3)
+}
+
+func TestShareAspectFeesDisabled(t *testing.T) {
+	beneficiary := cosmos.AccAddress([]byte("beneficiary_________"))
+	aspectGas := []types.AspectGas{{Beneficiary: beneficiary, GasUsed: 100}}
+
+	k, ctx, bank := newAspectFeeTestKeeper(t, "0")
+	require.NoError(t, k.ShareAspectFees(ctx, big.NewInt(10), 100, "aart", aspectGas))
+	require.True(t, k.GetAspectFees(ctx, beneficiary).IsZero())
+	require.True(t, bank.balances[types.ModuleName].IsZero())
+
+	// nothing is shared without a gas price
+	k, ctx, bank = newAspectFeeTestKeeper(t, "0.5")
+	require.NoError(t, k.ShareAspectFees(ctx, big.NewInt(0), 100, "aart", aspectGas))
+	require.True(t, k.GetAspectFees(ctx, beneficiary).IsZero())
+	require.True(t, bank.balances[types.ModuleName].IsZero())
+}
+
+func TestClaimAspectFees(t *testing.T) {
+	k, ctx, bank := newAspectFeeTestKeeper(t, "0.5")
+	beneficiary := cosmos.AccAddress([]byte("beneficiary_________"))
+	require.NoError(t, k.ShareAspectFees(ctx, big.NewInt(10), 100, "aart", []types.AspectGas{
+		{Beneficiary: beneficiary, GasUsed: 100},
+	}))
+
+	res, err := k.ClaimAspectFees(cosmos.WrapSDKContext(ctx), &types.MsgClaimAspectFees{Beneficiary: beneficiary.String()})
+	require.NoError(t, err)
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("aart", 500)), res.Amount)
+
+	// the fees are paid out from the module account, and can be claimed only once
+	require.Equal(t, sdkmath.NewInt(500), bank.balances[beneficiary.String()].AmountOf("aart"))
+	require.True(t, bank.balances[types.ModuleName].IsZero())
+	require.True(t, k.GetAspectFees(ctx, beneficiary).IsZero())
+	require.Empty(t, k.GetAllAspectFees(ctx))
+
+	res, err = k.ClaimAspectFees(cosmos.WrapSDKContext(ctx), &types.MsgClaimAspectFees{Beneficiary: beneficiary.String()})
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+	require.Equal(t, sdkmath.NewInt(500), bank.balances[beneficiary.String()].AmountOf("aart"))
+
+	_, err = k.ClaimAspectFees(cosmos.WrapSDKContext(ctx), &types.MsgClaimAspectFees{Beneficiary: "invalid"})
+	require.Error(t, err)
+}
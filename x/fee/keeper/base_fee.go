@@ -41,6 +41,8 @@ func (k Keeper) CalculateBaseFee(ctx cosmos.Context) *big.Int {
 		return nil
 	}
 
+	// NOTE: the block gas stored at EndBlock depends on the base fee mode, it is either
+	// the limited gas wanted, the gas used by the ethereum txs, or the max of both.
 	parentGasUsed := k.GetBlockGasWanted(ctx)
 
 	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/fee/types"
+)
+
+// defaultBlockGasLimit is the gas limit reported for blocks without a gas limit, which is max uint32
+// to not error with javascript dev tooling, same as the json-rpc
+const defaultBlockGasLimit = uint64(^uint32(0))
+
+// AddTransientTxReward records the gas used and the effective priority fee per gas paid by an ethereum txs
+// of the current block, which are used to compute the rewards of the block fee history.
+func (k Keeper) AddTransientTxReward(ctx cosmos.Context, txHash common.Hash, gasUsed uint64, reward *big.Int) {
+	if reward == nil || reward.Sign() < 0 {
+		reward = new(big.Int)
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientTxRewardKey(txHash), append(cosmos.Uint64ToBigEndian(gasUsed), reward.Bytes()...))
+}
+
+// txGasAndReward is the gas used and the effective priority fee per gas of a txs
+type txGasAndReward struct {
+	gasUsed uint64
+	reward  *big.Int
+}
+
+// getTransientTxRewards returns the txs rewards of the current block sorted by reward in ascending order.
+func (k Keeper) getTransientTxRewards(ctx cosmos.Context) []txGasAndReward {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTxReward)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var rewards []txGasAndReward
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		if len(bz) < 8 {
+			continue
+		}
+		rewards = append(rewards, txGasAndReward{
+			gasUsed: cosmos.BigEndianToUint64(bz[:8]),
+			reward:  new(big.Int).SetBytes(bz[8:]),
+		})
+	}
+
+	sort.SliceStable(rewards, func(i, j int) bool {
+		return rewards[i].reward.Cmp(rewards[j].reward) < 0
+	})
+	return rewards
+}
+
+// RecordFeeHistory saves the fee history of the current block into the ring buffer, overwriting the
+// record of the block FeeHistorySize blocks before. This should be called at the end of the block.
+func (k Keeper) RecordFeeHistory(ctx cosmos.Context, gasUsed uint64) {
+	record := types.BlockFeeHistory{
+		Height:   ctx.BlockHeight(),
+		GasUsed:  gasUsed,
+		GasLimit: defaultBlockGasLimit,
+	}
+
+	params := k.GetParams(ctx)
+	if params.IsBaseFeeEnabled(ctx.BlockHeight()) && !params.BaseFee.IsNil() {
+		baseFee := params.BaseFee
+		record.BaseFee = &baseFee
+	}
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		record.GasLimit = uint64(consParams.Block.MaxGas)
+	}
+
+	// the tx rewards are kept as is for the rewards at any percentile, the adjacent txs with the same
+	// reward are merged, which doesn't change the reward at any percentile
+	for _, txReward := range k.getTransientTxRewards(ctx) {
+		last := len(record.TxRewards) - 1
+		if last >= 0 && record.TxRewards[last].Reward.BigInt().Cmp(txReward.reward) == 0 {
+			record.TxRewards[last].GasUsed += txReward.gasUsed
+			continue
+		}
+		record.TxRewards = append(record.TxRewards, types.TxReward{
+			GasUsed: txReward.gasUsed,
+			Reward:  sdkmath.NewIntFromBigInt(txReward.reward),
+		})
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeHistoryKey(record.Height), k.cdc.MustMarshal(&record))
+}
+
+// GetFeeHistory returns the fee history of the block at the given height, false if the block is not
+// in the ring buffer.
+func (k Keeper) GetFeeHistory(ctx cosmos.Context, height int64) (types.BlockFeeHistory, bool) {
+	var record types.BlockFeeHistory
+	if height < 0 {
+		return record, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeHistoryKey(height))
+	if len(bz) == 0 {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	// the slot may be taken by an older block, or never written since the history is enabled
+	if record.Height != height {
+		return types.BlockFeeHistory{}, false
+	}
+	return record, true
+}
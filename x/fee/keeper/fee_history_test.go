@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/fee/types"
+)
+
+func TestFeeHistoryRingBuffer(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	k.RecordFeeHistory(ctx.WithBlockHeight(5), 100)
+	record, found := k.GetFeeHistory(ctx, 5)
+	require.True(t, found)
+	require.Equal(t, int64(5), record.Height)
+	require.Equal(t, uint64(100), record.GasUsed)
+
+	// the blocks sharing the slot of block 5 are not found
+	_, found = k.GetFeeHistory(ctx, 5+types.FeeHistorySize)
+	require.False(t, found)
+	_, found = k.GetFeeHistory(ctx, 6)
+	require.False(t, found)
+	_, found = k.GetFeeHistory(ctx, -1)
+	require.False(t, found)
+
+	// the record wraps around and overwrites the block FeeHistorySize blocks before
+	k.RecordFeeHistory(ctx.WithBlockHeight(5+types.FeeHistorySize), 200)
+	_, found = k.GetFeeHistory(ctx, 5)
+	require.False(t, found)
+	record, found = k.GetFeeHistory(ctx, 5+types.FeeHistorySize)
+	require.True(t, found)
+	require.Equal(t, uint64(200), record.GasUsed)
+
+	// only the blocks in the ring buffer are returned
+	ctx = ctx.WithBlockHeight(6 + types.FeeHistorySize)
+	res, err := k.FeeHistory(cosmos.WrapSDKContext(ctx), &types.QueryFeeHistoryRequest{BlockCount: types.FeeHistorySize})
+	require.NoError(t, err)
+	require.Len(t, res.Blocks, 1)
+	require.Equal(t, int64(5+types.FeeHistorySize), res.Blocks[0].Height)
+
+	_, err = k.FeeHistory(cosmos.WrapSDKContext(ctx), &types.QueryFeeHistoryRequest{BlockCount: types.FeeHistorySize + 1})
+	require.Error(t, err)
+}
+
+func TestFeeHistoryRewards(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	// an empty block has no rewards
+	k.RecordFeeHistory(ctx, 0)
+	record, found := k.GetFeeHistory(ctx, ctx.BlockHeight())
+	require.True(t, found)
+	require.Empty(t, record.TxRewards)
+	require.Equal(t, big.NewInt(0), record.Reward(50))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AddTransientTxReward(ctx, common.Hash{1}, 500, big.NewInt(3))
+	k.AddTransientTxReward(ctx, common.Hash{2}, 300, big.NewInt(1))
+	k.AddTransientTxReward(ctx, common.Hash{3}, 200, big.NewInt(3))
+	k.RecordFeeHistory(ctx, 1000)
+
+	record, found = k.GetFeeHistory(ctx, ctx.BlockHeight())
+	require.True(t, found)
+	// the txs are sorted by reward, and the txs with the same reward are merged
+	require.Equal(t, []types.TxReward{
+		{GasUsed: 300, Reward: sdkmath.NewInt(1)},
+		{GasUsed: 700, Reward: sdkmath.NewInt(3)},
+	}, record.TxRewards)
+
+	for _, tc := range []struct {
+		percentile float64
+		reward     int64
+	}{
+		{0, 1},
+		{30, 1},
+		// fractional percentiles are not rounded down to the integer percentiles
+		{30.05, 1},
+		{30.1, 3},
+		{100, 3},
+	} {
+		require.Equal(t, big.NewInt(tc.reward), record.Reward(tc.percentile), "percentile %f", tc.percentile)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/artela-network/artela/x/fee/types"
 )
@@ -50,3 +52,57 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// AspectFees implements the Query/AspectFees gRPC method
+func (k Keeper) AspectFees(c context.Context, req *types.QueryAspectFeesRequest) (*types.QueryAspectFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	beneficiary, err := cosmos.AccAddressFromBech32(req.Beneficiary)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	return &types.QueryAspectFeesResponse{
+		Amount: k.GetAspectFees(ctx, beneficiary),
+	}, nil
+}
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.BlockCount > types.FeeHistorySize {
+		return nil, status.Errorf(codes.InvalidArgument, "block count %d higher than %d", req.BlockCount, types.FeeHistorySize)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	lastBlock := req.LastBlock
+	if lastBlock <= 0 || lastBlock > ctx.BlockHeight() {
+		lastBlock = ctx.BlockHeight()
+	}
+
+	res := &types.QueryFeeHistoryResponse{
+		Blocks: make([]types.BlockFeeHistory, 0, req.BlockCount),
+	}
+	for height := lastBlock - int64(req.BlockCount) + 1; height <= lastBlock; height++ {
+		if record, found := k.GetFeeHistory(ctx, height); found {
+			res.Blocks = append(res.Blocks, record)
+		}
+	}
+
+	// the base fee of the block after the latest one is calculated from the current states
+	if next, found := k.GetFeeHistory(ctx, lastBlock+1); found {
+		res.NextBaseFee = next.BaseFee
+	} else if lastBlock == ctx.BlockHeight() {
+		if baseFee := k.CalculateBaseFee(ctx.WithBlockHeight(lastBlock + 1)); baseFee != nil {
+			aux := sdkmath.NewIntFromBigInt(baseFee)
+			res.NextBaseFee = &aux
+		}
+	}
+
+	return res, nil
+}
@@ -21,13 +21,16 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority cosmos.AccAddress
+	// bank keeper holding the claimable aspect fees
+	bankKeeper types.BankKeeper
 	// Legacy subspace
 	ss paramsmodule.Subspace
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, authority cosmos.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramsmodule.Subspace,
+	cdc codec.BinaryCodec, authority cosmos.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper, ss paramsmodule.Subspace,
 ) *Keeper {
 	// ensure authority account is correctly formatted
 	if err := cosmos.VerifyAddressFormat(authority); err != nil {
@@ -39,6 +42,7 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+		bankKeeper:   bankKeeper,
 		ss:           ss,
 	}
 }
@@ -103,3 +107,21 @@ func (k Keeper) AddTransientGasWanted(ctx cosmos.Context, gasWanted uint64) (uin
 	k.SetTransientBlockGasWanted(ctx, result)
 	return result, nil
 }
+
+// GetTransientEthGasUsed returns the gas used by the ethereum txs in the current block from transient store.
+func (k Keeper) GetTransientEthGasUsed(ctx cosmos.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientEthGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+	return cosmos.BigEndianToUint64(bz)
+}
+
+// AddTransientEthGasUsed adds the cumulative gas used by the ethereum txs in the transient store
+func (k Keeper) AddTransientEthGasUsed(ctx cosmos.Context, gasUsed uint64) (uint64, error) {
+	result := k.GetTransientEthGasUsed(ctx) + gasUsed
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientEthGasUsed, cosmos.Uint64ToBigEndian(result))
+	return result, nil
+}
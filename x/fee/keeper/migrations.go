@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/fee/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5, existing chains
+// keep adjusting the base fee by the gas wanted until changed by governance.
+func (m Migrator) Migrate4to5(ctx cosmos.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.BaseFeeMode = types.BaseFeeMode_BASE_FEE_MODE_GAS_WANTED
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6, the aspect fee share
+// param not set before the aspect fee sharing is introduced defaults to disabled.
+func (m Migrator) Migrate5to6(ctx cosmos.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.AspectFeeShare.IsNil() {
+		params.AspectFeeShare = types.DefaultAspectFeeShare
+	}
+	return m.keeper.SetParams(ctx, params)
+}
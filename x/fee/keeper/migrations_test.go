@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmosstore "github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsmodule "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/artela-network/artela/x/fee/types"
+)
+
+func newTestKeeper(t *testing.T) (*Keeper, cosmos.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	transientKey := storetypes.NewTransientStoreKey(types.TransientKey)
+
+	db := dbm.NewMemDB()
+	cms := cosmosstore.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(transientKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := NewKeeper(cdc, authtypes.NewModuleAddress(govtypes.ModuleName), storeKey, transientKey, nil, paramsmodule.Subspace{})
+	return k, cosmos.NewContext(cms, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+}
+
+// withoutFields removes the given fields from the encoded message, as stored before the fields are introduced.
+func withoutFields(t *testing.T, bz []byte, fields ...protowire.Number) []byte {
+	var result []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		removed := false
+		for _, field := range fields {
+			removed = removed || num == field
+		}
+		if !removed {
+			result = append(result, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	return result
+}
+
+func TestMigrations(t *testing.T) {
+	k, ctx := newTestKeeper(t)
+
+	params := types.DefaultParams()
+	params.BaseFeeMode = types.BaseFeeMode_BASE_FEE_MODE_HYBRID
+	bz, err := k.cdc.Marshal(&params)
+	require.NoError(t, err)
+	// params stored before the base fee mode and the aspect fee sharing are introduced
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, withoutFields(t, bz, 9, 10))
+	require.True(t, k.GetParams(ctx).AspectFeeShare.IsNil())
+
+	m := NewMigrator(k)
+	require.NoError(t, m.Migrate4to5(ctx))
+	require.Equal(t, types.BaseFeeMode_BASE_FEE_MODE_GAS_WANTED, k.GetParams(ctx).BaseFeeMode)
+
+	require.NoError(t, m.Migrate5to6(ctx))
+	migrated := k.GetParams(ctx)
+	require.NoError(t, migrated.Validate())
+	require.False(t, migrated.IsAspectFeeShareEnabled())
+	require.Equal(t, types.DefaultAspectFeeShare, migrated.AspectFeeShare)
+
+	// the other params are kept
+	migrated.BaseFeeMode, migrated.AspectFeeShare = params.BaseFeeMode, params.AspectFeeShare
+	require.Equal(t, params, migrated)
+}
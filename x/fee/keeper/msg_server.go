@@ -26,3 +26,20 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ClaimAspectFees implements the gRPC MsgServer interface. It pays out all the aspect fees
+// credited to the beneficiary.
+func (k *Keeper) ClaimAspectFees(goCtx context.Context, req *types.MsgClaimAspectFees) (*types.MsgClaimAspectFeesResponse, error) {
+	beneficiary, err := cosmos.AccAddressFromBech32(req.Beneficiary)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid beneficiary address")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+	amount, err := k.WithdrawAspectFees(ctx, beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAspectFeesResponse{Amount: amount}, nil
+}
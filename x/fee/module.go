@@ -22,7 +22,7 @@ import (
 )
 
 // TODO mark ConsensusVersion defines the current x/fee module consensus version.
-const ConsensusVersion = 4
+const ConsensusVersion = 6
 
 var (
 	_ module.AppModule      = AppModule{}
@@ -75,7 +75,7 @@ func (b AppModuleBasic) RegisterGRPCGatewayRoutes(c client.Context, serveMux *ru
 
 // GetTxCmd returns the root txs command for the fee market module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns no root query command for the fee market module.
@@ -126,11 +126,13 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 
-	// TODO mark
-	// m := keeper.NewMigrator(am.keeper, am.legacySubspace)
-	// if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
-	//	panic(err)
-	// }
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the fee market module. It returns
@@ -19,7 +19,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "artela/fee/MsgUpdateParams"
+	updateParamsName    = "artela/fee/MsgUpdateParams"
+	claimAspectFeesName = "artela/fee/MsgClaimAspectFees"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -33,6 +34,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*cosmos.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgClaimAspectFees{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -40,4 +42,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgClaimAspectFees{}, claimAspectFeesName, nil)
 }
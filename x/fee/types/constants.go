@@ -1,5 +1,11 @@
 package types
 
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feeMeter"
@@ -14,31 +20,61 @@ const (
 	// TransientKey is the key to access the Fee transient store, that is reset
 	// during the Commit phase.
 	TransientKey = "transient_" + ModuleName
+
+	// FeeHistorySize is the number of blocks kept in the fee history ring buffer
+	FeeHistorySize = 1024
 )
 
 // prefix bytes for the fee persistent store
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixAspectFee
+	prefixFeeHistory
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientTxReward
+	prefixTransientEthGasUsed
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixAspectFee      = []byte{prefixAspectFee}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientTxReward       = []byte{prefixTransientTxReward}
+	KeyPrefixTransientEthGasUsed     = []byte{prefixTransientEthGasUsed}
 )
 
 // fee module events
 const (
-	EventTypeFee = "fee"
+	EventTypeFee             = "fee"
+	EventTypeAspectFee       = "aspect_fee"
+	EventTypeClaimAspectFees = "claim_aspect_fees"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee     = "base_fee"
+	AttributeKeyBeneficiary = "beneficiary"
+	AttributeKeyAmount      = "amount"
 )
+
+// AspectFeeKey returns the key of the claimable aspect fees of the beneficiary
+func AspectFeeKey(beneficiary cosmos.AccAddress) []byte {
+	return append(KeyPrefixAspectFee, address.MustLengthPrefix(beneficiary)...)
+}
+
+// FeeHistoryKey returns the key of the fee history ring buffer slot of the block height
+func FeeHistoryKey(height int64) []byte {
+	return append(KeyPrefixFeeHistory, cosmos.Uint64ToBigEndian(uint64(height)%FeeHistorySize)...)
+}
+
+// TransientTxRewardKey returns the transient key of the priority fee paid by the txs
+func TransientTxRewardKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientTxReward, txHash.Bytes()...)
+}
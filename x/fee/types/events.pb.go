@@ -131,7 +131,7 @@ func init() {
 func init() { proto.RegisterFile("artela/fee/v1/events.proto", fileDescriptor_e1be03613ec3d65c) }
 
 var fileDescriptor_e1be03613ec3d65c = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
+	// 204 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2c, 0x2a, 0x49,
 	0xcd, 0x49, 0xd4, 0x4f, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29,
 	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0xc8, 0xe9, 0xa5, 0xa5, 0xa6, 0xea, 0x95,
@@ -144,7 +144,7 @@ var fileDescriptor_e1be03613ec3d65c = []byte{
 	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
 	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe2, 0x36, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c,
 	0x28, 0x17, 0xe4, 0x85, 0x0a, 0xb0, 0x5f, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x1e,
-	0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x17, 0x88, 0xce, 0xe6, 0x00, 0x00, 0x00,
+	0x31, 0x06, 0x0c, 0x00, 0xa9, 0x17, 0x88, 0xce, 0xe6, 0x00, 0x00, 0x00,
 }
 
 func (m *EventFee) Marshal() (dAtA []byte, err error) {
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,7 +25,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Params defines the EVM module parameters
+// BaseFeeMode defines the block gas the base fee adjustment is based on
+type BaseFeeMode int32
+
+const (
+	// BASE_FEE_MODE_GAS_WANTED adjusts the base fee by the gas wanted of the block, i.e. the summed
+	// gas limits bounded by the min gas multiplier, or the block gas consumed if higher
+	BaseFeeMode_BASE_FEE_MODE_GAS_WANTED BaseFeeMode = 0
+	// BASE_FEE_MODE_GAS_USED adjusts the base fee by the gas used by the ethereum txs of the block
+	BaseFeeMode_BASE_FEE_MODE_GAS_USED BaseFeeMode = 1
+	// BASE_FEE_MODE_HYBRID adjusts the base fee by the gas used by the ethereum txs of the block,
+	// or the gas wanted multiplied by the min gas multiplier if higher
+	BaseFeeMode_BASE_FEE_MODE_HYBRID BaseFeeMode = 2
+)
+
+var BaseFeeMode_name = map[int32]string{
+	0: "BASE_FEE_MODE_GAS_WANTED",
+	1: "BASE_FEE_MODE_GAS_USED",
+	2: "BASE_FEE_MODE_HYBRID",
+}
+
+var BaseFeeMode_value = map[string]int32{
+	"BASE_FEE_MODE_GAS_WANTED": 0,
+	"BASE_FEE_MODE_GAS_USED":   1,
+	"BASE_FEE_MODE_HYBRID":     2,
+}
+
+func (x BaseFeeMode) String() string {
+	return proto.EnumName(BaseFeeMode_name, int32(x))
+}
+
+func (BaseFeeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5b545c073c30863c, []int{0}
+}
+
+// Params defines the Fee module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
 	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
@@ -43,6 +78,11 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// aspect_fee_share defines the share of the fees paid for the gas consumed by aspects,
+	// which is credited to the aspect owners. Zero disables the aspect fee sharing.
+	AspectFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=aspect_fee_share,json=aspectFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"aspect_fee_share"`
+	// base_fee_mode defines the block gas the base fee adjustment is based on.
+	BaseFeeMode BaseFeeMode `protobuf:"varint,10,opt,name=base_fee_mode,json=baseFeeMode,proto3,enum=artela.fee.v1.BaseFeeMode" json:"base_fee_mode,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,39 +146,251 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeMode() BaseFeeMode {
+	if m != nil {
+		return m.BaseFeeMode
+	}
+	return BaseFeeMode_BASE_FEE_MODE_GAS_WANTED
+}
+
+// AspectFeeBalance defines the aspect fees claimable by a beneficiary
+type AspectFeeBalance struct {
+	// beneficiary is the bech32 address of the account receiving the aspect fees
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// amount is the claimable aspect fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *AspectFeeBalance) Reset()         { *m = AspectFeeBalance{} }
+func (m *AspectFeeBalance) String() string { return proto.CompactTextString(m) }
+func (*AspectFeeBalance) ProtoMessage()    {}
+func (*AspectFeeBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b545c073c30863c, []int{1}
+}
+func (m *AspectFeeBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectFeeBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectFeeBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectFeeBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectFeeBalance.Merge(m, src)
+}
+func (m *AspectFeeBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectFeeBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectFeeBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectFeeBalance proto.InternalMessageInfo
+
+func (m *AspectFeeBalance) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *AspectFeeBalance) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// BlockFeeHistory defines the fee history record of a block, kept in a ring buffer
+// for the eth_feeHistory queries
+type BlockFeeHistory struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the EIP1559 base fee of the block, empty if the base fee is disabled
+	BaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee,omitempty"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the block gas limit
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// tx_rewards are the gas used and the effective priority fees of the ethereum transactions, sorted by
+	// the fee in ascending order, empty if the block has no ethereum transactions
+	TxRewards []TxReward `protobuf:"bytes,5,rep,name=tx_rewards,json=txRewards,proto3" json:"tx_rewards"`
+}
+
+func (m *BlockFeeHistory) Reset()         { *m = BlockFeeHistory{} }
+func (m *BlockFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeHistory) ProtoMessage()    {}
+func (*BlockFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b545c073c30863c, []int{2}
+}
+func (m *BlockFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeHistory.Merge(m, src)
+}
+func (m *BlockFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeHistory proto.InternalMessageInfo
+
+func (m *BlockFeeHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetTxRewards() []TxReward {
+	if m != nil {
+		return m.TxRewards
+	}
+	return nil
+}
+
+// TxReward defines the gas used by the ethereum transactions paying the same effective priority fee
+// in a block, the adjacent transactions with the same fee in the sorted order are merged
+type TxReward struct {
+	// gas_used is the gas used by the transactions
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// reward is the effective priority fee per gas
+	Reward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=reward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward"`
+}
+
+func (m *TxReward) Reset()         { *m = TxReward{} }
+func (m *TxReward) String() string { return proto.CompactTextString(m) }
+func (*TxReward) ProtoMessage()    {}
+func (*TxReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b545c073c30863c, []int{3}
+}
+func (m *TxReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReward.Merge(m, src)
+}
+func (m *TxReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReward proto.InternalMessageInfo
+
+func (m *TxReward) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("artela.fee.v1.BaseFeeMode", BaseFeeMode_name, BaseFeeMode_value)
 	proto.RegisterType((*Params)(nil), "artela.fee.v1.Params")
+	proto.RegisterType((*AspectFeeBalance)(nil), "artela.fee.v1.AspectFeeBalance")
+	proto.RegisterType((*BlockFeeHistory)(nil), "artela.fee.v1.BlockFeeHistory")
+	proto.RegisterType((*TxReward)(nil), "artela.fee.v1.TxReward")
 }
 
 func init() { proto.RegisterFile("artela/fee/v1/fee.proto", fileDescriptor_5b545c073c30863c) }
 
 var fileDescriptor_5b545c073c30863c = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x5f, 0xcb, 0xd3, 0x30,
-	0x14, 0xc6, 0x1b, 0xf7, 0xbe, 0x7b, 0xfb, 0x66, 0x16, 0x46, 0x98, 0x58, 0x14, 0xba, 0xa2, 0x20,
-	0xbd, 0x59, 0xcb, 0xd8, 0xb5, 0x37, 0x73, 0xa8, 0x13, 0x84, 0xd1, 0x4b, 0x11, 0x42, 0xda, 0x9d,
-	0xb5, 0x61, 0x6d, 0x52, 0x9a, 0x6c, 0xba, 0x6f, 0xe1, 0xc7, 0xda, 0xe5, 0xae, 0x44, 0xbc, 0x18,
-	0xb2, 0x7d, 0x11, 0x69, 0xbb, 0x7f, 0xb7, 0x7a, 0x75, 0x92, 0xf3, 0x3b, 0x79, 0xce, 0x49, 0xf2,
-	0xe0, 0xe7, 0xac, 0xd4, 0x90, 0xb1, 0x60, 0x01, 0x10, 0xac, 0x87, 0x55, 0xf0, 0x8b, 0x52, 0x6a,
-	0x49, 0xac, 0x06, 0xf8, 0x55, 0x66, 0x3d, 0x7c, 0xd1, 0x4b, 0x64, 0x22, 0x6b, 0x12, 0x54, 0xab,
-	0xa6, 0xe8, 0xd5, 0xcf, 0x16, 0x6e, 0xcf, 0x58, 0xc9, 0x72, 0x45, 0x1c, 0xdc, 0x11, 0x92, 0x46,
-	0x4c, 0x01, 0x5d, 0x00, 0xd8, 0xc8, 0x45, 0x9e, 0x19, 0x3e, 0x0a, 0x39, 0x66, 0x0a, 0xde, 0x03,
-	0x90, 0xb7, 0xf8, 0xe5, 0x19, 0xd2, 0x38, 0x65, 0x22, 0x01, 0x3a, 0x07, 0x21, 0x73, 0x2e, 0x98,
-	0x96, 0xa5, 0xfd, 0xc4, 0x45, 0x9e, 0x15, 0xda, 0x51, 0x53, 0xfd, 0xae, 0x2e, 0x98, 0x5c, 0x39,
-	0x19, 0xe1, 0x67, 0x90, 0x31, 0xa5, 0x79, 0xcc, 0xf5, 0x86, 0xe6, 0xab, 0x4c, 0xf3, 0x22, 0xe3,
-	0x50, 0xda, 0xad, 0xfa, 0x60, 0xef, 0x0a, 0x3f, 0x5f, 0x18, 0x79, 0x8d, 0x2d, 0x10, 0x2c, 0xca,
-	0x80, 0xa6, 0xc0, 0x93, 0x54, 0xdb, 0xf7, 0x2e, 0xf2, 0x5a, 0xe1, 0xd3, 0x26, 0xf9, 0xb1, 0xce,
-	0x91, 0x29, 0x36, 0x2f, 0x53, 0xb7, 0x5d, 0xe4, 0x3d, 0x8e, 0xfd, 0xed, 0xbe, 0x6f, 0xfc, 0xde,
-	0xf7, 0xdf, 0x24, 0x5c, 0xa7, 0xab, 0xc8, 0x8f, 0x65, 0x1e, 0xc4, 0x52, 0xe5, 0x52, 0x9d, 0xc2,
-	0x40, 0xcd, 0x97, 0x81, 0xde, 0x14, 0xa0, 0xfc, 0xa9, 0xd0, 0xe1, 0xc3, 0x69, 0x6a, 0x12, 0x62,
-	0x2b, 0xe7, 0x82, 0x26, 0x4c, 0xd1, 0xa2, 0xe4, 0x31, 0xd8, 0x0f, 0xff, 0xac, 0x37, 0x81, 0x38,
-	0xec, 0xe4, 0x5c, 0x7c, 0x60, 0x6a, 0x56, 0x49, 0x90, 0xaf, 0x98, 0x9c, 0x35, 0x6f, 0x6e, 0x6d,
-	0xfe, 0x97, 0x70, 0xb7, 0x11, 0xbe, 0xbe, 0xd0, 0xa7, 0x3b, 0xf3, 0xae, 0x7b, 0x1f, 0x76, 0xb9,
-	0xe0, 0x9a, 0xb3, 0xec, 0xf2, 0x7d, 0xe3, 0xe9, 0xf6, 0xe0, 0xa0, 0xdd, 0xc1, 0x41, 0x7f, 0x0e,
-	0x0e, 0xfa, 0x71, 0x74, 0x8c, 0xdd, 0xd1, 0x31, 0x7e, 0x1d, 0x1d, 0xe3, 0x4b, 0x70, 0xd3, 0xab,
-	0xb1, 0xc8, 0x40, 0x80, 0xfe, 0x26, 0xcb, 0xe5, 0x69, 0x5b, 0xd9, 0xe8, 0x7b, 0xed, 0xa7, 0xba,
-	0x71, 0xd4, 0xae, 0xad, 0x32, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x34, 0x50, 0xe9, 0x1f, 0x6a,
-	0x02, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4e, 0xf3, 0x46,
+	0x10, 0x8f, 0x43, 0x08, 0xc9, 0xa6, 0xa1, 0xd6, 0x8a, 0x82, 0x09, 0x95, 0x89, 0x52, 0xa9, 0x8a,
+	0x90, 0xb0, 0x0b, 0x5c, 0xdb, 0x4a, 0x31, 0x49, 0x20, 0x55, 0x69, 0x91, 0x03, 0xea, 0x1f, 0x55,
+	0xb2, 0xd6, 0xce, 0x90, 0xac, 0xb0, 0x77, 0x23, 0xef, 0x06, 0xc8, 0x5b, 0xf4, 0xda, 0x57, 0xe8,
+	0x93, 0x70, 0xe4, 0x58, 0xf5, 0x40, 0x2b, 0x90, 0x7a, 0xee, 0x23, 0x7c, 0x5a, 0xdb, 0x09, 0xc9,
+	0xf7, 0x5d, 0x3e, 0x38, 0x79, 0x67, 0x7e, 0x33, 0xbf, 0x9d, 0x99, 0xfd, 0x79, 0xd0, 0x16, 0x89,
+	0x25, 0x84, 0xc4, 0xbe, 0x02, 0xb0, 0x6f, 0x0e, 0xd4, 0xc7, 0x1a, 0xc7, 0x5c, 0x72, 0x5c, 0x4d,
+	0x01, 0x4b, 0x79, 0x6e, 0x0e, 0x6a, 0x66, 0xc0, 0x45, 0xc4, 0x85, 0xed, 0x13, 0xa1, 0x02, 0x7d,
+	0x90, 0xe4, 0xc0, 0x0e, 0x38, 0x65, 0x69, 0x78, 0x6d, 0x63, 0xc8, 0x87, 0x3c, 0x39, 0xda, 0xea,
+	0x94, 0x7a, 0x1b, 0xff, 0x17, 0x50, 0xf1, 0x9c, 0xc4, 0x24, 0x12, 0xd8, 0x44, 0x15, 0xc6, 0x3d,
+	0x95, 0xee, 0x5d, 0x01, 0x18, 0x5a, 0x5d, 0x6b, 0x96, 0xdc, 0x32, 0xe3, 0x0e, 0x11, 0xd0, 0x05,
+	0xc0, 0xdf, 0xa0, 0x9d, 0x19, 0xe8, 0x05, 0x23, 0xc2, 0x86, 0xe0, 0x0d, 0x80, 0xf1, 0x88, 0x32,
+	0x22, 0x79, 0x6c, 0xe4, 0xeb, 0x5a, 0xb3, 0xea, 0x1a, 0x7e, 0x1a, 0x7d, 0x9c, 0x04, 0xb4, 0x5f,
+	0x70, 0x7c, 0x84, 0x3e, 0x83, 0x90, 0x08, 0x49, 0x03, 0x2a, 0xa7, 0x5e, 0x34, 0x09, 0x25, 0x1d,
+	0x87, 0x14, 0x62, 0x63, 0x25, 0x49, 0xdc, 0x78, 0x01, 0xcf, 0xe6, 0x18, 0xfe, 0x02, 0x55, 0x81,
+	0x11, 0x3f, 0x04, 0x6f, 0x04, 0x74, 0x38, 0x92, 0xc6, 0x6a, 0x5d, 0x6b, 0xae, 0xb8, 0x9f, 0xa4,
+	0xce, 0xd3, 0xc4, 0x87, 0x7b, 0xa8, 0x34, 0xaf, 0xba, 0x58, 0xd7, 0x9a, 0x65, 0xc7, 0xba, 0x7f,
+	0xdc, 0xcd, 0xfd, 0xfd, 0xb8, 0xfb, 0xe5, 0x90, 0xca, 0xd1, 0xc4, 0xb7, 0x02, 0x1e, 0xd9, 0xd9,
+	0x78, 0xd2, 0xcf, 0xbe, 0x18, 0x5c, 0xdb, 0x72, 0x3a, 0x06, 0x61, 0xf5, 0x98, 0x74, 0xd7, 0xb2,
+	0xaa, 0xb1, 0x8b, 0xaa, 0x11, 0x65, 0xde, 0x90, 0x08, 0x6f, 0x1c, 0xd3, 0x00, 0x8c, 0xb5, 0x57,
+	0xf3, 0xb5, 0x21, 0x70, 0x2b, 0x11, 0x65, 0x27, 0x44, 0x9c, 0x2b, 0x0a, 0xfc, 0x1b, 0xc2, 0x33,
+	0xce, 0x85, 0xae, 0x4b, 0x6f, 0x22, 0xd6, 0x53, 0xe2, 0x85, 0x09, 0xfd, 0x8c, 0x74, 0x22, 0xc6,
+	0x10, 0xc8, 0xe4, 0x5d, 0xc4, 0x88, 0xc4, 0x60, 0x94, 0xdf, 0xc4, 0xbd, 0x9e, 0xf2, 0x74, 0x01,
+	0xfa, 0x8a, 0x05, 0x7f, 0x8b, 0xaa, 0xf3, 0xf7, 0x8e, 0xf8, 0x00, 0x0c, 0x54, 0xd7, 0x9a, 0xeb,
+	0x87, 0x35, 0x6b, 0x49, 0x77, 0x56, 0x26, 0x8f, 0x33, 0x3e, 0x00, 0xb7, 0xe2, 0xbf, 0x18, 0xdf,
+	0x15, 0x4a, 0x05, 0x7d, 0xd5, 0xd5, 0x29, 0xa3, 0x92, 0x92, 0x70, 0x2e, 0xac, 0xc6, 0x1f, 0x1a,
+	0xd2, 0x5b, 0xb3, 0xab, 0x1c, 0x12, 0x12, 0x16, 0x00, 0xae, 0xa3, 0x8a, 0x0f, 0x0c, 0xae, 0x68,
+	0x40, 0x49, 0x3c, 0x4d, 0xc4, 0x57, 0x76, 0x17, 0x5d, 0x38, 0x40, 0x45, 0x12, 0xf1, 0x09, 0x93,
+	0x46, 0xbe, 0xbe, 0xd2, 0xac, 0x1c, 0x6e, 0x5b, 0x69, 0x17, 0x96, 0x22, 0xb6, 0x32, 0xc1, 0x5b,
+	0xc7, 0x9c, 0x32, 0xe7, 0x2b, 0xd5, 0xf9, 0x9f, 0xff, 0xec, 0x36, 0x3f, 0xa2, 0x73, 0x95, 0x20,
+	0xdc, 0x8c, 0xba, 0xf1, 0x9f, 0x86, 0x3e, 0x75, 0x42, 0x1e, 0x5c, 0x77, 0x01, 0x4e, 0xa9, 0x90,
+	0x3c, 0x9e, 0xe2, 0x4d, 0x54, 0xcc, 0xc4, 0xa7, 0x25, 0xe2, 0xcb, 0x2c, 0xdc, 0x59, 0x90, 0x5d,
+	0x3e, 0x99, 0xf8, 0xde, 0x5b, 0x24, 0xb7, 0x8d, 0x4a, 0x4a, 0x1a, 0x13, 0x01, 0x83, 0xe4, 0x57,
+	0x28, 0xb8, 0x6b, 0x43, 0x22, 0x2e, 0x05, 0x0c, 0xf0, 0x0e, 0x2a, 0x2b, 0x28, 0xa4, 0x11, 0x95,
+	0x46, 0x21, 0xc1, 0x54, 0xec, 0xf7, 0xca, 0xc6, 0x5f, 0x23, 0x24, 0xef, 0xbc, 0x18, 0x6e, 0x49,
+	0x3c, 0x10, 0xc6, 0x6a, 0x32, 0x93, 0xad, 0xf7, 0xde, 0xe6, 0xe2, 0xce, 0x4d, 0x70, 0xa7, 0xa0,
+	0x26, 0xe2, 0x96, 0x65, 0x66, 0x8b, 0x46, 0x84, 0x4a, 0x33, 0x70, 0xa9, 0x02, 0x6d, 0xb9, 0x82,
+	0x2e, 0x2a, 0xa6, 0x37, 0x18, 0xf9, 0x57, 0x6b, 0x4a, 0x75, 0x99, 0x65, 0xef, 0x11, 0x54, 0x59,
+	0xd0, 0x09, 0xfe, 0x1c, 0x19, 0x4e, 0xab, 0xdf, 0xf1, 0xba, 0x9d, 0x8e, 0x77, 0xf6, 0x63, 0xbb,
+	0xe3, 0x9d, 0xb4, 0xfa, 0xde, 0x4f, 0xad, 0x1f, 0x2e, 0x3a, 0x6d, 0x3d, 0x87, 0x6b, 0x68, 0xf3,
+	0x43, 0xf4, 0xb2, 0xdf, 0x69, 0xeb, 0x1a, 0x36, 0xd0, 0xc6, 0x32, 0x76, 0xfa, 0x8b, 0xe3, 0xf6,
+	0xda, 0x7a, 0xde, 0xe9, 0xdd, 0x3f, 0x99, 0xda, 0xc3, 0x93, 0xa9, 0xfd, 0xfb, 0x64, 0x6a, 0xbf,
+	0x3f, 0x9b, 0xb9, 0x87, 0x67, 0x33, 0xf7, 0xd7, 0xb3, 0x99, 0xfb, 0xd5, 0x5e, 0x28, 0x36, 0x9d,
+	0xcf, 0x3e, 0x03, 0x79, 0xcb, 0xe3, 0xeb, 0xcc, 0x54, 0x7b, 0xf5, 0x2e, 0x59, 0xb0, 0x49, 0xe5,
+	0x7e, 0x31, 0xd9, 0x8d, 0x47, 0xef, 0x06, 0x00, 0xf8, 0xa1, 0x47, 0x2a, 0x7b, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -161,6 +413,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFeeMode != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.BaseFeeMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.AspectFeeShare.Size()
+		i -= size
+		if _, err := m.AspectFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -219,6 +486,152 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AspectFeeBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectFeeBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectFeeBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxRewards) > 0 {
+		for iNdEx := len(m.TxRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.GasUsed != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -254,6 +667,72 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFee(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFee(uint64(l))
+	l = m.AspectFeeShare.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if m.BaseFeeMode != 0 {
+		n += 1 + sovFee(uint64(m.BaseFeeMode))
+	}
+	return n
+}
+
+func (m *AspectFeeBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFee(uint64(m.Height))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFee(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFee(uint64(m.GasLimit))
+	}
+	if len(m.TxRewards) > 0 {
+		for _, e := range m.TxRewards {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovFee(uint64(m.GasUsed))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovFee(uint64(l))
 	return n
 }
 
@@ -471,6 +950,455 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AspectFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMode", wireType)
+			}
+			m.BaseFeeMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeMode |= BaseFeeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectFeeBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectFeeBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectFeeBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxRewards = append(m.TxRewards, TxReward{})
+			if err := m.TxRewards[len(m.TxRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"math/big"
+)
+
+// Reward returns the effective priority fee paid at the given percentile of the block gas used, computed in
+// the same way as the go-ethereum fee history oracle: it's the fee of the first transaction in the sorted order
+// at which the cumulative gas used reaches the percentile, fractional percentiles included. Zero is returned if
+// the block has no ethereum transactions.
+func (h BlockFeeHistory) Reward(percentile float64) *big.Int {
+	if len(h.TxRewards) == 0 {
+		return new(big.Int)
+	}
+
+	thresholdGasUsed := uint64(float64(h.GasUsed) * percentile / 100)
+	index := 0
+	sumGasUsed := h.TxRewards[0].GasUsed
+	for sumGasUsed < thresholdGasUsed && index < len(h.TxRewards)-1 {
+		index++
+		sumGasUsed += h.TxRewards[index].GasUsed
+	}
+	return h.TxRewards[index].Reward.BigInt()
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesisState sets default fee market genesis states.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +25,19 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis states validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.AspectFees))
+	for _, balance := range gs.AspectFees {
+		if _, err := cosmos.AccAddressFromBech32(balance.Beneficiary); err != nil {
+			return fmt.Errorf("invalid aspect fee beneficiary %s: %w", balance.Beneficiary, err)
+		}
+		if seen[balance.Beneficiary] {
+			return fmt.Errorf("duplicated aspect fee beneficiary %s", balance.Beneficiary)
+		}
+		if err := balance.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid aspect fees of %s: %w", balance.Beneficiary, err)
+		}
+		seen[balance.Beneficiary] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -30,6 +30,8 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// aspect_fees is the claimable aspect fees of all the beneficiaries
+	AspectFees []AspectFeeBalance `protobuf:"bytes,4,rep,name=aspect_fees,json=aspectFees,proto3" json:"aspect_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +81,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetAspectFees() []AspectFeeBalance {
+	if m != nil {
+		return m.AspectFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "artela.fee.v1.GenesisState")
 }
@@ -86,23 +95,25 @@ func init() {
 func init() { proto.RegisterFile("artela/fee/v1/genesis.proto", fileDescriptor_54e3587c50114411) }
 
 var fileDescriptor_54e3587c50114411 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x48, 0xea, 0xa5, 0xa5, 0xa6, 0xea,
-	0x95, 0x19, 0x4a, 0x89, 0xa3, 0xaa, 0x05, 0x89, 0x82, 0xd5, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xa9, 0x80, 0x8b, 0xc7, 0x1d, 0x62, 0x5c, 0x70, 0x49,
-	0x62, 0x49, 0xaa, 0x90, 0x31, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xa8, 0x1e, 0x8a, 0xf1, 0x7a, 0x01, 0x60, 0x49, 0x27, 0x96, 0x13, 0xf7,
-	0xe4, 0x19, 0x82, 0xa0, 0x4a, 0x85, 0xa4, 0xb9, 0x38, 0x93, 0x72, 0xf2, 0x93, 0xb3, 0xe3, 0xd3,
-	0x13, 0x8b, 0x25, 0x98, 0x15, 0x18, 0x35, 0x58, 0x82, 0x38, 0xc0, 0x02, 0xee, 0x89, 0xc5, 0x5e,
-	0x2c, 0x1c, 0x4c, 0x02, 0xcc, 0x41, 0x1c, 0x49, 0x89, 0xc5, 0xa9, 0xf1, 0x69, 0xa9, 0xa9, 0x4e,
-	0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9f, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb1, 0x55, 0x37, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf,
-	0x28, 0x1b, 0xca, 0x05, 0x79, 0xa8, 0x02, 0xec, 0xb3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0x1f, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xf8, 0x2d, 0xdb, 0x20, 0x01, 0x00,
-	0x00,
+	// 282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0xb3, 0x5f, 0x43, 0xe9, 0xb7, 0x55, 0x90, 0xa0, 0x18, 0x5a, 0xd8, 0x06, 0x4f, 0xb9,
+	0xb8, 0x4b, 0xdb, 0x27, 0x30, 0x87, 0x16, 0x3d, 0x49, 0xbc, 0x79, 0x29, 0x93, 0x30, 0x89, 0xa5,
+	0x69, 0x36, 0x64, 0xd7, 0xaa, 0x6f, 0xe1, 0xe3, 0xf8, 0x08, 0x3d, 0xf6, 0xe8, 0x49, 0x24, 0x79,
+	0x11, 0xc9, 0x26, 0x1e, 0xea, 0x6d, 0xe6, 0x3f, 0x3f, 0x7e, 0x03, 0x7f, 0x3a, 0x86, 0x52, 0x63,
+	0x06, 0x22, 0x41, 0x14, 0xbb, 0xa9, 0x48, 0x31, 0x47, 0xb5, 0x56, 0xbc, 0x28, 0xa5, 0x96, 0xce,
+	0x69, 0x7b, 0xe4, 0x09, 0x22, 0xdf, 0x4d, 0x47, 0x97, 0xc7, 0x6c, 0x93, 0x1a, 0x6e, 0x74, 0x9e,
+	0xca, 0x54, 0x9a, 0x51, 0x34, 0x53, 0x9b, 0x5e, 0x7d, 0x10, 0x7a, 0xb2, 0x6c, 0x7d, 0x0f, 0x1a,
+	0x34, 0x3a, 0x73, 0xda, 0x2f, 0xa0, 0x84, 0xad, 0x72, 0x89, 0x47, 0xfc, 0xe1, 0xec, 0x82, 0x1f,
+	0xf9, 0xf9, 0xbd, 0x39, 0x06, 0xf6, 0xfe, 0x6b, 0x62, 0x85, 0x1d, 0xea, 0x8c, 0xe9, 0xff, 0x28,
+	0x93, 0xf1, 0x66, 0x95, 0x82, 0x72, 0x7b, 0x1e, 0xf1, 0xed, 0x70, 0x60, 0x82, 0x25, 0x28, 0x67,
+	0x41, 0x87, 0xa0, 0x0a, 0x8c, 0xf5, 0x2a, 0x41, 0x54, 0xae, 0xed, 0xf5, 0xfc, 0xe1, 0x6c, 0xf2,
+	0x47, 0x7b, 0x63, 0x88, 0x05, 0x62, 0x00, 0x19, 0xe4, 0x31, 0x76, 0x0f, 0x28, 0xfc, 0xe6, 0xea,
+	0xce, 0x1e, 0xfc, 0x3b, 0xeb, 0x85, 0x83, 0x08, 0x14, 0x36, 0xa6, 0xe0, 0x76, 0x5f, 0x31, 0x72,
+	0xa8, 0x18, 0xf9, 0xae, 0x18, 0x79, 0xaf, 0x99, 0x75, 0xa8, 0x99, 0xf5, 0x59, 0x33, 0xeb, 0x51,
+	0xa4, 0x6b, 0xfd, 0xf4, 0x1c, 0xf1, 0x58, 0x6e, 0x45, 0xfb, 0xe6, 0x3a, 0x47, 0xfd, 0x22, 0xcb,
+	0x4d, 0xb7, 0x36, 0xcd, 0xbc, 0x9a, 0x8a, 0xf4, 0x5b, 0x81, 0x2a, 0xea, 0x9b, 0x32, 0xe6, 0x3f,
+	0x03, 0x00, 0x12, 0xe3, 0x38, 0xec, 0x69, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +136,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AspectFees) > 0 {
+		for iNdEx := len(m.AspectFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AspectFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -165,6 +190,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.AspectFees) > 0 {
+		for _, e := range m.AspectFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -255,6 +286,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectFees = append(m.AspectFees, AspectFeeBalance{})
+			if err := m.AspectFees[len(m.AspectFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,17 @@
+package types
+
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper, used to hold and pay out the aspect fees
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx cosmos.Context, senderModule, recipientModule string, amt cosmos.Coins) error
+	SendCoinsFromModuleToAccount(ctx cosmos.Context, senderModule string, recipientAddr cosmos.AccAddress, amt cosmos.Coins) error
+}
+
+// AspectGas is the gas consumed by the aspects sharing the same beneficiary in a transaction
+type AspectGas struct {
+	Beneficiary cosmos.AccAddress
+	GasUsed     uint64
+}
@@ -5,7 +5,10 @@ import (
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ cosmos.Msg = &MsgUpdateParams{}
+var (
+	_ cosmos.Msg = &MsgUpdateParams{}
+	_ cosmos.Msg = &MsgClaimAspectFees{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []cosmos.AccAddress {
@@ -26,3 +29,22 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgClaimAspectFees message.
+func (m *MsgClaimAspectFees) GetSigners() []cosmos.AccAddress {
+	addr := cosmos.MustAccAddressFromBech32(m.Beneficiary)
+	return []cosmos.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgClaimAspectFees) ValidateBasic() error {
+	if _, err := cosmos.AccAddressFromBech32(m.Beneficiary); err != nil {
+		return errorsmod.Wrap(err, "invalid beneficiary address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgClaimAspectFees) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
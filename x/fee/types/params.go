@@ -27,6 +27,10 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultAspectFeeShare is 0 (i.e disabled)
+	DefaultAspectFeeShare = cosmos.ZeroDec()
+	// DefaultBaseFeeMode is adjusting the base fee by the gas used
+	DefaultBaseFeeMode = BaseFeeMode_BASE_FEE_MODE_GAS_USED
 )
 
 // Parameter keys
@@ -39,6 +43,8 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyAspectFeeShare           = []byte("AspectFeeShare")
+	ParamStoreKeyBaseFeeMode              = []byte("BaseFeeMode")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -56,6 +62,8 @@ func (p *Params) ParamSetPairs() paramsmodule.ParamSetPairs {
 		paramsmodule.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramsmodule.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramsmodule.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramsmodule.NewParamSetPair(ParamStoreKeyAspectFeeShare, &p.AspectFeeShare, validateAspectFeeShare),
+		paramsmodule.NewParamSetPair(ParamStoreKeyBaseFeeMode, &p.BaseFeeMode, validateBaseFeeMode),
 	}
 }
 
@@ -68,6 +76,8 @@ func NewParams(
 	enableHeight int64,
 	minGasPrice cosmos.Dec,
 	minGasPriceMultiplier cosmos.Dec,
+	aspectFeeShare cosmos.Dec,
+	baseFeeMode BaseFeeMode,
 ) Params {
 	return Params{
 		NoBaseFee:                noBaseFee,
@@ -77,6 +87,8 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		AspectFeeShare:           aspectFeeShare,
+		BaseFeeMode:              baseFeeMode,
 	}
 }
 
@@ -90,6 +102,8 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		AspectFeeShare:           DefaultAspectFeeShare,
+		BaseFeeMode:              DefaultBaseFeeMode,
 	}
 }
 
@@ -111,6 +125,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAspectFeeShare(p.AspectFeeShare); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeMode(p.BaseFeeMode); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -126,6 +148,12 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// IsAspectFeeShareEnabled returns true if a share of the aspect gas fees is credited to the aspect owners.
+// The share is nil in the params stored before it is introduced, which is the same as disabled.
+func (p Params) IsAspectFeeShareEnabled() bool {
+	return !p.AspectFeeShare.IsNil() && p.AspectFeeShare.IsPositive()
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(cosmos.Dec)
 
@@ -211,3 +239,37 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateAspectFeeShare(i interface{}) error {
+	v, ok := i.(cosmos.Dec)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// not set in the params before the aspect fee sharing is introduced
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("aspect fee share cannot be negative: %s", v)
+	}
+
+	if v.GT(cosmos.OneDec()) {
+		return fmt.Errorf("aspect fee share cannot be greater than 1: %s", v)
+	}
+	return nil
+}
+
+func validateBaseFeeMode(i interface{}) error {
+	v, ok := i.(BaseFeeMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := BaseFeeMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid base fee mode: %d", v)
+	}
+	return nil
+}
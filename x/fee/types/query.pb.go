@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -274,6 +275,204 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryAspectFeesRequest defines the request type for querying the claimable
+// aspect fees.
+type QueryAspectFeesRequest struct {
+	// beneficiary is the bech32 address of the account receiving the aspect fees
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (m *QueryAspectFeesRequest) Reset()         { *m = QueryAspectFeesRequest{} }
+func (m *QueryAspectFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectFeesRequest) ProtoMessage()    {}
+func (*QueryAspectFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71123c78bea6bfc5, []int{6}
+}
+func (m *QueryAspectFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectFeesRequest.Merge(m, src)
+}
+func (m *QueryAspectFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectFeesRequest proto.InternalMessageInfo
+
+func (m *QueryAspectFeesRequest) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+// QueryAspectFeesResponse returns the claimable aspect fees of a beneficiary.
+type QueryAspectFeesResponse struct {
+	// amount is the claimable aspect fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryAspectFeesResponse) Reset()         { *m = QueryAspectFeesResponse{} }
+func (m *QueryAspectFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectFeesResponse) ProtoMessage()    {}
+func (*QueryAspectFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71123c78bea6bfc5, []int{7}
+}
+func (m *QueryAspectFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectFeesResponse.Merge(m, src)
+}
+func (m *QueryAspectFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectFeesResponse proto.InternalMessageInfo
+
+func (m *QueryAspectFeesResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// QueryFeeHistoryRequest defines the request type for querying the fee history
+// of the block_count blocks ending at last_block.
+type QueryFeeHistoryRequest struct {
+	// last_block is the height of the last block of the range
+	LastBlock int64 `protobuf:"varint,1,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+	// block_count is the number of blocks of the range
+	BlockCount uint64 `protobuf:"varint,2,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71123c78bea6bfc5, []int{8}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetLastBlock() int64 {
+	if m != nil {
+		return m.LastBlock
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee history records of a range of blocks.
+type QueryFeeHistoryResponse struct {
+	// blocks are the records found in ascending height order, blocks pruned from
+	// the history are absent
+	Blocks []BlockFeeHistory `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+	// next_base_fee is the base fee of the block after last_block, empty if unknown
+	NextBaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"next_base_fee,omitempty"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71123c78bea6bfc5, []int{9}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetBlocks() []BlockFeeHistory {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "artela.fee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "artela.fee.v1.QueryParamsResponse")
@@ -281,39 +480,59 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "artela.fee.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "artela.fee.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "artela.fee.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryAspectFeesRequest)(nil), "artela.fee.v1.QueryAspectFeesRequest")
+	proto.RegisterType((*QueryAspectFeesResponse)(nil), "artela.fee.v1.QueryAspectFeesResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "artela.fee.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "artela.fee.v1.QueryFeeHistoryResponse")
 }
 
 func init() { proto.RegisterFile("artela/fee/v1/query.proto", fileDescriptor_71123c78bea6bfc5) }
 
 var fileDescriptor_71123c78bea6bfc5 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0xae, 0xd2, 0x40,
-	0x18, 0x85, 0xdb, 0x7b, 0x95, 0x8b, 0x63, 0x4c, 0xcc, 0x48, 0x05, 0x1a, 0x2d, 0x58, 0x8d, 0x51,
-	0x13, 0x3a, 0x01, 0xde, 0xa0, 0x89, 0x1a, 0x5c, 0x69, 0x97, 0x26, 0x86, 0x4c, 0xf1, 0xa7, 0x12,
-	0xa0, 0x53, 0x3a, 0x03, 0xc8, 0xd6, 0x27, 0x30, 0xf1, 0xa5, 0x58, 0x92, 0xb8, 0x31, 0x2e, 0x88,
-	0x01, 0x7d, 0x0f, 0xd3, 0x99, 0xa9, 0xb1, 0xbd, 0x84, 0x15, 0x7f, 0xce, 0x1c, 0xce, 0xd7, 0xff,
-	0xcc, 0xa0, 0x26, 0x4d, 0x05, 0xcc, 0x28, 0x19, 0x03, 0x90, 0x55, 0x97, 0x2c, 0x96, 0x90, 0x6e,
-	0xbc, 0x24, 0x65, 0x82, 0xe1, 0x3b, 0xea, 0xc8, 0x1b, 0x03, 0x78, 0xab, 0xae, 0x5d, 0x2f, 0x3a,
-	0x33, 0x55, 0xfa, 0xec, 0x5a, 0xc4, 0x22, 0x26, 0x47, 0x92, 0x4d, 0x5a, 0x7d, 0x10, 0x31, 0x16,
-	0xcd, 0x80, 0xd0, 0x64, 0x42, 0x68, 0x1c, 0x33, 0x41, 0xc5, 0x84, 0xc5, 0x5c, 0x9d, 0xba, 0x35,
-	0x84, 0xdf, 0x65, 0xa8, 0xb7, 0x34, 0xa5, 0x73, 0x1e, 0xc0, 0x62, 0x09, 0x5c, 0xb8, 0x6f, 0xd0,
-	0xbd, 0x82, 0xca, 0x13, 0x16, 0x73, 0xc0, 0x7d, 0x54, 0x49, 0xa4, 0xd2, 0x30, 0xdb, 0xe6, 0xb3,
-	0xdb, 0x3d, 0xcb, 0x2b, 0x7c, 0x99, 0xa7, 0xec, 0xfe, 0x8d, 0xed, 0xbe, 0x65, 0x04, 0xda, 0xea,
-	0x5a, 0x3a, 0xcb, 0xa7, 0x1c, 0x5e, 0x01, 0xe4, 0x88, 0x0f, 0xa8, 0x56, 0x94, 0x35, 0xe3, 0x25,
-	0xaa, 0x86, 0x94, 0xc3, 0x70, 0x0c, 0x20, 0x29, 0xb7, 0xfc, 0x17, 0x3f, 0xf7, 0xad, 0xa7, 0xd1,
-	0x44, 0x7c, 0x5a, 0x86, 0xde, 0x88, 0xcd, 0xc9, 0x88, 0xf1, 0x39, 0xe3, 0xfa, 0xa7, 0xc3, 0x3f,
-	0x4e, 0x89, 0xd8, 0x24, 0xc0, 0xbd, 0x41, 0x2c, 0x82, 0xab, 0x50, 0xc5, 0xb9, 0xf7, 0xf3, 0xf8,
-	0x19, 0x1b, 0x4d, 0x5f, 0xd3, 0x7f, 0x9b, 0x3d, 0x47, 0x56, 0x49, 0xd7, 0xdc, 0xbb, 0xe8, 0x32,
-	0xa2, 0x6a, 0xb1, 0xcb, 0x20, 0x1b, 0x7b, 0x7f, 0x2e, 0xd0, 0x4d, 0xe9, 0xc5, 0x31, 0xaa, 0xa8,
-	0xd5, 0xf0, 0xa3, 0xd2, 0xc6, 0xd7, 0xbb, 0xb3, 0xdd, 0x73, 0x16, 0x05, 0x73, 0x1f, 0x7e, 0xf9,
-	0xfe, 0xfb, 0xdb, 0x45, 0x1d, 0x5b, 0xa4, 0x78, 0x97, 0xaa, 0x32, 0xcc, 0xd1, 0x95, 0xae, 0x05,
-	0x9f, 0x4c, 0x2b, 0x56, 0x69, 0x3f, 0x3e, 0xeb, 0xd1, 0xc8, 0x96, 0x44, 0x36, 0x71, 0xbd, 0x84,
-	0xcc, 0xcb, 0xc6, 0x6b, 0x54, 0xcd, 0x4b, 0xc1, 0xa7, 0x13, 0x8b, 0x55, 0xda, 0x4f, 0xce, 0x9b,
-	0x34, 0xb7, 0x2d, 0xb9, 0x36, 0x6e, 0x94, 0xb9, 0x99, 0x71, 0x18, 0x51, 0xee, 0x0f, 0xb6, 0x07,
-	0xc7, 0xdc, 0x1d, 0x1c, 0xf3, 0xd7, 0xc1, 0x31, 0xbf, 0x1e, 0x1d, 0x63, 0x77, 0x74, 0x8c, 0x1f,
-	0x47, 0xc7, 0x78, 0x4f, 0xfe, 0xbb, 0x75, 0xf5, 0xef, 0x4e, 0x0c, 0x62, 0xcd, 0xd2, 0x69, 0x1e,
-	0xb6, 0xea, 0x92, 0xcf, 0x32, 0x51, 0x3e, 0x81, 0xb0, 0x22, 0x1f, 0x75, 0xff, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd1, 0x99, 0x95, 0xf7, 0x4d, 0x03, 0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x02, 0x16, 0xf8, 0x1a, 0x12, 0x33, 0x52, 0x0a, 0x1b, 0xd8, 0xd6, 0x55, 0x49, 0x25,
+	0x61, 0x87, 0xc2, 0xcd, 0x78, 0xb1, 0x44, 0x14, 0x0f, 0x46, 0xf7, 0x64, 0x4c, 0x4c, 0x33, 0xbb,
+	0x4e, 0x97, 0x0d, 0xed, 0x4e, 0xd9, 0x99, 0x02, 0x8d, 0x51, 0x13, 0xef, 0x26, 0x26, 0xfe, 0x08,
+	0x13, 0x7f, 0x09, 0x47, 0x12, 0x2f, 0xc6, 0x03, 0x1a, 0xf0, 0x37, 0x78, 0x36, 0x3b, 0x33, 0x0b,
+	0x6c, 0xdb, 0x14, 0x3d, 0xed, 0xe4, 0x9b, 0x37, 0xef, 0xbd, 0x6f, 0xe6, 0x7d, 0x0b, 0x0b, 0x24,
+	0x16, 0xb4, 0x45, 0x70, 0x93, 0x52, 0xbc, 0x5f, 0xc3, 0x7b, 0x5d, 0x1a, 0xf7, 0x9c, 0x4e, 0xcc,
+	0x04, 0x43, 0x33, 0x6a, 0xcb, 0x69, 0x52, 0xea, 0xec, 0xd7, 0xcc, 0x52, 0x16, 0x99, 0x54, 0x25,
+	0xce, 0xb4, 0x7c, 0xc6, 0xdb, 0x8c, 0x63, 0x8f, 0xf0, 0x64, 0xc7, 0xa3, 0x82, 0xd4, 0xb0, 0xcf,
+	0xc2, 0x48, 0xef, 0xcf, 0x06, 0x2c, 0x60, 0x72, 0x89, 0x93, 0x95, 0xae, 0x2e, 0x06, 0x8c, 0x05,
+	0x2d, 0x8a, 0x49, 0x27, 0xc4, 0x24, 0x8a, 0x98, 0x20, 0x22, 0x64, 0x11, 0x57, 0xbb, 0xf6, 0x2c,
+	0xa0, 0xe7, 0x89, 0x95, 0x67, 0x24, 0x26, 0x6d, 0xee, 0xd2, 0xbd, 0x2e, 0xe5, 0xc2, 0x7e, 0x02,
+	0x37, 0x32, 0x55, 0xde, 0x61, 0x11, 0xa7, 0x68, 0x03, 0xf2, 0x1d, 0x59, 0x99, 0x37, 0x2a, 0x46,
+	0xb5, 0xb0, 0x5e, 0x74, 0x32, 0xce, 0x1d, 0x05, 0xaf, 0x4f, 0x1c, 0x9d, 0x94, 0x73, 0xae, 0x86,
+	0xda, 0x45, 0xcd, 0x55, 0x27, 0x9c, 0x6e, 0x51, 0x9a, 0x4a, 0xbc, 0x82, 0xd9, 0x6c, 0x59, 0x6b,
+	0x3c, 0x84, 0xa9, 0xa4, 0xbf, 0x46, 0x93, 0x52, 0xa9, 0x32, 0x5d, 0x5f, 0xf9, 0x71, 0x52, 0x5e,
+	0x0e, 0x42, 0xb1, 0xd3, 0xf5, 0x1c, 0x9f, 0xb5, 0xb1, 0xbe, 0x05, 0xf5, 0x59, 0xe5, 0xaf, 0x77,
+	0xb1, 0xe8, 0x75, 0x28, 0x77, 0xb6, 0x23, 0xe1, 0x4e, 0x7a, 0x8a, 0xce, 0x9e, 0x4b, 0xe9, 0x5b,
+	0xcc, 0xdf, 0x7d, 0x44, 0xce, 0x3b, 0xbb, 0x0b, 0xc5, 0xbe, 0xba, 0xd6, 0xbd, 0x0e, 0xe3, 0x01,
+	0x51, 0x8d, 0x8d, 0xbb, 0xc9, 0xd2, 0xbe, 0x07, 0x73, 0x12, 0xfa, 0x80, 0x77, 0xa8, 0x2f, 0xb6,
+	0x28, 0x4d, 0x49, 0x50, 0x05, 0x0a, 0x1e, 0x8d, 0x68, 0x33, 0xf4, 0x43, 0x12, 0xf7, 0x94, 0x4d,
+	0xf7, 0x72, 0xc9, 0x7e, 0x07, 0xa5, 0x81, 0xb3, 0x5a, 0xc8, 0x87, 0x3c, 0x69, 0xb3, 0x6e, 0x24,
+	0xe6, 0x8d, 0xca, 0x78, 0xb5, 0xb0, 0xbe, 0xe0, 0xa8, 0x4e, 0x9c, 0xc4, 0xba, 0xa3, 0x9f, 0xd5,
+	0xd9, 0x64, 0x61, 0x54, 0x5f, 0x4b, 0x2e, 0xf2, 0xeb, 0xcf, 0x72, 0xf5, 0x1f, 0xba, 0x4f, 0x0e,
+	0x70, 0x57, 0x53, 0xdb, 0x2f, 0xb4, 0xf7, 0x2d, 0x4a, 0x1f, 0x87, 0x5c, 0xb0, 0xb8, 0x97, 0x7a,
+	0x5f, 0x02, 0x68, 0x11, 0x2e, 0x1a, 0x5e, 0x72, 0x01, 0xba, 0xdd, 0xe9, 0xa4, 0x22, 0x6f, 0x04,
+	0x95, 0xa1, 0x20, 0x77, 0x1a, 0xbe, 0xb4, 0x38, 0x56, 0x31, 0xaa, 0x13, 0x2e, 0xc8, 0xd2, 0xa6,
+	0x64, 0xfe, 0x62, 0x40, 0x69, 0x80, 0x5a, 0xb7, 0x76, 0x1f, 0xf2, 0x12, 0xc9, 0x75, 0x6b, 0x56,
+	0x5f, 0x3e, 0xa4, 0xc4, 0xc5, 0xb9, 0x34, 0x28, 0xea, 0x0c, 0x7a, 0x0a, 0x33, 0x11, 0x3d, 0x14,
+	0x8d, 0xf3, 0xe7, 0x1f, 0xfb, 0xef, 0xe7, 0x2f, 0x24, 0x04, 0x3a, 0x51, 0xeb, 0x7f, 0x26, 0xe0,
+	0x9a, 0x74, 0x8a, 0x22, 0xc8, 0xab, 0x68, 0xa2, 0x9b, 0x7d, 0x8e, 0x06, 0xb3, 0x6f, 0xda, 0xa3,
+	0x20, 0xaa, 0x51, 0x7b, 0xe9, 0xc3, 0xb7, 0xdf, 0x9f, 0xc7, 0x4a, 0xa8, 0x88, 0xb3, 0xb3, 0xaa,
+	0x22, 0x8f, 0x38, 0x4c, 0x6a, 0x13, 0x68, 0x28, 0x5b, 0x76, 0x14, 0xcc, 0x5b, 0x23, 0x31, 0x5a,
+	0xb2, 0x2c, 0x25, 0x17, 0x50, 0xa9, 0x4f, 0x32, 0xbd, 0x2d, 0x74, 0x00, 0x53, 0x69, 0xa8, 0xd1,
+	0x70, 0xc6, 0xec, 0x28, 0x98, 0xb7, 0x47, 0x83, 0xb4, 0x6e, 0x45, 0xea, 0x9a, 0x68, 0xbe, 0x5f,
+	0x57, 0xa6, 0x24, 0x20, 0x1c, 0x7d, 0x34, 0x00, 0x2e, 0x72, 0x8e, 0xee, 0x0c, 0xa3, 0x1d, 0x98,
+	0x21, 0x73, 0xf9, 0x2a, 0x98, 0xd6, 0x5f, 0x93, 0xfa, 0x2b, 0xa8, 0xda, 0xa7, 0x4f, 0x24, 0x34,
+	0xe9, 0x9c, 0xe3, 0x37, 0x97, 0x46, 0xef, 0x2d, 0x7a, 0x0f, 0x70, 0x91, 0xb1, 0xe1, 0x76, 0x06,
+	0xc6, 0xc2, 0x5c, 0xbe, 0x0a, 0xa6, 0xed, 0xd8, 0xd2, 0xce, 0x22, 0x32, 0xf1, 0xc0, 0x5f, 0xba,
+	0xb1, 0xa3, 0x63, 0xbd, 0x7d, 0x74, 0x6a, 0x19, 0xc7, 0xa7, 0x96, 0xf1, 0xeb, 0xd4, 0x32, 0x3e,
+	0x9d, 0x59, 0xb9, 0xe3, 0x33, 0x2b, 0xf7, 0xfd, 0xcc, 0xca, 0xbd, 0xc4, 0x97, 0x72, 0xac, 0xce,
+	0xaf, 0x46, 0x54, 0x1c, 0xb0, 0x78, 0x37, 0xa5, 0xdb, 0xaf, 0xe1, 0x43, 0xc9, 0x29, 0x43, 0xed,
+	0xe5, 0xe5, 0x5f, 0x7a, 0xe3, 0xef, 0x00, 0xa2, 0xcf, 0x77, 0xdc, 0x3e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +553,10 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// AspectFees queries the claimable aspect fees of a beneficiary
+	AspectFees(ctx context.Context, in *QueryAspectFeesRequest, opts ...grpc.CallOption) (*QueryAspectFeesResponse, error)
+	// FeeHistory queries the fee history records of a range of blocks
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -371,6 +594,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) AspectFees(ctx context.Context, in *QueryAspectFeesRequest, opts ...grpc.CallOption) (*QueryAspectFeesResponse, error) {
+	out := new(QueryAspectFeesResponse)
+	err := c.cc.Invoke(ctx, "/artela.fee.v1.Query/AspectFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/artela.fee.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/fee module.
@@ -379,6 +620,10 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// AspectFees queries the claimable aspect fees of a beneficiary
+	AspectFees(context.Context, *QueryAspectFeesRequest) (*QueryAspectFeesResponse, error)
+	// FeeHistory queries the fee history records of a range of blocks
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -394,6 +639,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) AspectFees(ctx context.Context, req *QueryAspectFeesRequest) (*QueryAspectFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectFees not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -453,6 +704,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AspectFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.fee.v1.Query/AspectFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectFees(ctx, req.(*QueryAspectFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.fee.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "artela.fee.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -469,6 +756,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "AspectFees",
+			Handler:    _Query_AspectFees_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/fee/v1/query.proto",
@@ -639,6 +934,155 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAspectFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextBaseFee != nil {
+		{
+			size := m.NextBaseFee.Size()
+			i -= size
+			if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -713,6 +1157,68 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAspectFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlock))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextBaseFee != nil {
+		l = m.NextBaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1107,6 +1613,380 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAspectFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlock", wireType)
+			}
+			m.LastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, BlockFeeHistory{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.NextBaseFee = &v
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -124,35 +126,139 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgClaimAspectFees defines a Msg for withdrawing all the claimable aspect fees.
+type MsgClaimAspectFees struct {
+	// beneficiary is the address of the account the aspect fees are credited to.
+	Beneficiary string `protobuf:"bytes,1,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+}
+
+func (m *MsgClaimAspectFees) Reset()         { *m = MsgClaimAspectFees{} }
+func (m *MsgClaimAspectFees) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAspectFees) ProtoMessage()    {}
+func (*MsgClaimAspectFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26b5e51db554952, []int{2}
+}
+func (m *MsgClaimAspectFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAspectFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAspectFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAspectFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAspectFees.Merge(m, src)
+}
+func (m *MsgClaimAspectFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAspectFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAspectFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAspectFees proto.InternalMessageInfo
+
+func (m *MsgClaimAspectFees) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+// MsgClaimAspectFeesResponse defines the response structure for executing a
+// MsgClaimAspectFees message.
+type MsgClaimAspectFeesResponse struct {
+	// amount is the aspect fees withdrawn
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimAspectFeesResponse) Reset()         { *m = MsgClaimAspectFeesResponse{} }
+func (m *MsgClaimAspectFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAspectFeesResponse) ProtoMessage()    {}
+func (*MsgClaimAspectFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26b5e51db554952, []int{3}
+}
+func (m *MsgClaimAspectFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAspectFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAspectFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAspectFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAspectFeesResponse.Merge(m, src)
+}
+func (m *MsgClaimAspectFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAspectFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAspectFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAspectFeesResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAspectFeesResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "artela.fee.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "artela.fee.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgClaimAspectFees)(nil), "artela.fee.v1.MsgClaimAspectFees")
+	proto.RegisterType((*MsgClaimAspectFeesResponse)(nil), "artela.fee.v1.MsgClaimAspectFeesResponse")
 }
 
 func init() { proto.RegisterFile("artela/fee/v1/txs.proto", fileDescriptor_c26b5e51db554952) }
 
 var fileDescriptor_c26b5e51db554952 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x88, 0xeb, 0xa5, 0xa5, 0xa6, 0xea, 0x95, 0x19, 0x4a, 0x89, 0x27,
-	0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0xe7, 0x16, 0xa7, 0x83, 0x94, 0xe5, 0x16, 0xa7, 0x43, 0xd4,
-	0x49, 0x49, 0x42, 0x24, 0xe2, 0xc1, 0x3c, 0x7d, 0x08, 0x07, 0x2a, 0x25, 0x8e, 0x6a, 0x34, 0xc8,
-	0x24, 0x88, 0x84, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x03, 0x88, 0x05, 0x11, 0x55, 0xea, 0x63,
-	0xe4, 0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x0d, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0x16, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x8f, 0x63, 0x4a, 0x4a,
-	0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x42, 0xa9, 0x90, 0x31, 0x17,
-	0x5b, 0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0xef, 0xe8,
-	0x41, 0x8c, 0x77, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xd4, 0x8a, 0xaf, 0xe9, 0xf9,
-	0x06, 0x2d, 0x84, 0x21, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0xee, 0x09, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x8a, 0xe5, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x0a, 0xe3, 0xe2, 0x41, 0x71, 0xae,
-	0x1c, 0x9a, 0x35, 0x68, 0xda, 0xa5, 0xd4, 0xf0, 0xcb, 0xc3, 0x8c, 0x77, 0xf2, 0x3c, 0xf1, 0x48,
-	0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0x59, 0xba, 0x79, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0x50, 0x2e,
-	0x28, 0xa4, 0x2b, 0xc0, 0x41, 0x5e, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5c, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xd9, 0x59, 0x66, 0xe8, 0x01, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0xf5, 0x12, 0x64, 0x29, 0x6b, 0x20, 0xe8, 0x14, 0xb0, 0x7d, 0xc5, 0xc5, 0xb8, 0x40, 0x06,
+	0xc9, 0x7b, 0xd8, 0x91, 0x28, 0xdc, 0xc5, 0x91, 0x90, 0x28, 0x2c, 0x21, 0x23, 0x28, 0x68, 0xa2,
+	0xbd, 0xf3, 0x78, 0x73, 0x4a, 0xee, 0xf6, 0xb4, 0xb3, 0x0e, 0x49, 0x09, 0x1f, 0x80, 0xf8, 0x0e,
+	0x2a, 0x0a, 0x6a, 0xea, 0x94, 0x11, 0x15, 0x15, 0x20, 0xbb, 0xe0, 0x37, 0xd0, 0xde, 0x6e, 0x88,
+	0x7d, 0x91, 0xe2, 0xea, 0x6e, 0xe6, 0xbd, 0x7d, 0x33, 0xf3, 0x66, 0xe8, 0x43, 0xae, 0x34, 0x1c,
+	0xf3, 0x70, 0x0a, 0x10, 0x9e, 0xf4, 0x42, 0x7d, 0xca, 0x72, 0x25, 0xb5, 0xf4, 0xee, 0xda, 0x3c,
+	0x9b, 0x02, 0xb0, 0x93, 0x9e, 0x5f, 0x8f, 0x25, 0xa6, 0x12, 0xc3, 0x14, 0x85, 0xa1, 0xa5, 0x28,
+	0x2c, 0xcf, 0x6f, 0x5a, 0xe0, 0xa0, 0x88, 0x42, 0x1b, 0x38, 0xa8, 0xbe, 0x2a, 0x6d, 0x94, 0x2c,
+	0x10, 0x38, 0xb1, 0x88, 0xa3, 0x41, 0x22, 0xd0, 0xbc, 0x17, 0xc6, 0x32, 0xc9, 0x1c, 0xbe, 0x2d,
+	0xa4, 0x90, 0x56, 0xd0, 0xfc, 0xd9, 0x6c, 0xfb, 0x13, 0xa1, 0x5b, 0x23, 0x14, 0x6f, 0xf2, 0x09,
+	0xd7, 0xf0, 0x8a, 0x2b, 0x9e, 0xa2, 0xf7, 0x9c, 0x6e, 0xf2, 0x99, 0x3e, 0x94, 0x2a, 0xd1, 0x67,
+	0x0d, 0xd2, 0x22, 0x9d, 0xcd, 0x61, 0xe3, 0xc7, 0xb7, 0xee, 0xb6, 0xeb, 0x63, 0x6f, 0x32, 0x51,
+	0x80, 0xf8, 0x5a, 0xab, 0x24, 0x13, 0xe3, 0x2b, 0xaa, 0xb7, 0x4b, 0xab, 0x79, 0xa1, 0xd0, 0xb8,
+	0xd5, 0x22, 0x9d, 0x5a, 0xff, 0x01, 0x5b, 0x19, 0x97, 0x59, 0xf9, 0xe1, 0xed, 0xf3, 0x5f, 0x3b,
+	0x95, 0xb1, 0xa3, 0x0e, 0xee, 0x7d, 0xfc, 0xfb, 0xf5, 0xe9, 0x95, 0x48, 0xbb, 0x49, 0xeb, 0xa5,
+	0x7e, 0xc6, 0x80, 0xb9, 0xcc, 0x10, 0xda, 0x11, 0xf5, 0x46, 0x28, 0xf6, 0x8f, 0x79, 0x92, 0xee,
+	0x61, 0x0e, 0xb1, 0x7e, 0x01, 0x80, 0xde, 0x80, 0xd6, 0x22, 0xc8, 0x60, 0x9a, 0xc4, 0x09, 0x57,
+	0xeb, 0xfb, 0x5d, 0x26, 0x0f, 0xee, 0x9b, 0xe2, 0xcb, 0x99, 0xf6, 0x07, 0x42, 0xfd, 0xeb, 0x45,
+	0x2e, 0x5b, 0xf0, 0x62, 0x5a, 0xe5, 0xa9, 0x9c, 0x65, 0xba, 0x41, 0x5a, 0x1b, 0x9d, 0x5a, 0xbf,
+	0xc9, 0x5c, 0x11, 0xe3, 0x3a, 0x73, 0xae, 0xb3, 0x7d, 0x99, 0x64, 0xc3, 0x67, 0x66, 0xcc, 0x2f,
+	0xbf, 0x77, 0x3a, 0x22, 0xd1, 0x87, 0xb3, 0x88, 0xc5, 0x32, 0x75, 0x9b, 0x74, 0x9f, 0x2e, 0x4e,
+	0x8e, 0x42, 0x7d, 0x96, 0x03, 0x16, 0x0f, 0x70, 0xec, 0xa4, 0xfb, 0xdf, 0x09, 0xdd, 0x18, 0xa1,
+	0xf0, 0xde, 0xd2, 0x3b, 0x2b, 0x7b, 0x09, 0x4a, 0x7e, 0x96, 0x7c, 0xf2, 0x1f, 0xdf, 0x8c, 0xff,
+	0x1f, 0xe2, 0x80, 0x6e, 0x95, 0x4d, 0x7c, 0x74, 0xfd, 0x69, 0x89, 0xe2, 0x3f, 0x59, 0x4b, 0xb9,
+	0x2c, 0x30, 0x7c, 0x79, 0x3e, 0x0f, 0xc8, 0xc5, 0x3c, 0x20, 0x7f, 0xe6, 0x01, 0xf9, 0xbc, 0x08,
+	0x2a, 0x17, 0x8b, 0xa0, 0xf2, 0x73, 0x11, 0x54, 0xde, 0x85, 0x4b, 0x66, 0x58, 0xb9, 0x6e, 0x06,
+	0xfa, 0xbd, 0x54, 0x47, 0x2e, 0x34, 0x37, 0x7d, 0x5a, 0x1c, 0x77, 0xe1, 0x4c, 0x54, 0x2d, 0xce,
+	0x74, 0xf7, 0xdf, 0x00, 0x68, 0x87, 0xf5, 0xdc, 0x52, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +276,8 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ClaimAspectFees defines a method for withdrawing the aspect fees credited to the beneficiary.
+	ClaimAspectFees(ctx context.Context, in *MsgClaimAspectFees, opts ...grpc.CallOption) (*MsgClaimAspectFeesResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +297,22 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ClaimAspectFees(ctx context.Context, in *MsgClaimAspectFees, opts ...grpc.CallOption) (*MsgClaimAspectFeesResponse, error) {
+	out := new(MsgClaimAspectFeesResponse)
+	err := c.cc.Invoke(ctx, "/artela.fee.v1.Msg/ClaimAspectFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ClaimAspectFees defines a method for withdrawing the aspect fees credited to the beneficiary.
+	ClaimAspectFees(context.Context, *MsgClaimAspectFees) (*MsgClaimAspectFeesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +322,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAspectFees(ctx context.Context, req *MsgClaimAspectFees) (*MsgClaimAspectFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAspectFees not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +348,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAspectFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAspectFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAspectFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.fee.v1.Msg/ClaimAspectFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAspectFees(ctx, req.(*MsgClaimAspectFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "artela.fee.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +374,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ClaimAspectFees",
+			Handler:    _Msg_ClaimAspectFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/fee/v1/txs.proto",
@@ -302,6 +446,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgClaimAspectFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAspectFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAspectFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAspectFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAspectFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAspectFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -337,6 +548,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimAspectFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAspectFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +747,172 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimAspectFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAspectFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAspectFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAspectFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAspectFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAspectFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0